@@ -819,6 +819,10 @@ func (c *Converter) convertValue(vocabName string, v rdf.VocabularyValue) *gen.K
 	s := v.SerializeFn.CloneToPackage(c.vocabValuePackage(v).Path())
 	d := v.DeserializeFn.CloneToPackage(c.vocabValuePackage(v).Path())
 	l := v.LessFn.CloneToPackage(c.vocabValuePackage(v).Path())
+	var val *codegen.Function
+	if v.ValidateFn != nil {
+		val = v.ValidateFn.CloneToPackage(c.vocabValuePackage(v).Path())
+	}
 	// Name must use toIdentifier for vocabValuePackage and valuePackage to
 	// be the same.
 	id := toIdentifier(v)
@@ -830,7 +834,8 @@ func (c *Converter) convertValue(vocabName string, v rdf.VocabularyValue) *gen.K
 		v.IsURI,
 		s,
 		d,
-		l)
+		l,
+		val)
 }
 
 // convertTypeToName makes a Titled version of the VocabularyType's name.
@@ -1459,6 +1464,9 @@ func convertValue(pkg gen.Package, v *gen.Kind) *File {
 		v.DeserializeDef.Definition(),
 	).Line().Add(
 		v.LessDef.Definition())
+	if v.ValidateDef != nil {
+		file.Line().Add(v.ValidateDef.Definition())
+	}
 	return &File{
 		F:         file,
 		FileName:  fmt.Sprintf("gen_%s.go", v.Name.LowerName),
@@ -542,6 +542,10 @@ func (p *FunctionalPropertyGenerator) singleTypeDef() *codegen.Struct {
 	funcs = append(funcs, p.ConstructorFn())
 	methods = append(methods, p.singleTypeFuncs()...)
 	methods = append(methods, p.funcs()...)
+	methods = append(methods, p.cloneMethod())
+	methods = append(methods, p.equalsMethod())
+	methods = append(methods, p.validateMethod())
+	methods = append(methods, p.valueMethod())
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
 	return codegen.NewStruct(comment,
@@ -800,6 +804,10 @@ func (p *FunctionalPropertyGenerator) multiTypeDef() *codegen.Struct {
 	funcs = append(funcs, p.ConstructorFn())
 	methods = append(methods, p.multiTypeFuncs()...)
 	methods = append(methods, p.funcs()...)
+	methods = append(methods, p.cloneMethod())
+	methods = append(methods, p.equalsMethod())
+	methods = append(methods, p.validateMethod())
+	methods = append(methods, p.valueMethod())
 	methods = append(methods, p.commonMethods()...)
 	methods = append(methods, p.nameMethod())
 	return codegen.NewStruct(comment,
@@ -1238,3 +1246,144 @@ func (p *FunctionalPropertyGenerator) nameMethod() *codegen.Method {
 		fmt.Sprintf("%s returns the name of this property: %q.", nameMethod, p.PropertyName()),
 	)
 }
+
+// cloneMethod returns the method creating a deep copy of this property. The
+// copy of an iterator belongs to the copy of its parent property, so it is
+// created by an unexported method taking the new parent.
+func (p *FunctionalPropertyGenerator) cloneMethod() *codegen.Method {
+	body := []jen.Code{
+		jen.Id("c").Op(":=").Op("&").Qual(p.GetPrivatePackage().Path(), p.StructName()).Values(
+			jen.Dict{
+				jen.Id(aliasMember):       jen.Id(codegen.This()).Dot(aliasMember),
+				jen.Id(unknownMemberName): jen.Qual(jsonLDPackagePath, copyValueFnName).Call(jen.Id(codegen.This()).Dot(unknownMemberName)),
+			},
+		),
+	}
+	for i, k := range p.kinds {
+		member := p.memberName(i)
+		if !k.isValue() {
+			body = append(body, jen.If(
+				jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+			).Block(
+				jen.Id("c").Dot(member).Op("=").Add(jen.Id(codegen.This())).Dot(member).Dot(cloneMethod).Call(),
+			))
+		} else if k.IsURI {
+			body = append(body, p.cloneIRICode(member))
+		} else if k.Nilable && k.Name.LowerName == "langString" {
+			body = append(body, jen.If(
+				jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+			).Block(
+				jen.Id("c").Dot(member).Op("=").Make(jen.Map(jen.String()).String(), jen.Len(jen.Id(codegen.This()).Dot(member))),
+				jen.For(
+					jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Add(jen.Id(codegen.This())).Dot(member),
+				).Block(
+					jen.Id("c").Dot(member).Index(jen.Id("k")).Op("=").Id("v"),
+				),
+			))
+		} else {
+			body = append(body, jen.Id("c").Dot(member).Op("=").Add(jen.Id(codegen.This())).Dot(member))
+		}
+		if !k.Nilable {
+			body = append(body, jen.Id("c").Dot(p.hasMemberName(i)).Op("=").Add(jen.Id(codegen.This())).Dot(p.hasMemberName(i)))
+		}
+	}
+	if !p.hasURIKind() {
+		body = append(body, p.cloneIRICode(iriMember))
+	}
+	if p.asIterator {
+		body = append(body,
+			jen.Id("c").Dot(myIndexMemberName).Op("=").Add(jen.Id(codegen.This())).Dot(myIndexMemberName),
+			jen.Id("c").Dot(parentMemberName).Op("=").Id("parent"),
+		)
+		body = append(body, jen.Return(jen.Id("c")))
+		return codegen.NewCommentedValueMethod(
+			p.GetPrivatePackage().Path(),
+			cloneIteratorMethod,
+			p.StructName(),
+			[]jen.Code{jen.Id("parent").Qual(p.GetPublicPackage().Path(), p.parentTypeInterfaceName())},
+			[]jen.Code{jen.Op("*").Qual(p.GetPrivatePackage().Path(), p.StructName())},
+			body,
+			fmt.Sprintf("%s returns a deep copy of this iterator, belonging to the parent property.", cloneIteratorMethod))
+	}
+	body = append(body, jen.Return(jen.Id("c")))
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		cloneMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		body,
+		fmt.Sprintf("%s returns a deep copy of this property, which can be modified without changing this one. Its values, IRI, and types are copied.", cloneMethod))
+}
+
+// cloneIRICode generates the code copying the IRI in the member to the clone,
+// so that modifying the IRI of one does not modify the other.
+func (p *FunctionalPropertyGenerator) cloneIRICode(member string) jen.Code {
+	return jen.If(
+		jen.Id(codegen.This()).Dot(member).Op("!=").Nil(),
+	).Block(
+		jen.Id("u").Op(":=").Op("*").Id(codegen.This()).Dot(member),
+		jen.Id("c").Dot(member).Op("=").Op("&").Id("u"),
+	)
+}
+
+// equalsMethod returns the method determining whether this property has the
+// same value as another.
+func (p *FunctionalPropertyGenerator) equalsMethod() *codegen.Method {
+	equalsCode := jen.If(
+		jen.Id("o").Op("==").Nil().Op("||").Id(codegen.This()).Dot(kindIndexMethod).Call().Op("!=").Id("o").Dot(kindIndexMethod).Call(),
+	).Block(
+		jen.Return(jen.False()),
+	)
+	for i, kind := range p.kinds {
+		equalsCode.Add(
+			jen.Else().If(
+				jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call(),
+			).Block(
+				jen.Return(kind.equalsFnCode(jen.Id(codegen.This()).Dot(p.getFnName(i)).Call(), jen.Id("o").Dot(p.getFnName(i)).Call()))))
+	}
+	if !p.hasURIKind() {
+		equalsCode.Add(
+			jen.Else().If(
+				jen.Id(codegen.This()).Dot(isIRIMethod).Call(),
+			).Block(
+				jen.Return(
+					jen.Id(codegen.This()).Dot(iriMember).Dot("String").Call().Op("==").Id("o").Dot(getIRIMethod).Call().Dot("String").Call(),
+				),
+			))
+	}
+	unknownCode := []jen.Code{
+		jen.List(jen.Id("rhs"), jen.Err()).Op(":=").Id("o").Dot(serializeMethod).Call(),
+		jen.Return(
+			jen.Err().Op("==").Nil().Op("&&").Qual(jsonLDPackagePath, equalValuesFnName).Call(
+				jen.Id(codegen.This()).Dot(unknownMemberName),
+				jen.Id("rhs"),
+			),
+		),
+	}
+	if p.asIterator {
+		// Iterators do not expose their unknown values, but are always
+		// of this implementation within a property.
+		unknownCode = []jen.Code{
+			jen.List(jen.Id("rhs"), jen.Id("ok")).Op(":=").Id("o").Assert(jen.Op("*").Id(p.StructName())),
+			jen.Return(
+				jen.Id("ok").Op("&&").Qual(jsonLDPackagePath, equalValuesFnName).Call(
+					jen.Id(codegen.This()).Dot(unknownMemberName),
+					jen.Id("rhs").Dot(unknownMemberName),
+				),
+			),
+		}
+	}
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		equalsMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			equalsCode,
+			jen.Commentf("Neither has a value, so compare the unknown values."),
+			join(unknownCode),
+		},
+		fmt.Sprintf("%s determines whether this property has the same value as the other. Types are compared with their %s method, and IRIs by their string form.", equalsMethod, equalsMethod))
+}
@@ -88,6 +88,13 @@ func (p *NonFunctionalPropertyGenerator) Definitions() (*codegen.Struct, *codege
 		funcs = append(funcs, deser)
 		funcs = append(funcs, p.ConstructorFn())
 		methods = append(methods, p.funcs()...)
+		methods = append(methods, p.cloneMethod())
+		methods = append(methods, p.equalsMethod())
+		methods = append(methods, p.validateMethod())
+		methods = append(methods, p.valuesMethod())
+		if p.hasNaturalLanguageMap {
+			methods = append(methods, p.languageMapMethods()...)
+		}
 		property := codegen.NewStruct(
 			fmt.Sprintf("%s is the non-functional property %q. It is permitted to have one or more values, and of different value types.", p.StructName(), p.PropertyName()),
 			p.StructName(),
@@ -844,6 +851,19 @@ func (p *NonFunctionalPropertyGenerator) funcs() []*codegen.Method {
 // NonFunctional property to be serialized and deserialized to and from an
 // encoding.
 func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method, *codegen.Function) {
+	idxVar := "_"
+	mapIdx := jen.Empty()
+	skipMap := jen.Empty()
+	if p.hasNaturalLanguageMap {
+		idxVar = "idx"
+		mapIdx = jen.Id("mapIdx").Op(":=").Id(codegen.This()).Dot(languageMapIndexMethod).Call()
+		skipMap = jen.If(
+			jen.Id("idx").Op("==").Id("mapIdx"),
+		).Block(
+			jen.Commentf("Serialized by %s instead.", serializeMapMethod),
+			jen.Continue(),
+		)
+	}
 	serialize := codegen.NewCommentedValueMethod(
 		p.GetPrivatePackage().Path(),
 		p.serializeFnName(),
@@ -856,12 +876,14 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 				jen.Lit(0),
 				jen.Len(jen.Id(codegen.This()).Dot(propertiesName)),
 			),
+			mapIdx,
 			jen.For(
 				jen.List(
-					jen.Id("_"),
+					jen.Id(idxVar),
 					jen.Id("iterator"),
 				).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
 			).Block(
+				skipMap,
 				jen.If(
 					jen.List(
 						jen.Id("b"),
@@ -922,15 +944,44 @@ func (p *NonFunctionalPropertyGenerator) serializationFuncs() (*codegen.Method,
 	mapProperty := jen.Empty()
 	if p.hasNaturalLanguageMap {
 		mapProperty = jen.If(
-			jen.Id("!ok"),
-		).Block(
-			jen.Commentf("Attempt to find the map instead."),
 			jen.List(
-				jen.Id("i"),
-				jen.Id("ok"),
-			).Op("=").Id("m").Index(
+				jen.Id("mi"),
+				jen.Id("mok"),
+			).Op(":=").Id("m").Index(
 				jen.Id("propName").Op("+").Lit("Map"),
 			),
+			jen.Id("mok"),
+		).Block(
+			jen.Commentf("Read the map after any plain values, as both may be present."),
+			jen.If(
+				jen.Op("!").Id("ok"),
+			).Block(
+				jen.List(
+					jen.Id("i"),
+					jen.Id("ok"),
+				).Op("=").List(
+					jen.Id("mi"),
+					jen.True(),
+				),
+			).Else().If(
+				jen.List(
+					jen.Id("list"),
+					jen.Id("isList"),
+				).Op(":=").Id("i").Assert(
+					jen.Index().Interface(),
+				),
+				jen.Id("isList"),
+			).Block(
+				jen.Id("i").Op("=").Append(
+					jen.Id("list"),
+					jen.Id("mi"),
+				),
+			).Else().Block(
+				jen.Id("i").Op("=").Index().Interface().Values(
+					jen.Id("i"),
+					jen.Id("mi"),
+				),
+			),
 		)
 	}
 	aliasBlock := jen.Empty()
@@ -1035,6 +1086,66 @@ func (p *NonFunctionalPropertyGenerator) thisIRI() *jen.Statement {
 	return nil
 }
 
+// languageMapMethods returns the methods serializing the natural language map
+// of this property alongside its plain values.
+func (p *NonFunctionalPropertyGenerator) languageMapMethods() []*codegen.Method {
+	return []*codegen.Method{
+		codegen.NewCommentedValueMethod(
+			p.GetPrivatePackage().Path(),
+			languageMapIndexMethod,
+			p.StructName(),
+			/*params=*/ nil,
+			[]jen.Code{jen.Int()},
+			[]jen.Code{
+				jen.Id("mapIdx").Op(":=").Lit(-1),
+				jen.If(
+					jen.Id(codegen.This()).Dot(lenMethod).Call().Op("<").Lit(2),
+				).Block(
+					jen.Return(jen.Id("mapIdx")),
+				),
+				jen.For(
+					jen.List(
+						jen.Id("idx"),
+						jen.Id("iterator"),
+					).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+				).Block(
+					jen.If(
+						jen.Op("!").Id("iterator").Dot(isLanguageMapMethod).Call(),
+					).Block(
+						jen.Continue(),
+					).Else().If(
+						jen.Id("mapIdx").Op(">=").Lit(0),
+					).Block(
+						jen.Return(jen.Lit(-1)),
+					),
+					jen.Id("mapIdx").Op("=").Id("idx"),
+				),
+				jen.Return(jen.Id("mapIdx")),
+			},
+			fmt.Sprintf("%s returns the index of the only langString value when there are other values as well, which is serialized as the %q property alongside them. It returns -1 otherwise.", languageMapIndexMethod, p.PropertyName()+"Map"),
+		),
+		codegen.NewCommentedValueMethod(
+			p.GetPrivatePackage().Path(),
+			serializeMapMethod,
+			p.StructName(),
+			/*params=*/ nil,
+			[]jen.Code{jen.Interface(), jen.Error()},
+			[]jen.Code{
+				jen.If(
+					jen.Id("idx").Op(":=").Id(codegen.This()).Dot(languageMapIndexMethod).Call(),
+					jen.Id("idx").Op(">=").Lit(0),
+				).Block(
+					jen.Return(
+						jen.Id(codegen.This()).Dot(propertiesName).Index(jen.Id("idx")).Dot(serializeIteratorMethod).Call(),
+					),
+				),
+				jen.Return(jen.Nil(), jen.Nil()),
+			},
+			fmt.Sprintf("%s converts the langString value into an interface representation to serialize as the %q property, alongside the other values serialized as the %q property. It returns nil if there is no such value, in which case %s serializes all of the values. Applications should not need this function as most typical use cases serialize types instead of individual properties.", serializeMapMethod, p.PropertyName()+"Map", p.PropertyName(), p.serializeFnName()),
+		),
+	}
+}
+
 // nameMethod returns the Name method for this non-functional property.
 func (p *NonFunctionalPropertyGenerator) nameMethod() *codegen.Method {
 	nameImpl := jen.If(
@@ -1075,3 +1186,65 @@ func (p *NonFunctionalPropertyGenerator) nameMethod() *codegen.Method {
 		fmt.Sprintf("%s returns the name of this property (%q) with any alias.", nameMethod, p.PropertyName()),
 	)
 }
+
+// cloneMethod returns the method creating a deep copy of this property and of
+// each of its values.
+func (p *NonFunctionalPropertyGenerator) cloneMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		cloneMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{
+			jen.Id("c").Op(":=").Op("&").Qual(p.GetPrivatePackage().Path(), p.StructName()).Values(
+				jen.Dict{
+					jen.Id(aliasMember): jen.Id(codegen.This()).Dot(aliasMember),
+				},
+			),
+			jen.If(
+				jen.Id(codegen.This()).Dot(propertiesName).Op("!=").Nil(),
+			).Block(
+				jen.Id("c").Dot(propertiesName).Op("=").Make(
+					jen.Index().Op("*").Id(p.iteratorTypeName().CamelName),
+					jen.Len(jen.Id(codegen.This()).Dot(propertiesName)),
+				),
+				jen.For(
+					jen.List(jen.Id("i"), jen.Id("it")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+				).Block(
+					jen.Id("c").Dot(propertiesName).Index(jen.Id("i")).Op("=").Id("it").Dot(cloneIteratorMethod).Call(jen.Id("c")),
+				),
+			),
+			jen.Return(jen.Id("c")),
+		},
+		fmt.Sprintf("%s returns a deep copy of this property, which can be modified without changing this one. Each of its values is copied.", cloneMethod))
+}
+
+// equalsMethod returns the method determining whether this property has the
+// same values, in the same order, as another.
+func (p *NonFunctionalPropertyGenerator) equalsMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		equalsMethod,
+		p.StructName(),
+		[]jen.Code{jen.Id("o").Qual(p.GetPublicPackage().Path(), p.InterfaceName())},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			jen.If(
+				jen.Id("o").Op("==").Nil().Op("||").Id(codegen.This()).Dot(lenMethod).Call().Op("!=").Id("o").Dot(lenMethod).Call(),
+			).Block(
+				jen.Return(jen.False()),
+			),
+			jen.For(
+				jen.List(jen.Id("i"), jen.Id("it")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.If(
+					jen.Op("!").Id("it").Dot(equalsMethod).Call(jen.Id("o").Dot(atMethodName).Call(jen.Id("i"))),
+				).Block(
+					jen.Return(jen.False()),
+				),
+			),
+			jen.Return(jen.True()),
+		},
+		fmt.Sprintf("%s determines whether this property has the same values as the other, in the same order.", equalsMethod))
+}
@@ -33,6 +33,7 @@ const (
 	nameMethod                = "Name"
 	serializeIteratorMethod   = "serialize"
 	deserializeIteratorMethod = "deserialize"
+	cloneIteratorMethod       = "clone"
 	hasLanguageMethod         = "HasLanguage"
 	getLanguageMethod         = "GetLanguage"
 	setLanguageMethod         = "SetLanguage"
@@ -41,6 +42,8 @@ const (
 	beginMethod               = "Begin"
 	endMethod                 = "End"
 	emptyMethod               = "Empty"
+	serializeMapMethod        = "SerializeMap"
+	languageMapIndexMethod    = "languageMapIndex"
 	// Context string management
 	contextMethod = "JSONLDContext"
 	// Member names for generated code
@@ -100,19 +103,24 @@ type Kind struct {
 	// on the object directly (instead of a qualified function).
 	SerializeFn *jen.Statement
 	LessFn      *jen.Statement
+	// ValidateFn is nil for values that are always in range, and for
+	// types.
+	ValidateFn *jen.Statement
 
 	// The following are only used for values, not types, as actual implementations
 	SerializeDef   *codegen.Function
 	DeserializeDef *codegen.Function
 	LessDef        *codegen.Function
+	ValidateDef    *codegen.Function
 }
 
-// NewKindForValue creates a Kind for a value type.
+// NewKindForValue creates a Kind for a value type. The validateFn may be nil if
+// every value of the type is in range.
 func NewKindForValue(docName, idName, vocab string,
 	defType *jen.Statement,
 	isNilable, isURI bool,
-	serializeFn, deserializeFn, lessFn *codegen.Function) *Kind {
-	return &Kind{
+	serializeFn, deserializeFn, lessFn, validateFn *codegen.Function) *Kind {
+	k := &Kind{
 		Name: Identifier{
 			LowerName: docName,
 			CamelName: idName,
@@ -128,6 +136,11 @@ func NewKindForValue(docName, idName, vocab string,
 		DeserializeDef: deserializeFn,
 		LessDef:        lessFn,
 	}
+	if validateFn != nil {
+		k.ValidateFn = validateFn.QualifiedName()
+		k.ValidateDef = validateFn
+	}
+	return k
 }
 
 // NewKindForType creates a Kind for an ActivitySteams type.
@@ -169,6 +182,15 @@ func (k Kind) lessFnCode(this, other *jen.Statement) *jen.Statement {
 	return lessCall
 }
 
+// equalsFnCode creates the code comparing two values of this Kind for equality.
+// Values are equal when neither is less than the other.
+func (k Kind) equalsFnCode(this, other *jen.Statement) *jen.Statement {
+	if k.isValue() {
+		return jen.Op("!").Add(k.lessFnCode(this, other)).Op("&&").Op("!").Add(k.lessFnCode(other, this))
+	}
+	return this.Clone().Dot(equalsMethod).Call(other.Clone())
+}
+
 // lessFnCode creates the correct code calling this Kind's deserialize function
 // depending on whether the Kind is a value or a type.
 func (k Kind) deserializeFnCode(m, ctx *jen.Statement) *jen.Statement {
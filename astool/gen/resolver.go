@@ -26,6 +26,8 @@ const (
 	errorCannotTypeAssert            = "errCannotTypeAssertType"
 	isUnFnName                       = "IsUnmatchedErr"
 	toAliasMapFnName                 = "toAliasMap"
+	jsonLDPackagePath                = "github.com/go-fed/activity/streams/jsonld"
+	normalizeFnName                  = "Normalize"
 )
 
 // ResolverGenerator generates the code required for the TypeResolver and the
@@ -265,6 +267,7 @@ func (r *ResolverGenerator) fns() []*codegen.Function {
 // jsonResolverMethods returns the methods for the TypeResolver.
 func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 	aliasToId := make(map[string]string)
+	var vocabularies []jen.Code
 	aliasFetching := jen.Empty()
 	impl := jen.Empty()
 	for i, t := range r.types {
@@ -282,6 +285,7 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 			// If not, generate the code.
 			vocabId := t.vocabName + "Alias"
 			aliasToId[vocabHttps.String()] = vocabId
+			vocabularies = append(vocabularies, jen.Lit(vocabHttps.String()))
 			aliasFetching = aliasFetching.Add(
 				jen.List(
 					jen.Id(vocabId),
@@ -365,6 +369,20 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 			jen.Error(),
 		},
 		[]jen.Code{
+			jen.List(
+				jen.Id("m"),
+				jen.Err(),
+			).Op(":=").Qual(jsonLDPackagePath, normalizeFnName).Call(
+				append([]jen.Code{
+					jen.Id("ctx"),
+					jen.Id("m"),
+				}, vocabularies...)...,
+			),
+			jen.If(
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Err()),
+			),
 			jen.List(
 				jen.Id("typeValue"),
 				jen.Id("ok"),
@@ -460,7 +478,7 @@ func (r *ResolverGenerator) jsonResolverMethods() (m []*codegen.Method) {
 				),
 			),
 		},
-		fmt.Sprintf("%s determines the ActivityStreams type of the payload, then applies the first callback function whose signature accepts the ActivityStreams value's type. This strictly assures that the callback function will only be passed ActivityStream objects whose type matches its interface. Returns an error if the ActivityStreams type does not match callbackers or is not a type handled by the generated code. If multiple types are present, it will check each one in order and apply only the first one. It returns an unhandled error for a multi-typed object if none of the types were able to be handled.", resolveMethod)))
+		fmt.Sprintf("%s determines the ActivityStreams type of the payload, then applies the first callback function whose signature accepts the ActivityStreams value's type. This strictly assures that the callback function will only be passed ActivityStream objects whose type matches its interface. Returns an error if the ActivityStreams type does not match callbackers or is not a type handled by the generated code. If multiple types are present, it will check each one in order and apply only the first one. It returns an unhandled error for a multi-typed object if none of the types were able to be handled. The payload is first normalized against the JSON-LD contexts of the vocabularies, so properties and types named by IRIs or by other terms are recognized.", resolveMethod)))
 	return
 }
 
@@ -25,6 +25,16 @@ const (
 	deserializeFnName          = "Deserialize"
 	compareLessMethod          = "LessThan"
 	getUnknownMethod           = "GetUnknownProperties"
+	getUnknownPropertyMethod   = "GetUnknownProperty"
+	setUnknownPropertyMethod   = "SetUnknownProperty"
+	delUnknownPropertyMethod   = "DeleteUnknownProperty"
+	rangeUnknownMethod         = "RangeUnknownProperties"
+	cloneMethod                = "Clone"
+	copyObjectFnName           = "CopyObject"
+	copyValueFnName            = "CopyValue"
+	equalsMethod               = "Equals"
+	equalObjectsFnName         = "EqualObjects"
+	equalValuesFnName          = "EqualValues"
 	unknownMember              = "unknown"
 	aliasMember                = "alias"
 	getMethodFormat            = "Get%s"
@@ -91,6 +101,42 @@ func TypeInterface(pkg Package) *codegen.Interface {
 			Ret:     []jen.Code{jen.Map(jen.String()).Interface(), jen.Error()},
 			Comment: fmt.Sprintf("%s converts this into an interface representation suitable for marshalling into a text or binary format.", serializeMethodName),
 		},
+		{
+			Name:    getUnknownPropertyMethod,
+			Params:  []jen.Code{jen.Id("name").String()},
+			Ret:     []jen.Code{jen.Interface(), jen.Bool()},
+			Comment: fmt.Sprintf("%s returns the value of the unknown property with the name, and whether it is set.", getUnknownPropertyMethod),
+		},
+		{
+			Name:    setUnknownPropertyMethod,
+			Params:  []jen.Code{jen.Id("name").String(), jen.Id("v").Interface()},
+			Ret:     nil,
+			Comment: fmt.Sprintf("%s sets the unknown property with the name.", setUnknownPropertyMethod),
+		},
+		{
+			Name:    delUnknownPropertyMethod,
+			Params:  []jen.Code{jen.Id("name").String()},
+			Ret:     nil,
+			Comment: fmt.Sprintf("%s removes the unknown property with the name.", delUnknownPropertyMethod),
+		},
+		{
+			Name:    rangeUnknownMethod,
+			Params:  []jen.Code{jen.Id("fn").Func().Params(jen.Id("name").String(), jen.Id("v").Interface()).Bool()},
+			Ret:     nil,
+			Comment: fmt.Sprintf("%s calls the function with each unknown property in the order of their names, until it returns false.", rangeUnknownMethod),
+		},
+		{
+			Name:    validateMethod,
+			Params:  nil,
+			Ret:     []jen.Code{jen.Error()},
+			Comment: fmt.Sprintf("%s returns an error if the value violates its vocabulary.", validateMethod),
+		},
+		{
+			Name:    rangePropertiesMethod,
+			Params:  []jen.Code{jen.Id("fn").Func().Params(jen.Id("name").String(), jen.Id("values").Index().Interface()).Bool()},
+			Ret:     nil,
+			Comment: fmt.Sprintf("%s calls the function with the name and values of each property that has a value, in the order of their names, until it returns false.", rangePropertiesMethod),
+		},
 	}
 	return codegen.NewInterface(pkg.Path(), typeInterfaceName, funcs, comment)
 }
@@ -384,6 +430,11 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 		ser := t.serializationMethod()
 		less := t.lessMethod()
 		get := t.getUnknownMethod()
+		unknowns := t.unknownPropertyMethods()
+		clone := t.cloneMethod()
+		equals := t.equalsMethod()
+		validate := t.validateMethod()
+		rangeProperties := t.rangePropertiesMethod()
 		deser := t.deserializationFn()
 		extendsFn, extendsMethod := t.extendsDefinition()
 		getters := t.allGetters()
@@ -393,15 +444,20 @@ func (t *TypeGenerator) Definition() *codegen.Struct {
 		t.cachedStruct = codegen.NewStruct(
 			t.Comments(),
 			t.StructName(),
-			append(append(append(
+			append(append(append(append(
 				[]*codegen.Method{
 					t.nameDefinition(),
 					t.vocabURIDefinition(),
 					extendsMethod,
 					ser,
 					less,
+					equals,
 					get,
+					clone,
+					validate,
+					rangeProperties,
 				},
+				unknowns...),
 				ctxMethods...),
 				getters...),
 				setters...,
@@ -710,6 +766,22 @@ func (t *TypeGenerator) disjointWithDefinition() *codegen.Function {
 func (t *TypeGenerator) serializationMethod() (ser *codegen.Method) {
 	serCode := jen.Commentf("Begin: Serialize known properties").Line()
 	for _, prop := range t.allProperties() {
+		serMap := jen.Empty()
+		if _, ok := prop.(*NonFunctionalPropertyGenerator); ok && prop.HasNaturalLanguageMap() {
+			serMap = jen.If(
+				jen.List(
+					jen.Id("i"),
+					jen.Err(),
+				).Op(":=").Id(codegen.This()).Dot(t.memberName(prop)).Dot(serializeMapMethod).Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			).Else().If(
+				jen.Id("i").Op("!=").Nil(),
+			).Block(
+				jen.Id("m").Index(jen.Id(codegen.This()).Dot(t.memberName(prop)).Dot(nameMethod).Call().Op("+").Lit("Map")).Op("=").Id("i"),
+			)
+		}
 		serCode.Add(
 			jen.Commentf("Maybe serialize property %q", prop.PropertyName()).Line(),
 			jen.If(
@@ -728,6 +800,7 @@ func (t *TypeGenerator) serializationMethod() (ser *codegen.Method) {
 				).Block(
 					jen.Id("m").Index(jen.Id(codegen.This()).Dot(t.memberName(prop)).Dot(nameMethod).Call()).Op("=").Id("i"),
 				),
+				serMap,
 			).Line())
 	}
 	serCode = serCode.Commentf("End: Serialize known properties").Line()
@@ -862,6 +935,65 @@ func (t *TypeGenerator) lessMethod() (less *codegen.Method) {
 	return
 }
 
+// equalsMethod returns the method determining whether this type has the same
+// properties and unknown properties as another.
+func (t *TypeGenerator) equalsMethod() *codegen.Method {
+	equalsCode := jen.If(
+		jen.Id("o").Op("==").Nil(),
+	).Block(
+		jen.Return(jen.False()),
+	).Line()
+	for _, prop := range t.allProperties() {
+		equalsCode = equalsCode.Add(
+			jen.Commentf("Compare property %q", prop.PropertyName()).Line(),
+			jen.If(
+				jen.List(
+					jen.Id("lhs"),
+					jen.Id("rhs"),
+				).Op(":=").List(
+					jen.Id(codegen.This()).Dot(t.memberName(prop)),
+					jen.Id("o").Dot(
+						fmt.Sprintf(getMethodFormat, t.memberName(prop)),
+					).Call(),
+				),
+				jen.Id("lhs").Op("==").Nil().Op("||").Id("rhs").Op("==").Nil(),
+			).Block(
+				jen.If(
+					jen.Id("lhs").Op("!=").Nil().Op("||").Id("rhs").Op("!=").Nil(),
+				).Block(
+					jen.Return(jen.False()),
+				),
+			).Else().If(
+				jen.Op("!").Id("lhs").Dot(equalsMethod).Call(jen.Id("rhs")),
+			).Block(
+				jen.Return(jen.False()),
+			).Line())
+	}
+	return codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		equalsMethod,
+		t.StructName(),
+		[]jen.Code{
+			jen.Id("o").Qual(t.PublicPackage().Path(), t.InterfaceName()),
+		},
+		[]jen.Code{jen.Bool()},
+		[]jen.Code{
+			equalsCode,
+			jen.Commentf("Compare unknown properties, except the @context of the document."),
+			jen.Return(
+				jen.Qual(jsonLDPackagePath, equalObjectsFnName).Call(
+					jen.Id(codegen.This()).Dot(unknownMember),
+					jen.Id("o").Dot(getUnknownMethod).Call(),
+					jen.Lit(contextJSONLDName),
+				),
+			),
+		},
+		fmt.Sprintf(
+			"%s determines whether this %s has the same properties, values, and unknown properties as the other. Values of non-functional properties are compared in order, and the aliases used for vocabularies are ignored.",
+			equalsMethod,
+			t.TypeName()))
+}
+
 // deserializationFn returns free function reference that can be used to
 // treat a TypeGenerator as another property's Kind.
 func (t *TypeGenerator) deserializationFn() (deser *codegen.Function) {
@@ -1063,6 +1195,143 @@ func (t *TypeGenerator) getUnknownMethod() (get *codegen.Method) {
 	return
 }
 
+// unknownPropertyMethods returns the methods for applications to get, set,
+// delete, and iterate over the unknown properties of this type, such as
+// extension properties that are not code generated.
+func (t *TypeGenerator) unknownPropertyMethods() []*codegen.Method {
+	return []*codegen.Method{
+		codegen.NewCommentedValueMethod(
+			t.PrivatePackage().Path(),
+			getUnknownPropertyMethod,
+			t.StructName(),
+			[]jen.Code{jen.Id("name").String()},
+			[]jen.Code{jen.Interface(), jen.Bool()},
+			[]jen.Code{
+				jen.List(
+					jen.Id("v"),
+					jen.Id("ok"),
+				).Op(":=").Id(codegen.This()).Dot(unknownMember).Index(jen.Id("name")),
+				jen.Return(jen.Id("v"), jen.Id("ok")),
+			},
+			fmt.Sprintf(
+				"%s returns the value of the unknown property with the name, and whether it is set. The value is as it was deserialized from JSON, such as a string, float64, bool, map[string]interface{} or []interface{}.",
+				getUnknownPropertyMethod)),
+		codegen.NewCommentedPointerMethod(
+			t.PrivatePackage().Path(),
+			setUnknownPropertyMethod,
+			t.StructName(),
+			[]jen.Code{
+				jen.Id("name").String(),
+				jen.Id("v").Interface(),
+			},
+			/*ret=*/ nil,
+			[]jen.Code{
+				jen.If(
+					jen.Id(codegen.This()).Dot(unknownMember).Op("==").Nil(),
+				).Block(
+					jen.Id(codegen.This()).Dot(unknownMember).Op("=").Make(jen.Map(jen.String()).Interface()),
+				),
+				jen.Id(codegen.This()).Dot(unknownMember).Index(jen.Id("name")).Op("=").Id("v"),
+			},
+			fmt.Sprintf(
+				"%s sets the unknown property with the name, which is serialized with the value as is. The value must be marshallable into JSON. It is not serialized if the name is the name of a property known to the %s type.",
+				setUnknownPropertyMethod,
+				t.TypeName())),
+		codegen.NewCommentedPointerMethod(
+			t.PrivatePackage().Path(),
+			delUnknownPropertyMethod,
+			t.StructName(),
+			[]jen.Code{jen.Id("name").String()},
+			/*ret=*/ nil,
+			[]jen.Code{
+				jen.Delete(
+					jen.Id(codegen.This()).Dot(unknownMember),
+					jen.Id("name"),
+				),
+			},
+			fmt.Sprintf("%s removes the unknown property with the name.", delUnknownPropertyMethod)),
+		codegen.NewCommentedValueMethod(
+			t.PrivatePackage().Path(),
+			rangeUnknownMethod,
+			t.StructName(),
+			[]jen.Code{
+				jen.Id("fn").Func().Params(
+					jen.Id("name").String(),
+					jen.Id("v").Interface(),
+				).Bool(),
+			},
+			/*ret=*/ nil,
+			[]jen.Code{
+				jen.Id("names").Op(":=").Make(
+					jen.Index().String(),
+					jen.Lit(0),
+					jen.Len(jen.Id(codegen.This()).Dot(unknownMember)),
+				),
+				jen.For(
+					jen.Id("k").Op(":=").Range().Id(codegen.This()).Dot(unknownMember),
+				).Block(
+					jen.If(
+						jen.Id("k").Op("!=").Lit(contextJSONLDName),
+					).Block(
+						jen.Id("names").Op("=").Append(jen.Id("names"), jen.Id("k")),
+					),
+				),
+				jen.Qual("sort", "Strings").Call(jen.Id("names")),
+				jen.For(
+					jen.List(
+						jen.Id("_"),
+						jen.Id("k"),
+					).Op(":=").Range().Id("names"),
+				).Block(
+					jen.If(
+						jen.Op("!").Id("fn").Call(
+							jen.Id("k"),
+							jen.Id(codegen.This()).Dot(unknownMember).Index(jen.Id("k")),
+						),
+					).Block(
+						jen.Return(),
+					),
+				),
+			},
+			fmt.Sprintf(
+				"%s calls the function with each unknown property in the order of their names, until it returns false. The @context of the document the %s was deserialized from is skipped.",
+				rangeUnknownMethod,
+				t.TypeName())),
+	}
+}
+
+// cloneMethod returns the method creating a deep copy of this type, without
+// serializing it.
+func (t *TypeGenerator) cloneMethod() *codegen.Method {
+	body := []jen.Code{
+		jen.Id("c").Op(":=").Op("&").Qual(t.PrivatePackage().Path(), t.StructName()).Values(
+			jen.Dict{
+				jen.Id(aliasMember):   jen.Id(codegen.This()).Dot(aliasMember),
+				jen.Id(unknownMember): jen.Qual(jsonLDPackagePath, copyObjectFnName).Call(jen.Id(codegen.This()).Dot(unknownMember)),
+			},
+		),
+	}
+	for _, property := range t.allProperties() {
+		body = append(body, jen.If(
+			jen.Id(codegen.This()).Dot(t.memberName(property)).Op("!=").Nil(),
+		).Block(
+			jen.Id("c").Dot(t.memberName(property)).Op("=").Id(codegen.This()).Dot(t.memberName(property)).Dot(cloneMethod).Call(),
+		))
+	}
+	body = append(body, jen.Return(jen.Id("c")))
+	return codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		cloneMethod,
+		t.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Qual(t.PublicPackage().Path(), t.InterfaceName())},
+		body,
+		fmt.Sprintf(
+			"%s returns a deep copy of this %s, which can be modified without changing this one. Its properties, values, IRIs, and unknown properties are copied.",
+			cloneMethod,
+			t.TypeName()))
+}
+
 // allGetters returns all property Getters for this type.
 func (t *TypeGenerator) allGetters() (m []*codegen.Method) {
 	for _, property := range t.allProperties() {
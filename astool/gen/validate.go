@@ -0,0 +1,270 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+	"sort"
+)
+
+const (
+	validateMethod = "Validate"
+)
+
+// typeRule is a rule on the properties of a type that is stated in the notes of
+// its specification, rather than by the ranges of its properties.
+type typeRule struct {
+	// required are the properties that a value of the type must have.
+	required []string
+	// exclusive are the properties of which a value of the type must have
+	// at most one.
+	exclusive []string
+}
+
+// activityStreamsRules are the rules of the ActivityStreams and ActivityPub
+// specifications, keyed by type name. They also apply to the types extending
+// them.
+var activityStreamsRules = map[string]typeRule{
+	"Accept":   {required: []string{"object"}},
+	"Add":      {required: []string{"object"}},
+	"Announce": {required: []string{"object"}},
+	"Block":    {required: []string{"object"}},
+	"Create":   {required: []string{"object"}},
+	"Delete":   {required: []string{"object"}},
+	"Flag":     {required: []string{"object"}},
+	"Follow":   {required: []string{"object"}},
+	"Like":     {required: []string{"object"}},
+	// A Question "MUST NOT have both properties".
+	"Question": {exclusive: []string{"anyOf", "oneOf"}},
+	"Reject":   {required: []string{"object"}},
+	"Remove":   {required: []string{"object"}},
+	// The type of a Tombstone is the formerType.
+	"Tombstone": {required: []string{"formerType"}},
+	"Undo":      {required: []string{"object"}},
+	"Update":    {required: []string{"object"}},
+}
+
+// typeRules are the rules of each vocabulary, keyed by its URI.
+var typeRules = map[string]map[string]typeRule{
+	"https://www.w3.org/ns/activitystreams": activityStreamsRules,
+}
+
+// rules returns the rules that apply to this type, including the rules of the
+// types it extends.
+func (t *TypeGenerator) rules() (r []typeRule) {
+	var extends map[*TypeGenerator]string
+	extends = t.getAllParentExtends(extends, t)
+	extends[t] = t.TypeName()
+	// Sort the types for the generated code to be stable.
+	types := make([]*TypeGenerator, 0, len(extends))
+	for tg := range extends {
+		types = append(types, tg)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].TypeName() < types[j].TypeName()
+	})
+	for _, tg := range types {
+		if rule, ok := typeRules[tg.vocabURI.String()][tg.TypeName()]; ok {
+			r = append(r, rule)
+		}
+	}
+	return
+}
+
+// isSetCode generates the code determining whether the property of this type
+// has a value.
+func (t *TypeGenerator) isSetCode(p Property) *jen.Statement {
+	member := jen.Id(codegen.This()).Dot(t.memberName(p))
+	if _, ok := p.(*FunctionalPropertyGenerator); ok {
+		return member.Clone().Op("!=").Nil().Op("&&").Add(member.Clone()).Dot(hasAnyMethod).Call()
+	}
+	return member.Clone().Op("!=").Nil().Op("&&").Add(member.Clone()).Dot(lenMethod).Call().Op(">").Lit(0)
+}
+
+// validateMethod returns the method validating the properties of this type
+// and the rules of its specification.
+func (t *TypeGenerator) validateMethod() *codegen.Method {
+	byName := make(map[string]Property)
+	validateCode := jen.Empty()
+	for _, prop := range t.allProperties() {
+		byName[prop.PropertyName()] = prop
+		validateCode = validateCode.Add(
+			jen.If(
+				jen.Id(codegen.This()).Dot(t.memberName(prop)).Op("!=").Nil(),
+			).Block(
+				jen.If(
+					jen.Err().Op(":=").Id(codegen.This()).Dot(t.memberName(prop)).Dot(validateMethod).Call(),
+					jen.Err().Op("!=").Nil(),
+				).Block(
+					jen.Return(
+						jen.Qual("fmt", "Errorf").Call(
+							jen.Lit(fmt.Sprintf("%s: %%v", prop.PropertyName())),
+							jen.Err(),
+						),
+					),
+				),
+			).Line())
+	}
+	// Rules naming properties this type does not have, such as ones
+	// removed by a type extending another, do not apply.
+	for _, rule := range t.rules() {
+		for _, name := range rule.required {
+			prop, ok := byName[name]
+			if !ok {
+				continue
+			}
+			validateCode = validateCode.Add(
+				jen.If(
+					jen.Op("!").Parens(t.isSetCode(prop)),
+				).Block(
+					jen.Return(
+						jen.Qual("fmt", "Errorf").Call(
+							jen.Lit(fmt.Sprintf("%s: required by %s", name, t.TypeName())),
+						),
+					),
+				).Line())
+		}
+		for i, first := range rule.exclusive {
+			for _, second := range rule.exclusive[i+1:] {
+				p1, ok1 := byName[first]
+				p2, ok2 := byName[second]
+				if !ok1 || !ok2 {
+					continue
+				}
+				validateCode = validateCode.Add(
+					jen.If(
+						jen.Parens(t.isSetCode(p1)).Op("&&").Parens(t.isSetCode(p2)),
+					).Block(
+						jen.Return(
+							jen.Qual("fmt", "Errorf").Call(
+								jen.Lit(fmt.Sprintf("%s and %s: at most one is allowed by %s", first, second, t.TypeName())),
+							),
+						),
+					).Line())
+			}
+		}
+	}
+	return codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		validateMethod,
+		t.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Error()},
+		[]jen.Code{
+			validateCode,
+			jen.Return(jen.Nil()),
+		},
+		fmt.Sprintf(
+			"%s returns an error if this %s violates its vocabulary: if a value is out of the range of its property, if an IRI is not absolute, or if a property required by the specification is missing. The error names the property of the first violation found.",
+			validateMethod,
+			t.TypeName()))
+}
+
+// validateMethod returns the method validating the value of this property.
+func (p *FunctionalPropertyGenerator) validateMethod() *codegen.Method {
+	validateCode := jen.Empty()
+	for i, kind := range p.kinds {
+		var check []jen.Code
+		get := jen.Id(codegen.This()).Dot(p.getFnName(i)).Call()
+		if !kind.isValue() {
+			check = []jen.Code{jen.Return(get.Clone().Dot(validateMethod).Call())}
+		} else if kind.IsURI {
+			check = []jen.Code{p.absoluteIRICode(get)}
+		} else if kind.ValidateFn != nil {
+			check = []jen.Code{jen.Return(kind.ValidateFn.Clone().Call(get))}
+		} else {
+			continue
+		}
+		validateCode = validateCode.Add(
+			jen.If(
+				jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call(),
+			).Block(check...).Line())
+	}
+	if !p.hasURIKind() {
+		validateCode = validateCode.Add(
+			jen.If(
+				jen.Id(codegen.This()).Dot(isIRIMethod).Call(),
+			).Block(
+				p.absoluteIRICode(jen.Id(codegen.This()).Dot(iriMember)),
+			).Line())
+	}
+	// A property of only values holds an unknown value when it is out of
+	// range. Other properties may also hold an object of a type from an
+	// extension, but any other unknown value, such as a relative IRI, is
+	// out of range.
+	unknown := jen.Id(codegen.This()).Dot(unknownMemberName)
+	outOfRange := []jen.Code{unknown.Clone().Op("!=").Nil()}
+	if p.hasTypeKind() {
+		outOfRange = []jen.Code{
+			jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Add(unknown.Clone()).Assert(jen.Map(jen.String()).Interface()),
+			unknown.Clone().Op("!=").Nil().Op("&&").Op("!").Id("ok"),
+		}
+	}
+	validateCode = validateCode.Add(
+		jen.If(
+			outOfRange...,
+		).Block(
+			jen.Return(
+				jen.Qual("fmt", "Errorf").Call(
+					jen.Lit("%v is not in the range of the property"),
+					unknown.Clone(),
+				),
+			),
+		).Line())
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		validateMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Error()},
+		[]jen.Code{
+			validateCode,
+			jen.Return(jen.Nil()),
+		},
+		fmt.Sprintf("%s returns an error if the value of this property is out of its range, or is an IRI that is not absolute. Types are validated with their %s method.", validateMethod, validateMethod))
+}
+
+// absoluteIRICode generates the code returning an error if the IRI is not
+// absolute, and nil otherwise.
+func (p *FunctionalPropertyGenerator) absoluteIRICode(iri *jen.Statement) *jen.Statement {
+	return jen.If(
+		jen.Op("!").Add(iri.Clone()).Dot("IsAbs").Call(),
+	).Block(
+		jen.Return(
+			jen.Qual("fmt", "Errorf").Call(
+				jen.Lit("%q is not an absolute IRI"),
+				iri.Clone(),
+			),
+		),
+	).Line().Return(jen.Nil())
+}
+
+// validateMethod returns the method validating each value of this property.
+func (p *NonFunctionalPropertyGenerator) validateMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		validateMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Error()},
+		[]jen.Code{
+			jen.For(
+				jen.List(jen.Id("i"), jen.Id("it")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.If(
+					jen.Err().Op(":=").Id("it").Dot(validateMethod).Call(),
+					jen.Err().Op("!=").Nil(),
+				).Block(
+					jen.Return(
+						jen.Qual("fmt", "Errorf").Call(
+							jen.Lit("value %d: %v"),
+							jen.Id("i"),
+							jen.Err(),
+						),
+					),
+				),
+			),
+			jen.Return(jen.Nil()),
+		},
+		fmt.Sprintf("%s returns an error naming the first value of this property that is out of its range, or is an IRI that is not absolute.", validateMethod))
+}
@@ -0,0 +1,119 @@
+package gen
+
+import (
+	"fmt"
+	"github.com/dave/jennifer/jen"
+	"github.com/go-fed/activity/astool/codegen"
+)
+
+const (
+	rangePropertiesMethod = "RangeProperties"
+	valueMethod           = "Value"
+	valuesMethod          = "Values"
+)
+
+// rangePropertiesMethod returns the method calling a function with the values
+// of each property of this type that has a value.
+func (t *TypeGenerator) rangePropertiesMethod() *codegen.Method {
+	rangeCode := jen.Empty()
+	for _, prop := range t.allProperties() {
+		member := jen.Id(codegen.This()).Dot(t.memberName(prop))
+		if _, ok := prop.(*FunctionalPropertyGenerator); ok {
+			rangeCode = rangeCode.Add(
+				jen.If(
+					member.Clone().Op("!=").Nil(),
+				).Block(
+					jen.If(
+						jen.Id("v").Op(":=").Add(member.Clone()).Dot(valueMethod).Call(),
+						jen.Id("v").Op("!=").Nil().Op("&&").Op("!").Id("fn").Call(
+							jen.Lit(prop.PropertyName()),
+							jen.Index().Interface().Values(jen.Id("v")),
+						),
+					).Block(
+						jen.Return(),
+					),
+				).Line())
+		} else {
+			rangeCode = rangeCode.Add(
+				jen.If(
+					member.Clone().Op("!=").Nil().Op("&&").Add(member.Clone()).Dot(lenMethod).Call().Op(">").Lit(0).Op("&&").Op("!").Id("fn").Call(
+						jen.Lit(prop.PropertyName()),
+						member.Clone().Dot(valuesMethod).Call(),
+					),
+				).Block(
+					jen.Return(),
+				).Line())
+		}
+	}
+	return codegen.NewCommentedValueMethod(
+		t.PrivatePackage().Path(),
+		rangePropertiesMethod,
+		t.StructName(),
+		[]jen.Code{jen.Id("fn").Func().Params(jen.Id("name").String(), jen.Id("values").Index().Interface()).Bool()},
+		/*ret=*/ nil,
+		[]jen.Code{rangeCode},
+		fmt.Sprintf(
+			"%s calls the function with the name and values of each property of this %s that has a value, in the order of their names, until it returns false. Each value is a type, an IRI, or the value of a literal, as returned by the %s method of the property. Unknown properties are not included.",
+			rangePropertiesMethod,
+			t.TypeName(),
+			valueMethod))
+}
+
+// valueMethod returns the method returning the value of this property,
+// whatever its kind.
+func (p *FunctionalPropertyGenerator) valueMethod() *codegen.Method {
+	valueCode := jen.Empty()
+	for i := range p.kinds {
+		valueCode = valueCode.Add(
+			jen.If(
+				jen.Id(codegen.This()).Dot(p.isMethodName(i)).Call(),
+			).Block(
+				jen.Return(jen.Id(codegen.This()).Dot(p.getFnName(i)).Call()),
+			).Line())
+	}
+	if !p.hasURIKind() {
+		valueCode = valueCode.Add(
+			jen.If(
+				jen.Id(codegen.This()).Dot(isIRIMethod).Call(),
+			).Block(
+				jen.Return(jen.Id(codegen.This()).Dot(iriMember)),
+			).Line())
+	}
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		valueMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Interface()},
+		[]jen.Code{
+			valueCode,
+			jen.Return(jen.Id(codegen.This()).Dot(unknownMemberName)),
+		},
+		fmt.Sprintf("%s returns the value of this property: a type, a *url.URL for an IRI, or the value of a literal as returned by its getter. A value out of the range of the property is returned as it was deserialized from JSON. It returns nil if there is no value.", valueMethod))
+}
+
+// valuesMethod returns the method returning the values of this property,
+// whatever their kinds.
+func (p *NonFunctionalPropertyGenerator) valuesMethod() *codegen.Method {
+	return codegen.NewCommentedValueMethod(
+		p.GetPrivatePackage().Path(),
+		valuesMethod,
+		p.StructName(),
+		/*params=*/ nil,
+		[]jen.Code{jen.Index().Interface()},
+		[]jen.Code{
+			jen.Id("v").Op(":=").Make(jen.Index().Interface(), jen.Lit(0), jen.Len(jen.Id(codegen.This()).Dot(propertiesName))),
+			jen.For(
+				jen.List(jen.Id("_"), jen.Id("it")).Op(":=").Range().Id(codegen.This()).Dot(propertiesName),
+			).Block(
+				jen.If(
+					jen.Id("value").Op(":=").Id("it").Dot(valueMethod).Call(),
+					jen.Id("value").Op("!=").Nil(),
+				).Block(
+					jen.Id("v").Op("=").Append(jen.Id("v"), jen.Id("value")),
+				),
+			),
+			jen.Return(jen.Id("v")),
+		},
+		fmt.Sprintf("%s returns the values of this property in order, as returned by the %s method of each of them.", valuesMethod, valueMethod))
+}
@@ -192,6 +192,9 @@ type VocabularyValue struct {
 	SerializeFn    *codegen.Function
 	DeserializeFn  *codegen.Function
 	LessFn         *codegen.Function
+	// ValidateFn is nil when every value of the DefinitionType is in the
+	// range of the value.
+	ValidateFn *codegen.Function
 }
 
 // String returns a printable version of this value for debugging.
@@ -58,6 +58,21 @@ func LessFunction(pkg, valueName string,
 		fmt.Sprintf("%s returns true if the left %s value is less than the right value.", name, valueName))
 }
 
+// ValidateValueFunction is a helper for creating a value's Validate function,
+// for values whose Go type can hold values out of the range of the value.
+func ValidateValueFunction(pkg, valueName string,
+	concreteType jen.Code,
+	impl []jen.Code) *codegen.Function {
+	name := fmt.Sprintf("Validate%s", strings.Title(valueName))
+	return codegen.NewCommentedFunction(
+		pkg,
+		name,
+		[]jen.Code{jen.Id(codegen.This()).Add(concreteType)},
+		[]jen.Code{jen.Error()},
+		impl,
+		fmt.Sprintf("%s returns an error if the %s value is out of its range.", name, valueName))
+}
+
 var _ Ontology = &RDFOntology{}
 
 // RDFOntology is an Ontology for the RDF namespace.
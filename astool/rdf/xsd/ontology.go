@@ -782,6 +782,23 @@ func (n *nonNegativeInteger) Apply(key string, value interface{}, ctx *rdf.Parsi
 						jen.Id("lhs").Op("<").Id("rhs"),
 					),
 				}),
+			ValidateFn: rdf.ValidateValueFunction(
+				n.pkg,
+				nonNegativeIntegerSpec,
+				jen.Id("int"),
+				[]jen.Code{
+					jen.If(
+						jen.Id(codegen.This()).Op("<").Lit(0),
+					).Block(
+						jen.Return(
+							jen.Qual("fmt", "Errorf").Call(
+								jen.Lit("%d is a negative integer for xsd:nonNegativeInteger"),
+								jen.Id(codegen.This()),
+							),
+						),
+					),
+					jen.Return(jen.Nil()),
+				}),
 		}
 		if err = v.SetValue(nonNegativeIntegerSpec, val); err != nil {
 			return true, err
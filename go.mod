@@ -1,10 +1,11 @@
 module github.com/go-fed/activity
 
-go 1.12
+go 1.13
 
 require (
 	github.com/dave/jennifer v1.3.0
 	github.com/go-fed/httpsig v0.1.1-0.20190914113940-c2de3672e5b5
 	github.com/go-test/deep v1.0.1
 	github.com/golang/mock v1.2.0
+	github.com/mattn/go-sqlite3 v1.14.22
 )
@@ -0,0 +1,174 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+const (
+	// DefaultMaxAnnounceDepth is the number of nested Announce levels
+	// dereferenced when the MaxAnnounceDepth is not set.
+	DefaultMaxAnnounceDepth = 2
+	// DefaultMaxAnnounceSize is the size, in bytes, of the largest
+	// announced object stored when the MaxAnnounceSize is not set.
+	DefaultMaxAnnounceSize = 1 << 20
+)
+
+// fetchAnnounced replaces the IRIs in the 'object' property of an Announce
+// with the announced objects, dereferencing and storing the ones not already
+// in the database.
+//
+// IRIs of objects owned by this server, too large, nested deeper than the
+// MaxAnnounceDepth, or that cannot be dereferenced and verified are left
+// as-is.
+func (w FederatingWrappedCallbacks) fetchAnnounced(c context.Context, a vocab.ActivityStreamsAnnounce, op vocab.ActivityStreamsObjectProperty, depth int) error {
+	if op == nil {
+		return nil
+	}
+	maxDepth := w.MaxAnnounceDepth
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxAnnounceDepth
+	}
+	if depth >= maxDepth {
+		return nil
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if !iter.IsIRI() {
+			continue
+		}
+		t, err := w.resolveAnnounced(c, a, iter.GetIRI(), depth)
+		if err != nil {
+			return err
+		} else if t == nil {
+			continue
+		}
+		if err = iter.SetType(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveAnnounced obtains an announced object from the database, or else
+// dereferences it and stores it with Create.
+//
+// Returns a nil value if the object is owned by this server, is too large, or
+// cannot be dereferenced and verified.
+func (w FederatingWrappedCallbacks) resolveAnnounced(c context.Context, a vocab.ActivityStreamsAnnounce, iri *url.URL, depth int) (vocab.Type, error) {
+	// Use the value in the database, if any.
+	t, fetch, err := func() (vocab.Type, bool, error) {
+		if err := w.db.Lock(c, iri); err != nil {
+			return nil, false, err
+		}
+		defer w.db.Unlock(c, iri)
+		if owns, err := w.db.Owns(c, iri); err != nil {
+			return nil, false, err
+		} else if owns {
+			return nil, false, nil
+		}
+		if exists, err := w.db.Exists(c, iri); err != nil {
+			return nil, false, err
+		} else if !exists {
+			return nil, true, nil
+		}
+		t, err := w.db.Get(c, iri)
+		return t, false, err
+	}()
+	if err != nil || !fetch {
+		return t, err
+	}
+	tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
+	if err != nil {
+		return nil, err
+	}
+	// An object that is unreachable, invalid, or not in its origin is not
+	// worth failing the whole Announce for.
+	if t, err = w.dereferenceAnnounced(c, tport, iri); err != nil || t == nil {
+		return nil, nil
+	}
+	// Resolve announce-of-announce chains before storing.
+	if streams.IsOrExtendsActivityStreamsAnnounce(t) {
+		if o, ok := t.(objecter); ok {
+			if err = w.fetchAnnounced(c, a, o.GetActivityStreamsObject(), depth+1); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := w.db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	defer w.db.Unlock(c, iri)
+	// Another request may have stored it in the meantime.
+	if exists, err := w.db.Exists(c, iri); err != nil {
+		return nil, err
+	} else if exists {
+		return w.db.Get(c, iri)
+	}
+	if err = w.db.Create(c, t); err != nil {
+		return nil, err
+	}
+	if err = setFetched(c, w.db, w.clock, iri); err != nil {
+		return nil, err
+	}
+	w.emit(c, EventObjectCreated, a, nil, iri)
+	return t, nil
+}
+
+// dereferenceAnnounced fetches an announced object and ensures it is in the
+// origin of its IRI.
+//
+// Returns a nil value if the object is larger than the MaxAnnounceSize. If the
+// Transport is a LimitedDereferencer, no more than the MaxAnnounceSize is read.
+func (w FederatingWrappedCallbacks) dereferenceAnnounced(c context.Context, tport Transport, iri *url.URL) (vocab.Type, error) {
+	maxSize := w.MaxAnnounceSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxAnnounceSize
+	}
+	var b []byte
+	var err error
+	if ld, ok := tport.(LimitedDereferencer); ok {
+		b, err = ld.DereferenceLimited(c, iri, int64(maxSize))
+		if err == ErrResponseTooLarge {
+			return nil, nil
+		}
+	} else {
+		b, err = tport.Dereference(c, iri)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > maxSize {
+		return nil, nil
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	t, err := streams.ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	id, err := GetId(t)
+	if err != nil {
+		return nil, err
+	}
+	if id.String() != iri.String() {
+		return nil, &OriginMismatchError{
+			Origin:   iri.Host,
+			Property: "id",
+			IRI:      id,
+		}
+	}
+	if err = mustHaveObjectInOrigin(iri.Host, t); err != nil {
+		return nil, err
+	}
+	if a, ok := t.(Activity); ok {
+		if err = mustHaveActivityOriginMatchActors(a); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
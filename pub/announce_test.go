@@ -0,0 +1,262 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// limitedTransport is a Transport that also is a LimitedDereferencer.
+type limitedTransport struct {
+	*MockTransport
+	*MockLimitedDereferencer
+}
+
+// newAnnounceOf creates an Announce of the object IRI by a federated actor.
+func newAnnounceOf(id, object string) vocab.ActivityStreamsAnnounce {
+	announce := streams.NewActivityStreamsAnnounce()
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(mustParse(id))
+	announce.SetJSONLDId(idProp)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(mustParse(testFederatedActorIRI))
+	announce.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(object))
+	announce.SetActivityStreamsObject(op)
+	return announce
+}
+
+// TestFetchAnnounced ensures announced objects are dereferenced, verified,
+// and stored when FetchAnnounced is set.
+func TestFetchAnnounced(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, tp *MockTransport, w FederatingWrappedCallbacks, announced *vocab.ActivityStreamsAnnounce) {
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		announced = new(vocab.ActivityStreamsAnnounce)
+		w = FederatingWrappedCallbacks{
+			Announce: func(c context.Context, a vocab.ActivityStreamsAnnounce) error {
+				*announced = a
+				return nil
+			},
+			FetchAnnounced: true,
+			db:             db,
+			newTransport: func(c context.Context, a *url.URL, s string) (Transport, error) {
+				return tp, nil
+			},
+		}
+		return
+	}
+	t.Run("StoresAndEmbedsDereferencedObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w, announced := setupFn(ctl)
+		noteId := mustParse(testFederatedNoteIRI)
+		note := newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)
+		var created vocab.Type
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			tp.EXPECT().Dereference(ctx, noteId).Return(mustSerializeToBytes(note), nil),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Create(ctx, gomock.Any()).Do(func(c context.Context, v vocab.Type) {
+				created = v
+			}),
+			db.EXPECT().Unlock(ctx, noteId),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(created), mustSerializeToBytes(note))
+		op := (*announced).GetActivityStreamsObject()
+		assertEqual(t, op.At(0).IsActivityStreamsNote(), true)
+	})
+	t.Run("EmbedsStoredObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, w, announced := setupFn(ctl)
+		noteId := mustParse(testFederatedNoteIRI)
+		note := newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(true, nil),
+			db.EXPECT().Get(ctx, noteId).Return(note, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		op := (*announced).GetActivityStreamsObject()
+		assertEqual(t, op.At(0).IsActivityStreamsNote(), true)
+	})
+	t.Run("StopsAtMaxDepth", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w, announced := setupFn(ctl)
+		w.MaxAnnounceDepth = 1
+		boostId := mustParse(testFederatedActivityIRI2)
+		boost := newAnnounceOf(testFederatedActivityIRI2, testFederatedNoteIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, boostId),
+			db.EXPECT().Owns(ctx, boostId).Return(false, nil),
+			db.EXPECT().Exists(ctx, boostId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, boostId),
+			tp.EXPECT().Dereference(ctx, boostId).Return(mustSerializeToBytes(boost), nil),
+			db.EXPECT().Lock(ctx, boostId),
+			db.EXPECT().Exists(ctx, boostId).Return(false, nil),
+			db.EXPECT().Create(ctx, gomock.Any()),
+			db.EXPECT().Unlock(ctx, boostId),
+			db.EXPECT().Lock(ctx, boostId),
+			db.EXPECT().Owns(ctx, boostId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, boostId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedActivityIRI2))
+		// Verify
+		assertEqual(t, err, nil)
+		inner := (*announced).GetActivityStreamsObject().At(0).GetActivityStreamsAnnounce()
+		assertEqual(t, inner.GetActivityStreamsObject().At(0).IsIRI(), true)
+	})
+	t.Run("DoesNotStoreObjectOverMaxSize", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w, announced := setupFn(ctl)
+		w.MaxAnnounceSize = 16
+		noteId := mustParse(testFederatedNoteIRI)
+		note := newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			tp.EXPECT().Dereference(ctx, noteId).Return(mustSerializeToBytes(note), nil),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, (*announced).GetActivityStreamsObject().At(0).IsIRI(), true)
+	})
+	t.Run("SkipsObjectNotInOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w, announced := setupFn(ctl)
+		noteId := mustParse(testFederatedNoteIRI)
+		note := newOriginNote(testFederatedNoteIRI, testPersonIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			tp.EXPECT().Dereference(ctx, noteId).Return(mustSerializeToBytes(note), nil),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, (*announced).GetActivityStreamsObject().At(0).IsIRI(), true)
+	})
+	t.Run("SkipsUnreachableObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w, announced := setupFn(ctl)
+		noteId := mustParse(testFederatedNoteIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			tp.EXPECT().Dereference(ctx, noteId).Return(nil, errors.New("404")),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, (*announced).GetActivityStreamsObject().At(0).IsIRI(), true)
+	})
+	t.Run("LimitsDereferencedSize", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w, announced := setupFn(ctl)
+		ld := NewMockLimitedDereferencer(ctl)
+		w.newTransport = func(c context.Context, a *url.URL, s string) (Transport, error) {
+			return limitedTransport{tp, ld}, nil
+		}
+		w.MaxAnnounceSize = 16
+		noteId := mustParse(testFederatedNoteIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			ld.EXPECT().DereferenceLimited(ctx, noteId, int64(16)).Return(nil, ErrResponseTooLarge),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.announce(ctx, newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, (*announced).GetActivityStreamsObject().At(0).IsIRI(), true)
+	})
+	t.Run("LeavesReceivedActivityUnchanged", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, w, announced := setupFn(ctl)
+		noteId := mustParse(testFederatedNoteIRI)
+		note := newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Exists(ctx, noteId).Return(true, nil),
+			db.EXPECT().Get(ctx, noteId).Return(note, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Owns(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		a := newAnnounceOf(testFederatedActivityIRI, testFederatedNoteIRI)
+		expected := mustSerializeToBytes(a)
+		// Run
+		err := w.announce(ctx, a)
+		// Verify
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(a), expected)
+		assertEqual(t, (*announced).GetActivityStreamsObject().At(0).IsActivityStreamsNote(), true)
+	})
+}
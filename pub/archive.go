@@ -0,0 +1,590 @@
+package pub
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"io"
+	"io/ioutil"
+	"net/url"
+)
+
+const (
+	// ArchiveVersion is the version of the archive format written by
+	// ExportActor, and the only one read by ImportActor.
+	ArchiveVersion = 1
+	// archiveManifest is the file name of the ArchiveManifest.
+	archiveManifest = "manifest.json"
+	// archiveActor is the file name of the serialized actor.
+	archiveActor = "actor.json"
+	// archiveFollowers is the file name of the serialized followers.
+	archiveFollowers = "followers.json"
+	// archiveFollowing is the file name of the serialized following.
+	archiveFollowing = "following.json"
+	// archiveLiked is the file name of the serialized liked.
+	archiveLiked = "liked.json"
+	// MaxArchiveFileSize is the size, in bytes, of the largest file of an
+	// archive read by ImportActor.
+	MaxArchiveFileSize = 16 << 20
+)
+
+var (
+	// ErrArchiveVersion indicates that an archive has a version other than
+	// ArchiveVersion.
+	ErrArchiveVersion = errors.New("unsupported archive version")
+	// ErrOutboxPaged indicates that an outbox cannot be exported whole, as
+	// GetOutbox returns a page with a next one and the Database is not a
+	// CollectionDatabase.
+	ErrOutboxPaged = errors.New("outbox has more than one page")
+	// ErrArchiveFileTooLarge indicates that a file of an archive is larger
+	// than MaxArchiveFileSize.
+	ErrArchiveFileTooLarge = errors.New("archive file is too large")
+)
+
+// archiveActorProperties are the properties of an archived actor which are not
+// copied onto the actor importing it, as they are specific to a server.
+var archiveActorProperties = map[string]bool{
+	"@context":          true,
+	"id":                true,
+	"type":              true,
+	"inbox":             true,
+	"outbox":            true,
+	"followers":         true,
+	"following":         true,
+	"liked":             true,
+	"streams":           true,
+	"endpoints":         true,
+	"publicKey":         true,
+	"preferredUsername": true,
+}
+
+// ArchiveManifest describes the contents of an actor archive.
+//
+// An archive is a zip of JSON-LD documents: the manifest in "manifest.json",
+// the actor in "actor.json", its followers, following, and liked collections
+// in "followers.json", "following.json", and "liked.json", and the actor's
+// activities and objects in the files listed in Objects.
+type ArchiveManifest struct {
+	// Version is the version of the archive format.
+	Version int `json:"version"`
+	// Actor is the id of the exported actor.
+	Actor string `json:"actor"`
+	// Outbox are the ids of the activities of the actor's outbox, newest
+	// first.
+	Outbox []string `json:"outbox"`
+	// Objects are the file names of the activities and objects owned by
+	// the actor.
+	Objects []string `json:"objects"`
+}
+
+// ExportActor writes an archive of everything the local actor owns to 'w': its
+// profile, the activities of its outbox and the objects they own, and its
+// followers, following, and liked collections.
+//
+// All the items of the outbox are exported when the Database is a
+// CollectionDatabase. Otherwise, the ones of the page returned by GetOutbox
+// are, and ErrOutboxPaged is returned if it has a next page.
+func ExportActor(c context.Context, db Database, actorIRI *url.URL, w io.Writer) error {
+	err := db.Lock(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	actor, err := db.Get(c, actorIRI)
+	db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	if err != nil {
+		return err
+	}
+	ob, ok := actor.(outboxer)
+	if !ok || ob.GetActivityStreamsOutbox() == nil {
+		return fmt.Errorf("actor %s has no outbox", actorIRI)
+	}
+	outboxIRI, err := ToId(ob.GetActivityStreamsOutbox())
+	if err != nil {
+		return err
+	}
+	outbox, err := outboxItems(c, db, outboxIRI)
+	if err != nil {
+		return err
+	}
+	// Collect the owned activities, and the owned objects they refer to.
+	var objects []vocab.Type
+	seen := make(map[string]bool)
+	collect := func(id *url.URL) error {
+		if seen[id.String()] {
+			return nil
+		}
+		seen[id.String()] = true
+		if err := db.Lock(c, id); err != nil {
+			return err
+		}
+		defer db.Unlock(c, id)
+		if owns, err := db.Owns(c, id); err != nil {
+			return err
+		} else if !owns {
+			return nil
+		}
+		if exists, err := db.Exists(c, id); err != nil {
+			return err
+		} else if !exists {
+			return nil
+		}
+		t, err := db.Get(c, id)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, t)
+		return nil
+	}
+	manifest := ArchiveManifest{
+		Version: ArchiveVersion,
+		Actor:   actorIRI.String(),
+	}
+	for _, id := range outbox {
+		manifest.Outbox = append(manifest.Outbox, id.String())
+		n := len(objects)
+		if err = collect(id); err != nil {
+			return err
+		} else if len(objects) == n {
+			continue
+		}
+		o, ok := objects[n].(objecter)
+		if !ok || o.GetActivityStreamsObject() == nil {
+			continue
+		}
+		for iter := o.GetActivityStreamsObject().Begin(); iter != o.GetActivityStreamsObject().End(); iter = iter.Next() {
+			oid, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			if err = collect(oid); err != nil {
+				return err
+			}
+		}
+	}
+	err = db.Lock(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	// WARNING: Unlock not deferred.
+	followers, err := db.Followers(c, actorIRI)
+	if err != nil {
+		db.Unlock(c, actorIRI)
+		return err
+	}
+	following, err := db.Following(c, actorIRI)
+	if err != nil {
+		db.Unlock(c, actorIRI)
+		return err
+	}
+	liked, err := db.Liked(c, actorIRI)
+	db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	if err != nil {
+		return err
+	}
+	// Write the archive.
+	z := zip.NewWriter(w)
+	files := []struct {
+		name string
+		t    vocab.Type
+	}{
+		{archiveActor, actor},
+		{archiveFollowers, followers},
+		{archiveFollowing, following},
+		{archiveLiked, liked},
+	}
+	for i, t := range objects {
+		name := fmt.Sprintf("objects/%d.json", i)
+		manifest.Objects = append(manifest.Objects, name)
+		files = append(files, struct {
+			name string
+			t    vocab.Type
+		}{name, t})
+	}
+	for _, f := range files {
+		m, err := streams.Serialize(f.t)
+		if err != nil {
+			return err
+		}
+		if err = writeArchiveFile(z, f.name, m); err != nil {
+			return err
+		}
+	}
+	if err = writeArchiveFile(z, archiveManifest, manifest); err != nil {
+		return err
+	}
+	return z.Close()
+}
+
+// ImportActor reads an archive written by ExportActor into the Database, for
+// the existing local actor at 'actorIRI'.
+//
+// The archived activities and objects are created with new ids from NewId, and
+// every reference to them, to the archived actor, or to its collections is
+// rewritten to the new ids. The activities are added to the actor's outbox,
+// the items of the archived collections are added to the actor's followers,
+// following, and liked collections, and the archived profile properties, such
+// as 'name' and 'summary', are copied onto the actor.
+//
+// Followers are not notified of the move; the application is expected to
+// federate a Move activity to them. ImportActor returns the new ids, keyed by
+// the archived ones.
+//
+// ErrArchiveFileTooLarge is returned if a file of the archive is larger than
+// MaxArchiveFileSize.
+func ImportActor(c context.Context, db Database, r io.ReaderAt, size int64, actorIRI *url.URL) (ids map[string]*url.URL, err error) {
+	z, err := zip.NewReader(r, size)
+	if err != nil {
+		return
+	}
+	files := make(map[string]*zip.File, len(z.File))
+	for _, f := range z.File {
+		files[f.Name] = f
+	}
+	var manifest ArchiveManifest
+	if err = readArchiveFile(files, archiveManifest, &manifest); err != nil {
+		return
+	} else if manifest.Version != ArchiveVersion {
+		err = ErrArchiveVersion
+		return
+	}
+	var oldActor map[string]interface{}
+	if err = readArchiveFile(files, archiveActor, &oldActor); err != nil {
+		return
+	}
+	// Map the archived actor and its collections to the local ones.
+	newActor, err := db.Get(c, actorIRI)
+	if err != nil {
+		return
+	}
+	newActorM, err := streams.Serialize(newActor)
+	if err != nil {
+		return
+	}
+	ids = map[string]*url.URL{manifest.Actor: actorIRI}
+	for _, p := range []string{"inbox", "outbox", "followers", "following", "liked"} {
+		oldIRI, ok := oldActor[p].(string)
+		if !ok {
+			continue
+		}
+		newIRI, ok := newActorM[p].(string)
+		if !ok {
+			continue
+		}
+		var u *url.URL
+		if u, err = url.Parse(newIRI); err != nil {
+			return
+		}
+		ids[oldIRI] = u
+	}
+	// Assign new ids to the activities and objects.
+	objects := make([]map[string]interface{}, 0, len(manifest.Objects))
+	for _, name := range manifest.Objects {
+		var m map[string]interface{}
+		if err = readArchiveFile(files, name, &m); err != nil {
+			return
+		}
+		oldId, ok := m["id"].(string)
+		if !ok {
+			err = fmt.Errorf("archived %s has no id", name)
+			return
+		}
+		var t vocab.Type
+		if t, err = streams.ToType(c, m); err != nil {
+			return
+		}
+		var newId *url.URL
+		if newId, err = db.NewId(c, t); err != nil {
+			return
+		}
+		ids[oldId] = newId
+		objects = append(objects, m)
+	}
+	// Create the activities and objects.
+	for _, m := range objects {
+		var t vocab.Type
+		if t, err = streams.ToType(c, rewriteIds(m, ids).(map[string]interface{})); err != nil {
+			return
+		}
+		if err = importCreate(c, db, t); err != nil {
+			return
+		}
+	}
+	// Add the activities to the outbox, oldest first.
+	if ob, ok := newActor.(outboxer); ok && ob.GetActivityStreamsOutbox() != nil {
+		var outboxIRI *url.URL
+		if outboxIRI, err = ToId(ob.GetActivityStreamsOutbox()); err != nil {
+			return
+		}
+		var outbox []*url.URL
+		for i := len(manifest.Outbox) - 1; i >= 0; i-- {
+			if id, ok := ids[manifest.Outbox[i]]; ok {
+				outbox = append(outbox, id)
+			}
+		}
+		if err = prependOutboxItems(c, db, outboxIRI, outbox); err != nil {
+			return
+		}
+	}
+	// Merge the collections.
+	for _, col := range []struct {
+		name string
+		fn   func(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error)
+	}{
+		{archiveFollowers, db.Followers},
+		{archiveFollowing, db.Following},
+		{archiveLiked, db.Liked},
+	} {
+		var m map[string]interface{}
+		if err = readArchiveFile(files, col.name, &m); err != nil {
+			return
+		}
+		var t vocab.Type
+		if t, err = streams.ToType(c, rewriteIds(m, ids).(map[string]interface{})); err != nil {
+			return
+		}
+		if err = importCollectionItems(c, db, actorIRI, t, col.fn); err != nil {
+			return
+		}
+	}
+	// Copy the profile onto the actor.
+	for k, v := range oldActor {
+		if !archiveActorProperties[k] {
+			newActorM[k] = rewriteIds(v, ids)
+		}
+	}
+	if newActor, err = streams.ToType(c, newActorM); err != nil {
+		return
+	}
+	err = db.Lock(c, actorIRI)
+	if err != nil {
+		return
+	}
+	// WARNING: Unlock not deferred.
+	err = db.Update(c, newActor)
+	db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	return
+}
+
+// outboxItems returns the ids of the items of the outbox, newest first.
+func outboxItems(c context.Context, db Database, outboxIRI *url.URL) (items []*url.URL, err error) {
+	err = db.Lock(c, outboxIRI)
+	if err != nil {
+		return
+	}
+	defer db.Unlock(c, outboxIRI)
+	if cdb, ok := db.(CollectionDatabase); ok {
+		var n int
+		if n, err = cdb.CountItems(c, outboxIRI); err != nil {
+			return
+		}
+		return cdb.GetItems(c, outboxIRI, 0, n)
+	}
+	outbox, err := db.GetOutbox(c, outboxIRI)
+	if err != nil {
+		return
+	}
+	if next := outbox.GetActivityStreamsNext(); next != nil && next.HasAny() {
+		err = ErrOutboxPaged
+		return
+	}
+	oi := outbox.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		return
+	}
+	for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+		var id *url.URL
+		if id, err = ToId(iter); err != nil {
+			return
+		}
+		items = append(items, id)
+	}
+	return
+}
+
+// prependOutboxItems prepends the items to the outbox in order, so the last one
+// becomes the newest.
+func prependOutboxItems(c context.Context, db Database, outboxIRI *url.URL, items []*url.URL) error {
+	if len(items) == 0 {
+		return nil
+	}
+	err := db.Lock(c, outboxIRI)
+	if err != nil {
+		return err
+	}
+	defer db.Unlock(c, outboxIRI)
+	if cdb, ok := db.(CollectionDatabase); ok {
+		for _, id := range items {
+			if err = cdb.PrependItem(c, outboxIRI, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	outbox, err := db.GetOutbox(c, outboxIRI)
+	if err != nil {
+		return err
+	}
+	oi := outbox.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	for _, id := range items {
+		oi.PrependIRI(id)
+	}
+	outbox.SetActivityStreamsOrderedItems(oi)
+	return db.SetOutbox(c, outbox)
+}
+
+// importCreate creates the imported value, unless its id already exists.
+func importCreate(c context.Context, db Database, t vocab.Type) error {
+	id, err := GetId(t)
+	if err != nil {
+		return err
+	}
+	err = db.Lock(c, id)
+	if err != nil {
+		return err
+	}
+	defer db.Unlock(c, id)
+	if exists, err := db.Exists(c, id); err != nil {
+		return err
+	} else if exists {
+		return nil
+	}
+	return db.Create(c, t)
+}
+
+// importCollectionItems adds the items of the archived collection that are
+// missing from the actor's collection.
+func importCollectionItems(c context.Context, db Database, actorIRI *url.URL, archived vocab.Type, get func(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error)) error {
+	var items []*url.URL
+	if i, ok := archived.(itemser); ok && i.GetActivityStreamsItems() != nil {
+		for iter := i.GetActivityStreamsItems().Begin(); iter != i.GetActivityStreamsItems().End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			items = append(items, id)
+		}
+	} else if oi, ok := archived.(orderedItemser); ok && oi.GetActivityStreamsOrderedItems() != nil {
+		for iter := oi.GetActivityStreamsOrderedItems().Begin(); iter != oi.GetActivityStreamsOrderedItems().End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			items = append(items, id)
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	col, err := get(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	colId, err := GetId(col)
+	if err != nil {
+		return err
+	}
+	err = db.Lock(c, colId)
+	if err != nil {
+		return err
+	}
+	defer db.Unlock(c, colId)
+	// Get the collection again now that it is locked.
+	col, err = get(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	ip := col.GetActivityStreamsItems()
+	if ip == nil {
+		ip = streams.NewActivityStreamsItemsProperty()
+	}
+	existing := make(map[string]bool, ip.Len())
+	for iter := ip.Begin(); iter != ip.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		existing[id.String()] = true
+	}
+	for _, id := range items {
+		if !existing[id.String()] {
+			existing[id.String()] = true
+			ip.AppendIRI(id)
+		}
+	}
+	col.SetActivityStreamsItems(ip)
+	return db.Update(c, col)
+}
+
+// rewriteIds returns a copy of the serialized value with every string equal to
+// an archived id replaced by its new id.
+func rewriteIds(v interface{}, ids map[string]*url.URL) interface{} {
+	switch t := v.(type) {
+	case string:
+		if id, ok := ids[t]; ok {
+			return id.String()
+		}
+		return t
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			if k == "@context" {
+				m[k] = e
+			} else {
+				m[k] = rewriteIds(e, ids)
+			}
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, e := range t {
+			s[i] = rewriteIds(e, ids)
+		}
+		return s
+	}
+	return v
+}
+
+// writeArchiveFile writes the value as a JSON file of the archive.
+func writeArchiveFile(z *zip.Writer, name string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := z.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	return err
+}
+
+// readArchiveFile reads a JSON file of the archive into the value.
+func readArchiveFile(files map[string]*zip.File, name string, v interface{}) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("archive has no %s", name)
+	}
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(io.LimitReader(r, MaxArchiveFileSize+1))
+	if err != nil {
+		return err
+	} else if len(b) > MaxArchiveFileSize {
+		return ErrArchiveFileTooLarge
+	}
+	return json.Unmarshal(b, v)
+}
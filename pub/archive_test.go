@@ -0,0 +1,130 @@
+package pub
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// newTestArchive creates a zip with the manifest.
+func newTestArchive(t *testing.T, manifest ArchiveManifest) *bytes.Reader {
+	var b bytes.Buffer
+	z := zip.NewWriter(&b)
+	if err := writeArchiveFile(z, archiveManifest, manifest); err != nil {
+		t.Fatal(err)
+	}
+	if err := z.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(b.Bytes())
+}
+
+func TestExportActor(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ErrorIfOutboxPaged", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		actorIRI := mustParse(testMyActorIRI)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actor := streams.NewActivityStreamsPerson()
+		outbox := streams.NewActivityStreamsOutboxProperty()
+		outbox.SetIRI(outboxIRI)
+		actor.SetActivityStreamsOutbox(outbox)
+		page := streams.NewActivityStreamsOrderedCollectionPage()
+		next := streams.NewActivityStreamsNextProperty()
+		next.SetIRI(mustParse(testMyOutboxIRI + "?page=2"))
+		page.SetActivityStreamsNext(next)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Get(ctx, actorIRI).Return(actor, nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().GetOutbox(ctx, outboxIRI).Return(page, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+		)
+		// Run
+		err := ExportActor(ctx, db, actorIRI, &bytes.Buffer{})
+		// Verify
+		assertEqual(t, err, ErrOutboxPaged)
+	})
+}
+
+func TestImportActor(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ErrorIfUnsupportedVersion", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		r := newTestArchive(t, ArchiveManifest{Version: ArchiveVersion + 1})
+		// Run
+		_, err := ImportActor(ctx, db, r, r.Size(), mustParse(testMyActorIRI))
+		// Verify
+		assertEqual(t, err, ErrArchiveVersion)
+	})
+	t.Run("ErrorIfNoActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		r := newTestArchive(t, ArchiveManifest{Version: ArchiveVersion})
+		// Run
+		_, err := ImportActor(ctx, db, r, r.Size(), mustParse(testMyActorIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+	t.Run("ErrorIfFileTooLarge", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		var b bytes.Buffer
+		z := zip.NewWriter(&b)
+		f, err := z.Create(archiveManifest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = f.Write(bytes.Repeat([]byte(" "), MaxArchiveFileSize+1)); err != nil {
+			t.Fatal(err)
+		}
+		if err = z.Close(); err != nil {
+			t.Fatal(err)
+		}
+		r := bytes.NewReader(b.Bytes())
+		// Run
+		_, err = ImportActor(ctx, db, r, r.Size(), mustParse(testMyActorIRI))
+		// Verify
+		assertEqual(t, err, ErrArchiveFileTooLarge)
+	})
+}
+
+func TestRewriteIds(t *testing.T) {
+	ids := map[string]*url.URL{
+		testFederatedActorIRI: mustParse(testMyActorIRI),
+		testNoteId1:           mustParse(testNoteId2),
+	}
+	v := map[string]interface{}{
+		"@context": testFederatedActorIRI,
+		"id":       testNoteId1,
+		"attributedTo": []interface{}{
+			testFederatedActorIRI,
+			map[string]interface{}{"id": testFederatedActorIRI2},
+		},
+		"content": "Unchanged",
+	}
+	m := rewriteIds(v, ids).(map[string]interface{})
+	assertEqual(t, m["@context"], testFederatedActorIRI)
+	assertEqual(t, m["id"], testNoteId2)
+	attr := m["attributedTo"].([]interface{})
+	assertEqual(t, attr[0], testMyActorIRI)
+	assertEqual(t, attr[1].(map[string]interface{})["id"], testFederatedActorIRI2)
+	assertEqual(t, m["content"], "Unchanged")
+	// The original is left unchanged.
+	assertEqual(t, v["id"], testNoteId1)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/go-fed/activity/pub/integrity"
 	"github.com/go-fed/activity/streams"
 	"github.com/go-fed/activity/streams/vocab"
 	"io/ioutil"
@@ -207,6 +208,25 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
 	}
+	// Reject payloads the application considers invalid.
+	if v, ok := b.inboxValidator(); ok {
+		if err = v.Validate(c, activity); IsValidationError(err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return true, nil
+		} else if err != nil {
+			return true, err
+		}
+	}
+	// Reject payloads whose Data Integrity proofs are invalid or not made
+	// by their actors.
+	if kr, ok := b.proofKeyResolver(); ok {
+		if err = verifyProof(c, kr, m, activity); integrity.IsVerificationError(err) || IsProofError(err) {
+			w.WriteHeader(http.StatusForbidden)
+			return true, nil
+		} else if err != nil {
+			return true, err
+		}
+	}
 	// Allow server implementations to set context data with a hook.
 	c, err = b.delegate.PostInboxRequestBodyHook(c, r, activity)
 	if err != nil {
@@ -229,7 +249,7 @@ func (b *baseActor) PostInbox(c context.Context, w http.ResponseWriter, r *http.
 		// target properties needed to be populated, but weren't.
 		//
 		// Send the rejection to the peer.
-		if err == ErrObjectRequired || err == ErrTargetRequired {
+		if err == ErrObjectRequired || err == ErrTargetRequired || IsOriginMismatchError(err) {
 			w.WriteHeader(http.StatusBadRequest)
 			return true, nil
 		}
@@ -315,6 +335,24 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	} else if !authenticated {
 		return true, nil
 	}
+	// A retried request is answered with the activity it created before.
+	outboxId := requestId(r)
+	key := r.Header.Get(idempotencyKeyHeader)
+	store, isIdempotent := b.idempotencyStore()
+	isIdempotent = isIdempotent && key != ""
+	if isIdempotent {
+		if err := store.LockIdempotencyKey(c, outboxId, key); err != nil {
+			return true, err
+		}
+		defer store.UnlockIdempotencyKey(c, outboxId, key)
+		if id, err := store.GetIdempotencyKey(c, outboxId, key); err != nil {
+			return true, err
+		} else if id != nil {
+			w.Header().Set(locationHeader, id.String())
+			w.WriteHeader(http.StatusCreated)
+			return true, nil
+		}
+	}
 	// Everything is good to begin processing the request.
 	raw, err := ioutil.ReadAll(r.Body)
 	if err != nil {
@@ -336,6 +374,27 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 		w.WriteHeader(http.StatusBadRequest)
 		return true, nil
 	}
+	// Reject payloads the application considers invalid.
+	if v, ok := b.outboxValidator(); ok {
+		if err = v.Validate(c, asValue); IsValidationError(err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return true, nil
+		} else if err != nil {
+			return true, err
+		}
+	}
+	// Copy text without a language into language maps, in both the value
+	// and the JSON given to the side effects.
+	if d, ok := b.defaultLanguager(); ok {
+		if lang := d.DefaultLanguage(c, outboxId); len(lang) > 0 {
+			normalizeLanguage(asValue, lang)
+			var n map[string]interface{}
+			if n, err = streams.Serialize(asValue); err != nil {
+				return true, err
+			}
+			copyLanguageMaps(m, n)
+		}
+	}
 	// Allow server implementations to set context data with a hook.
 	c, err = b.delegate.PostOutboxRequestBodyHook(c, r, asValue)
 	if err != nil {
@@ -343,7 +402,6 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	}
 	// The HTTP request steps are complete, complete the rest of the outbox
 	// and delivery process.
-	outboxId := requestId(r)
 	activity, err := b.deliver(c, outboxId, asValue, m)
 	// Special case: We know it is a bad request if the object or
 	// target properties needed to be populated, but weren't.
@@ -355,12 +413,77 @@ func (b *baseActor) PostOutbox(c context.Context, w http.ResponseWriter, r *http
 	} else if err != nil {
 		return true, err
 	}
+	if isIdempotent {
+		if err := store.SetIdempotencyKey(c, outboxId, key, activity.GetJSONLDId().Get()); err != nil {
+			return true, err
+		}
+	}
 	// Respond to the request with the new Activity's IRI location.
 	w.Header().Set(locationHeader, activity.GetJSONLDId().Get().String())
 	w.WriteHeader(http.StatusCreated)
 	return true, nil
 }
 
+// idempotencyStore returns the IdempotencyStore of the delegate, or of the
+// SocialProtocol of a sideEffectActor, if any.
+func (b *baseActor) idempotencyStore() (IdempotencyStore, bool) {
+	if s, ok := b.delegate.(IdempotencyStore); ok {
+		return s, true
+	} else if a, ok := b.delegate.(*sideEffectActor); ok {
+		s, ok := a.c2s.(IdempotencyStore)
+		return s, ok
+	}
+	return nil, false
+}
+
+// eventSubscriber returns the EventSubscriber of the delegate, or of the
+// CommonBehavior of a sideEffectActor, if any.
+func (b *baseActor) eventSubscriber() (EventSubscriber, bool) {
+	if s, ok := b.delegate.(EventSubscriber); ok {
+		return s, true
+	} else if a, ok := b.delegate.(*sideEffectActor); ok {
+		s, ok := a.common.(EventSubscriber)
+		return s, ok
+	}
+	return nil, false
+}
+
+// inboxValidator returns the Validator of the delegate, or of the
+// FederatingProtocol of a sideEffectActor, if any.
+func (b *baseActor) inboxValidator() (Validator, bool) {
+	if v, ok := b.delegate.(Validator); ok {
+		return v, true
+	} else if a, ok := b.delegate.(*sideEffectActor); ok {
+		v, ok := a.s2s.(Validator)
+		return v, ok
+	}
+	return nil, false
+}
+
+// outboxValidator returns the Validator of the delegate, or of the
+// SocialProtocol of a sideEffectActor, if any.
+func (b *baseActor) outboxValidator() (Validator, bool) {
+	if v, ok := b.delegate.(Validator); ok {
+		return v, true
+	} else if a, ok := b.delegate.(*sideEffectActor); ok {
+		v, ok := a.c2s.(Validator)
+		return v, ok
+	}
+	return nil, false
+}
+
+// proofKeyResolver returns the integrity.KeyResolver of the delegate, or of
+// the FederatingProtocol of a sideEffectActor, if any.
+func (b *baseActor) proofKeyResolver() (integrity.KeyResolver, bool) {
+	if kr, ok := b.delegate.(integrity.KeyResolver); ok {
+		return kr, true
+	} else if a, ok := b.delegate.(*sideEffectActor); ok {
+		kr, ok := a.s2s.(integrity.KeyResolver)
+		return kr, ok
+	}
+	return nil, false
+}
+
 // GetOutbox implements the generic algorithm for handling a Get request to an
 // actor's outbox independent on an application. It relies on a delegate to
 // implement application specific functionality.
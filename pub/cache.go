@@ -0,0 +1,165 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+const (
+	// DefaultCacheMaxAge is the CachePolicy MaxAge when it is not set.
+	DefaultCacheMaxAge = 30 * 24 * time.Hour
+	// DefaultCacheInterval is the CachePolicy Interval when it is not set.
+	DefaultCacheInterval = time.Hour
+	// DefaultCacheBatchSize is the CachePolicy BatchSize when it is not set.
+	DefaultCacheBatchSize = 100
+)
+
+var (
+	// ErrNotCacheDatabase indicates that a CacheSweeper was created with a
+	// Database that is not a CacheDatabase.
+	ErrNotCacheDatabase = errors.New("database is not a CacheDatabase")
+)
+
+// CachePolicy configures which federated data a CacheSweeper evicts, and how
+// often.
+type CachePolicy struct {
+	// MaxAge is how long federated data is kept after it was last fetched
+	// or accessed. DefaultCacheMaxAge is used if zero.
+	MaxAge time.Duration
+	// Interval is the time between sweeps of Run. DefaultCacheInterval is
+	// used if zero.
+	Interval time.Duration
+	// BatchSize is the number of stale entries examined at once.
+	// DefaultCacheBatchSize is used if zero.
+	BatchSize int
+	// MaxEvictions bounds the number of entries evicted by a sweep, so it
+	// does not hold up the Database for long. There is no bound if zero.
+	MaxEvictions int
+}
+
+// CacheSweeper evicts the federated data of a CacheDatabase that is stale and
+// no longer referenced locally.
+//
+// Stale entries that are still referenced are marked as accessed, so they are
+// kept for another MaxAge before being examined again.
+type CacheSweeper struct {
+	db     Database
+	cdb    CacheDatabase
+	clock  Clock
+	policy CachePolicy
+}
+
+// NewCacheSweeper creates a CacheSweeper for the Database, which must be a
+// CacheDatabase.
+func NewCacheSweeper(db Database, clock Clock, policy CachePolicy) (*CacheSweeper, error) {
+	cdb, ok := db.(CacheDatabase)
+	if !ok {
+		return nil, ErrNotCacheDatabase
+	}
+	if policy.MaxAge == 0 {
+		policy.MaxAge = DefaultCacheMaxAge
+	}
+	if policy.Interval == 0 {
+		policy.Interval = DefaultCacheInterval
+	}
+	if policy.BatchSize == 0 {
+		policy.BatchSize = DefaultCacheBatchSize
+	}
+	return &CacheSweeper{
+		db:     db,
+		cdb:    cdb,
+		clock:  clock,
+		policy: policy,
+	}, nil
+}
+
+// Run sweeps the Database every Interval until the context is done, returning
+// the context's error. Errors of a sweep are passed to the optional onError
+// function, and do not stop the following sweeps.
+func (s *CacheSweeper) Run(c context.Context, onError func(err error)) error {
+	ticker := time.NewTicker(s.policy.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.Done():
+			return c.Err()
+		case <-ticker.C:
+			if _, err := s.Sweep(c); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+// Sweep evicts the stale federated entries that are no longer referenced,
+// returning how many were deleted.
+func (s *CacheSweeper) Sweep(c context.Context) (evicted int, err error) {
+	now := s.clock.Now()
+	since := now.Add(-s.policy.MaxAge)
+	for {
+		var ids []*url.URL
+		ids, err = s.cdb.StaleEntries(c, since, s.policy.BatchSize)
+		if err != nil {
+			return
+		}
+		// Entries that are neither deleted nor renewed are returned
+		// again by StaleEntries.
+		skipped := 0
+		for _, id := range ids {
+			if s.policy.MaxEvictions > 0 && evicted >= s.policy.MaxEvictions {
+				return
+			}
+			var deleted, renewed bool
+			deleted, renewed, err = s.evict(c, id, now)
+			if err != nil {
+				return
+			} else if deleted {
+				evicted++
+			} else if !renewed {
+				skipped++
+			}
+		}
+		if len(ids) < s.policy.BatchSize || skipped > 0 {
+			return
+		}
+	}
+}
+
+// evict deletes the federated entry if it is not referenced, or renews it by
+// marking it as accessed otherwise.
+func (s *CacheSweeper) evict(c context.Context, id *url.URL, now time.Time) (deleted, renewed bool, err error) {
+	// Owned data is never evicted, even if the database reports it.
+	if owns, err := s.db.Owns(c, id); err != nil {
+		return false, false, err
+	} else if owns {
+		return false, false, nil
+	}
+	err = s.db.Lock(c, id)
+	if err != nil {
+		return
+	}
+	defer s.db.Unlock(c, id)
+	referenced, err := s.cdb.IsReferenced(c, id)
+	if err != nil {
+		return
+	} else if referenced {
+		err = s.cdb.SetAccessed(c, id, now)
+		renewed = err == nil
+		return
+	}
+	err = s.db.Delete(c, id)
+	deleted = err == nil
+	return
+}
+
+// setFetched records the time at which the federated entry was stored, if the
+// database is a CacheDatabase.
+func setFetched(c context.Context, db Database, clock Clock, id *url.URL) error {
+	cdb, ok := db.(CacheDatabase)
+	if !ok {
+		return nil
+	}
+	return cdb.SetFetched(c, id, clock.Now())
+}
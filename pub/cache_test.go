@@ -0,0 +1,133 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// cacheDatabase is a Database that also is a CacheDatabase.
+type cacheDatabase struct {
+	*MockDatabase
+	*MockCacheDatabase
+}
+
+// TestCacheSweeper tests evicting stale federated data.
+func TestCacheSweeper(t *testing.T) {
+	ctx := context.Background()
+	policy := CachePolicy{MaxAge: time.Hour, BatchSize: 2}
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, cdb *MockCacheDatabase, s *CacheSweeper) {
+		db = NewMockDatabase(ctl)
+		cdb = NewMockCacheDatabase(ctl)
+		cl := NewMockClock(ctl)
+		cl.EXPECT().Now().Return(now()).AnyTimes()
+		s, err := NewCacheSweeper(cacheDatabase{db, cdb}, cl, policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	t.Run("ErrorIfNotCacheDatabase", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		// Run
+		_, err := NewCacheSweeper(NewMockDatabase(ctl), NewMockClock(ctl), policy)
+		// Verify
+		assertEqual(t, err, ErrNotCacheDatabase)
+	})
+	t.Run("EvictsUnreferencedAndRenewsReferenced", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cdb, s := setupFn(ctl)
+		stale := mustParse(testFederatedActivityIRI)
+		referenced := mustParse(testFederatedActivityIRI2)
+		gomock.InOrder(
+			cdb.EXPECT().StaleEntries(ctx, now().Add(-time.Hour), 2).Return([]*url.URL{stale, referenced}, nil),
+			db.EXPECT().Owns(ctx, stale).Return(false, nil),
+			db.EXPECT().Lock(ctx, stale),
+			cdb.EXPECT().IsReferenced(ctx, stale).Return(false, nil),
+			db.EXPECT().Delete(ctx, stale),
+			db.EXPECT().Unlock(ctx, stale),
+			db.EXPECT().Owns(ctx, referenced).Return(false, nil),
+			db.EXPECT().Lock(ctx, referenced),
+			cdb.EXPECT().IsReferenced(ctx, referenced).Return(true, nil),
+			cdb.EXPECT().SetAccessed(ctx, referenced, now()),
+			db.EXPECT().Unlock(ctx, referenced),
+			cdb.EXPECT().StaleEntries(ctx, now().Add(-time.Hour), 2).Return(nil, nil),
+		)
+		// Run
+		evicted, err := s.Sweep(ctx)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, evicted, 1)
+	})
+	t.Run("NeverEvictsOwned", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cdb, s := setupFn(ctl)
+		owned := mustParse(testNewActivityIRI)
+		stale := mustParse(testFederatedActivityIRI)
+		gomock.InOrder(
+			cdb.EXPECT().StaleEntries(ctx, now().Add(-time.Hour), 2).Return([]*url.URL{owned, stale}, nil),
+			db.EXPECT().Owns(ctx, owned).Return(true, nil),
+			db.EXPECT().Owns(ctx, stale).Return(false, nil),
+			db.EXPECT().Lock(ctx, stale),
+			cdb.EXPECT().IsReferenced(ctx, stale).Return(false, nil),
+			db.EXPECT().Delete(ctx, stale),
+			db.EXPECT().Unlock(ctx, stale),
+		)
+		// Run
+		evicted, err := s.Sweep(ctx)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, evicted, 1)
+	})
+	t.Run("StopsAtMaxEvictions", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, cdb, s := setupFn(ctl)
+		s.policy.MaxEvictions = 1
+		stale := mustParse(testFederatedActivityIRI)
+		gomock.InOrder(
+			cdb.EXPECT().StaleEntries(ctx, now().Add(-time.Hour), 2).Return([]*url.URL{stale, mustParse(testFederatedActivityIRI2)}, nil),
+			db.EXPECT().Owns(ctx, stale).Return(false, nil),
+			db.EXPECT().Lock(ctx, stale),
+			cdb.EXPECT().IsReferenced(ctx, stale).Return(false, nil),
+			db.EXPECT().Delete(ctx, stale),
+			db.EXPECT().Unlock(ctx, stale),
+		)
+		// Run
+		evicted, err := s.Sweep(ctx)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, evicted, 1)
+	})
+	t.Run("FederatedCreateSetsFetched", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		cdb := NewMockCacheDatabase(ctl)
+		cl := NewMockClock(ctl)
+		w := FederatingWrappedCallbacks{db: cacheDatabase{db, cdb}, clock: cl}
+		note := newOriginNote(testNoteId1, testPersonIRI)
+		noteId := mustParse(testNoteId1)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Create(ctx, note),
+			cl.EXPECT().Now().Return(now()),
+			cdb.EXPECT().SetFetched(ctx, noteId, now()),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.create(ctx, newOriginCreate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
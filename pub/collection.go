@@ -0,0 +1,45 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// CollectionPage builds a page of the OrderedCollection with the given id from
+// a CollectionDatabase, with at most 'limit' items, newest first, skipping the
+// 'offset' newest ones. It is meant for implementing the GetInbox and GetOutbox
+// methods of the FederatingProtocol and CommonBehavior.
+//
+// The page has its 'partOf', 'startIndex', 'totalItems', and 'orderedItems'
+// properties set. The application sets its 'id', and its 'prev' and 'next'
+// properties, which depend on how it exposes pages in its IRIs.
+//
+// CollectionPage does not lock the collection.
+func CollectionPage(c context.Context, db CollectionDatabase, collectionIRI *url.URL, offset, limit int) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	total, err := db.CountItems(c, collectionIRI)
+	if err != nil {
+		return nil, err
+	}
+	items, err := db.GetItems(c, collectionIRI, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	partOf := streams.NewActivityStreamsPartOfProperty()
+	partOf.SetIRI(collectionIRI)
+	page.SetActivityStreamsPartOf(partOf)
+	startIndex := streams.NewActivityStreamsStartIndexProperty()
+	startIndex.Set(offset)
+	page.SetActivityStreamsStartIndex(startIndex)
+	totalItems := streams.NewActivityStreamsTotalItemsProperty()
+	totalItems.Set(total)
+	page.SetActivityStreamsTotalItems(totalItems)
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range items {
+		oi.AppendIRI(item)
+	}
+	page.SetActivityStreamsOrderedItems(oi)
+	return page, nil
+}
@@ -0,0 +1,120 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// collectionDatabase is a Database that also is a CollectionDatabase.
+type collectionDatabase struct {
+	*MockDatabase
+	*MockCollectionDatabase
+}
+
+// TestCollectionDatabase tests adding to inboxes and outboxes with a
+// CollectionDatabase.
+func TestCollectionDatabase(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (fp *MockFederatingProtocol, sp *MockSocialProtocol, db *MockDatabase, cdb *MockCollectionDatabase, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		sp = NewMockSocialProtocol(ctl)
+		db = NewMockDatabase(ctl)
+		cdb = NewMockCollectionDatabase(ctl)
+		a = &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    fp,
+			c2s:    sp,
+			db:     collectionDatabase{db, cdb},
+			clock:  NewMockClock(ctl),
+		}
+		return
+	}
+	t.Run("PrependsToInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, _, db, cdb, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			cdb.EXPECT().ContainsItem(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			cdb.EXPECT().PrependItem(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotPrependDuplicateToInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, db, cdb, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			cdb.EXPECT().ContainsItem(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("PrependsToOutbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, sp, db, cdb, a := setupFn(ctl)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Create(ctx, testMyListen),
+			db.EXPECT().Unlock(ctx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Lock(ctx, outboxIRI),
+			cdb.EXPECT().PrependItem(ctx, outboxIRI, mustParse(testNewActivityIRI)).Return(nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+		)
+		sp.EXPECT().Callbacks(ctx).Return(SocialWrappedCallbacks{}, nil, nil)
+		sp.EXPECT().DefaultCallback(ctx, testMyListen).Return(nil)
+		// Run
+		deliverable, err := a.PostOutbox(ctx, testMyListen, outboxIRI, mustSerialize(testMyListen))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, deliverable, true)
+	})
+}
+
+// TestCollectionPage tests building a page from a CollectionDatabase.
+func TestCollectionPage(t *testing.T) {
+	ctx := context.Background()
+	// Setup
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	cdb := NewMockCollectionDatabase(ctl)
+	inboxIRI := mustParse(testMyInboxIRI)
+	gomock.InOrder(
+		cdb.EXPECT().CountItems(ctx, inboxIRI).Return(3, nil),
+		cdb.EXPECT().GetItems(ctx, inboxIRI, 1, 2).Return([]*url.URL{
+			mustParse(testFederatedActivityIRI2),
+			mustParse(testFederatedActivityIRI),
+		}, nil),
+	)
+	// Run
+	page, err := CollectionPage(ctx, cdb, inboxIRI, 1, 2)
+	// Verify
+	assertEqual(t, err, nil)
+	assertEqual(t, page.GetActivityStreamsPartOf().GetIRI().String(), testMyInboxIRI)
+	assertEqual(t, page.GetActivityStreamsStartIndex().Get(), 1)
+	assertEqual(t, page.GetActivityStreamsTotalItems().Get(), 3)
+	oi := page.GetActivityStreamsOrderedItems()
+	assertEqual(t, oi.Len(), 2)
+	assertEqual(t, oi.At(0).GetIRI().String(), testFederatedActivityIRI2)
+	assertEqual(t, oi.At(1).GetIRI().String(), testFederatedActivityIRI)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/go-fed/activity/streams/vocab"
 	"net/url"
+	"time"
 )
 
 type Database interface {
@@ -137,3 +138,134 @@ type Database interface {
 	// The library makes this call only after acquiring a lock first.
 	Liked(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error)
 }
+
+// FollowRequestDatabase is an optional extension of the Database for
+// applications that manually approve Follow requests, such as for locked
+// accounts. It is required when a FederatingWrappedCallbacks uses the
+// OnFollowManuallyApprove behavior.
+type FollowRequestDatabase interface {
+	// FollowRequests obtains the OrderedCollection of pending Follow
+	// requests for an actor with the given id. The Follow activities are
+	// kept as embedded values in its 'orderedItems' property, newest
+	// first.
+	//
+	// If modified, the library will then call Update.
+	//
+	// The library makes this call only after acquiring a lock first.
+	FollowRequests(c context.Context, actorIRI *url.URL) (requests vocab.ActivityStreamsOrderedCollection, err error)
+}
+
+// ReportDatabase is an optional extension of the Database for applications
+// that moderate the reports of actors and objects, which federate as Flag
+// activities.
+//
+// When the Database is a ReportDatabase, the Flag side effects of both the
+// FederatingWrappedCallbacks and the SocialWrappedCallbacks save a Report.
+type ReportDatabase interface {
+	// CreateReport saves a new Report, for later review by moderators.
+	//
+	// Under certain conditions and network activities, CreateReport may be
+	// called multiple times for the same Flag.
+	//
+	// The library makes this call only after acquiring a lock first.
+	CreateReport(c context.Context, r *Report) error
+}
+
+// ActorPurgeDatabase is an optional extension of the Database for applications
+// that purge the cached content of federated actors once they are deleted.
+//
+// When the Database is an ActorPurgeDatabase, the Delete side effects of the
+// FederatingWrappedCallbacks purge an actor that deletes itself.
+type ActorPurgeDatabase interface {
+	// PurgeActor deletes everything held for the federated actor with the
+	// given id, such as its cached objects, activities, and collections.
+	// Entries owned by this server, such as the actor's Follows of local
+	// actors in their followers collections, are left for the application
+	// to clean up. The entry of the actor itself has already been removed
+	// or replaced with a Tombstone according to the OnDelete behavior.
+	//
+	// The library makes this call only after acquiring a lock first.
+	PurgeActor(c context.Context, actorIRI *url.URL) error
+}
+
+// CollectionDatabase is an optional extension of the Database for applications
+// that store the items of inboxes and outboxes individually, such as in an
+// indexed table, instead of as a whole OrderedCollection.
+//
+// When the Database is a CollectionDatabase, the library adds an activity to
+// an inbox or outbox with ContainsItem and PrependItem, instead of reading and
+// rewriting the whole page with GetInbox and SetInbox, or GetOutbox and
+// SetOutbox. GetItems and CountItems let the application serve pages of the
+// collection, such as with CollectionPage.
+type CollectionDatabase interface {
+	// PrependItem adds the item to the front of the OrderedCollection with
+	// the given id, making it the newest item.
+	//
+	// The library makes this call only after acquiring a lock first.
+	PrependItem(c context.Context, collectionIRI, item *url.URL) error
+	// GetItems returns at most 'limit' items of the OrderedCollection with
+	// the given id, newest first, skipping the 'offset' newest ones.
+	GetItems(c context.Context, collectionIRI *url.URL, offset, limit int) (items []*url.URL, err error)
+	// CountItems returns the number of items of the OrderedCollection with
+	// the given id.
+	CountItems(c context.Context, collectionIRI *url.URL) (n int, err error)
+	// ContainsItem returns true if the OrderedCollection with the given id
+	// contains the item.
+	//
+	// The library makes this call only after acquiring a lock first.
+	ContainsItem(c context.Context, collectionIRI, item *url.URL) (contains bool, err error)
+}
+
+// TransactionDatabase is an optional extension of the Database for
+// applications whose store supports transactions, so that a failure while
+// processing an activity leaves no partial writes.
+//
+// When the Database is a TransactionDatabase, the library processes each
+// activity posted to an inbox or outbox, including its side effects, within a
+// single transaction: it calls Begin, makes every call to the Database with
+// the returned context, and then calls Commit, or Rollback if processing
+// failed.
+//
+// Lock and Unlock are still called with the transaction's context. The
+// Database may implement them as row-level or advisory locks that are held
+// until the transaction ends.
+type TransactionDatabase interface {
+	// Begin starts a transaction, returning a context carrying it.
+	Begin(c context.Context) (tx context.Context, err error)
+	// Commit commits the transaction carried by the context.
+	Commit(tx context.Context) error
+	// Rollback aborts the transaction carried by the context, discarding
+	// its writes.
+	Rollback(tx context.Context) error
+}
+
+// CacheDatabase is an optional extension of the Database for applications that
+// evict the federated data cached by the library, such as the objects of
+// federated Creates, Updates, and Announces, with a CacheSweeper.
+//
+// When the Database is a CacheDatabase, the library records the time from its
+// Clock at which it stores or updates federated data with SetFetched.
+type CacheDatabase interface {
+	// SetFetched records that the federated entry with the given id was
+	// fetched or received at the given time.
+	//
+	// The library makes this call only after acquiring a lock first.
+	SetFetched(c context.Context, id *url.URL, t time.Time) error
+	// SetAccessed records that the federated entry with the given id was
+	// read at the given time. Applications call it when they show the
+	// entry, such as in a timeline.
+	//
+	// The library makes this call only after acquiring a lock first.
+	SetAccessed(c context.Context, id *url.URL, t time.Time) error
+	// StaleEntries returns the ids of at most 'limit' federated entries
+	// that were neither fetched nor accessed since the given time, least
+	// recently fetched or accessed first.
+	StaleEntries(c context.Context, since time.Time, limit int) (ids []*url.URL, err error)
+	// IsReferenced returns true if the federated entry with the given id is
+	// still needed locally: it is referred to by an entry owned by this
+	// server, such as an inbox, a Like, or a reply, or it is attributed to
+	// an actor followed by a local actor.
+	//
+	// The library makes this call only after acquiring a lock first.
+	IsReferenced(c context.Context, id *url.URL) (referenced bool, err error)
+}
@@ -0,0 +1,804 @@
+// Package dbtest is a behavioral test suite for implementations of
+// pub.Database.
+//
+// It checks the assumptions the pub package makes of a Database, such as
+// InboxContains seeing the items given to SetInbox, Owns agreeing with NewId,
+// and Lock excluding other goroutines. An implementation runs the suite from
+// its own tests:
+//
+//	func TestConformance(t *testing.T) {
+//		dbtest.Run(t, func(t *testing.T) dbtest.Fixture {
+//			db := NewMyDatabase()
+//			actor := db.CreateActor("alex")
+//			return dbtest.Fixture{
+//				DB:     db,
+//				Actor:  actor.IRI,
+//				Inbox:  actor.Inbox,
+//				Outbox: actor.Outbox,
+//			}
+//		})
+//	}
+package dbtest
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	// FederatedHost is the host of the federated IRIs used by the suite.
+	// The Database under test must not own IRIs on this host.
+	FederatedHost = "federated.example.org"
+	// concurrency is the number of goroutines of the concurrency tests.
+	concurrency = 20
+	// lockTimeout bounds how long the suite waits on a lock that must be
+	// available.
+	lockTimeout = 5 * time.Second
+)
+
+// Fixture is a new Database under test, and a local actor saved in it.
+type Fixture struct {
+	// DB is the Database under test.
+	DB pub.Database
+	// Actor is the IRI of a local actor.
+	Actor *url.URL
+	// Inbox is the IRI of the actor's inbox, which is empty.
+	Inbox *url.URL
+	// Outbox is the IRI of the actor's outbox, which is empty.
+	Outbox *url.URL
+}
+
+// Run runs the suite against the Databases created by newFixture, which is
+// called once per test so each test starts with a new Database.
+//
+// The optional FollowRequestDatabase, ReportDatabase, CollectionDatabase,
+// TransactionDatabase, CacheDatabase, and ActorPurgeDatabase interfaces are also tested if the
+// Database implements them.
+func Run(t *testing.T, newFixture func(t *testing.T) Fixture) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, f Fixture)
+	}{
+		{"OwnsNewId", testOwnsNewId},
+		{"NewIdIsUnique", testNewIdIsUnique},
+		{"CreateGetUpdateDelete", testCreateGetUpdateDelete},
+		{"ExistsIsFalseForUnknownId", testExistsIsFalseForUnknownId},
+		{"InboxContainsAfterSetInbox", testInboxContainsAfterSetInbox},
+		{"InboxKeepsNewestFirst", testInboxKeepsNewestFirst},
+		{"OutboxKeepsNewestFirst", testOutboxKeepsNewestFirst},
+		{"ActorForBoxes", testActorForBoxes},
+		{"ActorCollections", testActorCollections},
+		{"LockIsExclusive", testLockIsExclusive},
+		{"LockIsPerId", testLockIsPerId},
+		{"UnlockFromAnotherGoroutine", testUnlockFromAnotherGoroutine},
+		{"ConcurrentInboxDeliveries", testConcurrentInboxDeliveries},
+		{"FollowRequests", testFollowRequests},
+		{"CreateReport", testCreateReport},
+		{"CollectionItems", testCollectionItems},
+		{"TransactionRollback", testTransactionRollback},
+		{"TransactionCommit", testTransactionCommit},
+		{"CacheStaleEntries", testCacheStaleEntries},
+		{"CacheIsReferencedByOwnedEntry", testCacheIsReferencedByOwnedEntry},
+		{"PurgeActor", testPurgeActor},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			test.fn(t, newFixture(t))
+		})
+	}
+}
+
+// federatedIRI returns an IRI on FederatedHost.
+func federatedIRI(path string) *url.URL {
+	return &url.URL{
+		Scheme: "https",
+		Host:   FederatedHost,
+		Path:   path,
+	}
+}
+
+// newNote creates a Note with the id.
+func newNote(id *url.URL) vocab.ActivityStreamsNote {
+	note := streams.NewActivityStreamsNote()
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(id)
+	note.SetJSONLDId(idProp)
+	return note
+}
+
+// mustSerialize serializes the value or fails the test.
+func mustSerialize(t *testing.T, v vocab.Type) map[string]interface{} {
+	t.Helper()
+	m, err := streams.Serialize(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// lockWithTimeout locks the id or fails the test if it takes too long.
+func lockWithTimeout(t *testing.T, db pub.Database, id *url.URL) {
+	t.Helper()
+	c, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+	if err := db.Lock(c, id); err != nil {
+		t.Fatalf("Lock(%s): %v", id, err)
+	}
+}
+
+// prependToInbox delivers the id to the inbox the way the pub package does.
+func prependToInbox(c context.Context, db pub.Database, inboxIRI, id *url.URL) error {
+	if err := db.Lock(c, inboxIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, inboxIRI)
+	inbox, err := db.GetInbox(c, inboxIRI)
+	if err != nil {
+		return err
+	}
+	oi := inbox.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	oi.PrependIRI(id)
+	inbox.SetActivityStreamsOrderedItems(oi)
+	return db.SetInbox(c, inbox)
+}
+
+// prependToOutbox adds the id to the outbox the way the pub package does.
+func prependToOutbox(c context.Context, db pub.Database, outboxIRI, id *url.URL) error {
+	if err := db.Lock(c, outboxIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, outboxIRI)
+	outbox, err := db.GetOutbox(c, outboxIRI)
+	if err != nil {
+		return err
+	}
+	oi := outbox.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	oi.PrependIRI(id)
+	outbox.SetActivityStreamsOrderedItems(oi)
+	return db.SetOutbox(c, outbox)
+}
+
+// itemIds returns the ids of the ordered items of a page.
+func itemIds(t *testing.T, page vocab.ActivityStreamsOrderedCollectionPage) (ids []string) {
+	t.Helper()
+	oi := page.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		return
+	}
+	for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+		id, err := pub.ToId(iter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id.String())
+	}
+	return
+}
+
+func testOwnsNewId(t *testing.T, f Fixture) {
+	c := context.Background()
+	id, err := f.DB.NewId(c, streams.NewActivityStreamsNote())
+	if err != nil {
+		t.Fatalf("NewId: %v", err)
+	}
+	if owns, err := f.DB.Owns(c, id); err != nil {
+		t.Fatalf("Owns: %v", err)
+	} else if !owns {
+		t.Errorf("Owns(%s) = false for an id from NewId", id)
+	}
+	if owns, err := f.DB.Owns(c, f.Actor); err != nil {
+		t.Fatalf("Owns: %v", err)
+	} else if !owns {
+		t.Errorf("Owns(%s) = false for the local actor", f.Actor)
+	}
+	federated := federatedIRI("/note/1")
+	if owns, err := f.DB.Owns(c, federated); err != nil {
+		t.Fatalf("Owns: %v", err)
+	} else if owns {
+		t.Errorf("Owns(%s) = true for a federated id", federated)
+	}
+}
+
+func testNewIdIsUnique(t *testing.T, f Fixture) {
+	c := context.Background()
+	seen := make(map[string]bool)
+	for _, v := range []vocab.Type{
+		streams.NewActivityStreamsNote(),
+		streams.NewActivityStreamsNote(),
+		streams.NewActivityStreamsCreate(),
+		streams.NewActivityStreamsCreate(),
+	} {
+		id, err := f.DB.NewId(c, v)
+		if err != nil {
+			t.Fatalf("NewId: %v", err)
+		}
+		if seen[id.String()] {
+			t.Errorf("NewId returned %s twice", id)
+		}
+		seen[id.String()] = true
+	}
+}
+
+func testCreateGetUpdateDelete(t *testing.T, f Fixture) {
+	c := context.Background()
+	id := federatedIRI("/note/1")
+	note := newNote(id)
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("Hello")
+	note.SetActivityStreamsContent(content)
+	if err := f.DB.Create(c, note); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if exists, err := f.DB.Exists(c, id); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if !exists {
+		t.Fatalf("Exists(%s) = false after Create", id)
+	}
+	got, err := f.DB.Get(c, id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if want, got := mustSerialize(t, note), mustSerialize(t, got); !reflect.DeepEqual(want, got) {
+		t.Errorf("Get after Create: got %v, want %v", got, want)
+	}
+	content.AppendXMLSchemaString("World")
+	if err := f.DB.Update(c, note); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	got, err = f.DB.Get(c, id)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if want, got := mustSerialize(t, note), mustSerialize(t, got); !reflect.DeepEqual(want, got) {
+		t.Errorf("Get after Update: got %v, want %v", got, want)
+	}
+	if err := f.DB.Delete(c, id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if exists, err := f.DB.Exists(c, id); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if exists {
+		t.Errorf("Exists(%s) = true after Delete", id)
+	}
+}
+
+func testExistsIsFalseForUnknownId(t *testing.T, f Fixture) {
+	c := context.Background()
+	id := federatedIRI("/note/unknown")
+	if exists, err := f.DB.Exists(c, id); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if exists {
+		t.Errorf("Exists(%s) = true for an unknown id", id)
+	}
+}
+
+func testInboxContainsAfterSetInbox(t *testing.T, f Fixture) {
+	c := context.Background()
+	id := federatedIRI("/activity/1")
+	if contains, err := f.DB.InboxContains(c, f.Inbox, id); err != nil {
+		t.Fatalf("InboxContains: %v", err)
+	} else if contains {
+		t.Fatalf("InboxContains(%s) = true before SetInbox", id)
+	}
+	if err := prependToInbox(c, f.DB, f.Inbox, id); err != nil {
+		t.Fatalf("SetInbox: %v", err)
+	}
+	if contains, err := f.DB.InboxContains(c, f.Inbox, id); err != nil {
+		t.Fatalf("InboxContains: %v", err)
+	} else if !contains {
+		t.Errorf("InboxContains(%s) = false after SetInbox", id)
+	}
+}
+
+func testInboxKeepsNewestFirst(t *testing.T, f Fixture) {
+	c := context.Background()
+	var want []string
+	for i := 1; i <= 3; i++ {
+		id := federatedIRI(fmt.Sprintf("/activity/%d", i))
+		if err := prependToInbox(c, f.DB, f.Inbox, id); err != nil {
+			t.Fatalf("SetInbox: %v", err)
+		}
+		want = append([]string{id.String()}, want...)
+	}
+	inbox, err := f.DB.GetInbox(c, f.Inbox)
+	if err != nil {
+		t.Fatalf("GetInbox: %v", err)
+	}
+	if got := itemIds(t, inbox); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetInbox items: got %v, want %v", got, want)
+	}
+}
+
+func testOutboxKeepsNewestFirst(t *testing.T, f Fixture) {
+	c := context.Background()
+	var want []string
+	for i := 0; i < 3; i++ {
+		id, err := f.DB.NewId(c, streams.NewActivityStreamsCreate())
+		if err != nil {
+			t.Fatalf("NewId: %v", err)
+		}
+		if err := prependToOutbox(c, f.DB, f.Outbox, id); err != nil {
+			t.Fatalf("SetOutbox: %v", err)
+		}
+		want = append([]string{id.String()}, want...)
+	}
+	outbox, err := f.DB.GetOutbox(c, f.Outbox)
+	if err != nil {
+		t.Fatalf("GetOutbox: %v", err)
+	}
+	if got := itemIds(t, outbox); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetOutbox items: got %v, want %v", got, want)
+	}
+}
+
+func testActorForBoxes(t *testing.T, f Fixture) {
+	c := context.Background()
+	if actor, err := f.DB.ActorForInbox(c, f.Inbox); err != nil {
+		t.Errorf("ActorForInbox: %v", err)
+	} else if actor.String() != f.Actor.String() {
+		t.Errorf("ActorForInbox: got %s, want %s", actor, f.Actor)
+	}
+	if actor, err := f.DB.ActorForOutbox(c, f.Outbox); err != nil {
+		t.Errorf("ActorForOutbox: %v", err)
+	} else if actor.String() != f.Actor.String() {
+		t.Errorf("ActorForOutbox: got %s, want %s", actor, f.Actor)
+	}
+	if outbox, err := f.DB.OutboxForInbox(c, f.Inbox); err != nil {
+		t.Errorf("OutboxForInbox: %v", err)
+	} else if outbox.String() != f.Outbox.String() {
+		t.Errorf("OutboxForInbox: got %s, want %s", outbox, f.Outbox)
+	}
+}
+
+func testActorCollections(t *testing.T, f Fixture) {
+	c := context.Background()
+	if _, err := f.DB.Followers(c, f.Actor); err != nil {
+		t.Errorf("Followers: %v", err)
+	}
+	if _, err := f.DB.Following(c, f.Actor); err != nil {
+		t.Errorf("Following: %v", err)
+	}
+	if _, err := f.DB.Liked(c, f.Actor); err != nil {
+		t.Errorf("Liked: %v", err)
+	}
+}
+
+func testLockIsExclusive(t *testing.T, f Fixture) {
+	c := context.Background()
+	id := federatedIRI("/note/1")
+	var held int32
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := f.DB.Lock(c, id); err != nil {
+				t.Errorf("Lock: %v", err)
+				return
+			}
+			if !atomic.CompareAndSwapInt32(&held, 0, 1) {
+				t.Errorf("Lock(%s) held by two goroutines", id)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.StoreInt32(&held, 0)
+			if err := f.DB.Unlock(c, id); err != nil {
+				t.Errorf("Unlock: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func testLockIsPerId(t *testing.T, f Fixture) {
+	c := context.Background()
+	id := federatedIRI("/note/1")
+	other := federatedIRI("/note/2")
+	lockWithTimeout(t, f.DB, id)
+	defer f.DB.Unlock(c, id)
+	done := make(chan error)
+	go func() {
+		tc, cancel := context.WithTimeout(c, lockTimeout)
+		defer cancel()
+		if err := f.DB.Lock(tc, other); err != nil {
+			done <- err
+			return
+		}
+		done <- f.DB.Unlock(c, other)
+	}()
+	if err := <-done; err != nil {
+		t.Errorf("Lock(%s) while %s is locked: %v", other, id, err)
+	}
+}
+
+func testUnlockFromAnotherGoroutine(t *testing.T, f Fixture) {
+	c := context.Background()
+	id := federatedIRI("/note/1")
+	lockWithTimeout(t, f.DB, id)
+	done := make(chan error)
+	go func() {
+		done <- f.DB.Unlock(c, id)
+	}()
+	if err := <-done; err != nil {
+		t.Fatalf("Unlock: %v", err)
+	}
+	lockWithTimeout(t, f.DB, id)
+	if err := f.DB.Unlock(c, id); err != nil {
+		t.Errorf("Unlock: %v", err)
+	}
+}
+
+func testConcurrentInboxDeliveries(t *testing.T, f Fixture) {
+	c := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			id := federatedIRI(fmt.Sprintf("/activity/%d", i))
+			if err := prependToInbox(c, f.DB, f.Inbox, id); err != nil {
+				t.Errorf("SetInbox: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < concurrency; i++ {
+		id := federatedIRI(fmt.Sprintf("/activity/%d", i))
+		if contains, err := f.DB.InboxContains(c, f.Inbox, id); err != nil {
+			t.Fatalf("InboxContains: %v", err)
+		} else if !contains {
+			t.Errorf("InboxContains(%s) = false: a concurrent delivery was lost", id)
+		}
+	}
+}
+
+func testFollowRequests(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.FollowRequestDatabase)
+	if !ok {
+		t.Skip("not a FollowRequestDatabase")
+	}
+	if _, err := db.FollowRequests(context.Background(), f.Actor); err != nil {
+		t.Errorf("FollowRequests: %v", err)
+	}
+}
+
+func testCreateReport(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.ReportDatabase)
+	if !ok {
+		t.Skip("not a ReportDatabase")
+	}
+	flag := streams.NewActivityStreamsFlag()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(federatedIRI("/flag/1"))
+	flag.SetJSONLDId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(federatedIRI("/users/sam"))
+	flag.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(f.Actor)
+	flag.SetActivityStreamsObject(op)
+	r, err := pub.NewReport(flag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Federated = true
+	if err := db.CreateReport(context.Background(), r); err != nil {
+		t.Errorf("CreateReport: %v", err)
+	}
+}
+
+func testCollectionItems(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.CollectionDatabase)
+	if !ok {
+		t.Skip("not a CollectionDatabase")
+	}
+	c := context.Background()
+	var want []string
+	for i := 1; i <= 5; i++ {
+		id := federatedIRI(fmt.Sprintf("/activity/%d", i))
+		if err := db.PrependItem(c, f.Inbox, id); err != nil {
+			t.Fatalf("PrependItem: %v", err)
+		}
+		want = append([]string{id.String()}, want...)
+	}
+	if n, err := db.CountItems(c, f.Inbox); err != nil {
+		t.Fatalf("CountItems: %v", err)
+	} else if n != len(want) {
+		t.Errorf("CountItems: got %d, want %d", n, len(want))
+	}
+	items, err := db.GetItems(c, f.Inbox, 1, 3)
+	if err != nil {
+		t.Fatalf("GetItems: %v", err)
+	}
+	var got []string
+	for _, item := range items {
+		got = append(got, item.String())
+	}
+	if !reflect.DeepEqual(got, want[1:4]) {
+		t.Errorf("GetItems(1, 3): got %v, want %v", got, want[1:4])
+	}
+	if items, err := db.GetItems(c, f.Inbox, len(want), 3); err != nil {
+		t.Fatalf("GetItems: %v", err)
+	} else if len(items) != 0 {
+		t.Errorf("GetItems past the end: got %v, want none", items)
+	}
+	if contains, err := db.ContainsItem(c, f.Inbox, federatedIRI("/activity/3")); err != nil {
+		t.Fatalf("ContainsItem: %v", err)
+	} else if !contains {
+		t.Errorf("ContainsItem = false after PrependItem")
+	}
+	// The items are the ones seen through the Database methods.
+	if contains, err := f.DB.InboxContains(c, f.Inbox, federatedIRI("/activity/3")); err != nil {
+		t.Fatalf("InboxContains: %v", err)
+	} else if !contains {
+		t.Errorf("InboxContains = false after PrependItem")
+	}
+	inbox, err := f.DB.GetInbox(c, f.Inbox)
+	if err != nil {
+		t.Fatalf("GetInbox: %v", err)
+	}
+	if got := itemIds(t, inbox); len(got) == 0 || got[0] != want[0] {
+		t.Errorf("GetInbox items after PrependItem: got %v, want %v first", got, want[0])
+	}
+}
+
+func testTransactionRollback(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.TransactionDatabase)
+	if !ok {
+		t.Skip("not a TransactionDatabase")
+	}
+	c := context.Background()
+	id := federatedIRI("/note/1")
+	tx, err := db.Begin(c)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if err := f.DB.Create(tx, newNote(id)); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if exists, err := f.DB.Exists(tx, id); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if !exists {
+		t.Errorf("Exists(%s) = false in the transaction after Create", id)
+	}
+	if err := db.Rollback(tx); err != nil {
+		t.Fatalf("Rollback: %v", err)
+	}
+	if exists, err := f.DB.Exists(c, id); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if exists {
+		t.Errorf("Exists(%s) = true after Rollback", id)
+	}
+}
+
+func testTransactionCommit(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.TransactionDatabase)
+	if !ok {
+		t.Skip("not a TransactionDatabase")
+	}
+	c := context.Background()
+	id := federatedIRI("/activity/1")
+	tx, err := db.Begin(c)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if err := f.DB.Create(tx, newNote(id)); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := prependToInbox(tx, f.DB, f.Inbox, id); err != nil {
+		t.Fatalf("SetInbox: %v", err)
+	}
+	if err := db.Commit(tx); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if exists, err := f.DB.Exists(c, id); err != nil {
+		t.Fatalf("Exists: %v", err)
+	} else if !exists {
+		t.Errorf("Exists(%s) = false after Commit", id)
+	}
+	if contains, err := f.DB.InboxContains(c, f.Inbox, id); err != nil {
+		t.Fatalf("InboxContains: %v", err)
+	} else if !contains {
+		t.Errorf("InboxContains(%s) = false after Commit", id)
+	}
+	// The locks taken in the transaction are available after it.
+	lockWithTimeout(t, f.DB, f.Inbox)
+	if err := f.DB.Unlock(c, f.Inbox); err != nil {
+		t.Errorf("Unlock: %v", err)
+	}
+}
+
+func testCacheStaleEntries(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.CacheDatabase)
+	if !ok {
+		t.Skip("not a CacheDatabase")
+	}
+	c := context.Background()
+	since := time.Unix(1000, 0)
+	older := federatedIRI("/activity/1")
+	newer := federatedIRI("/activity/2")
+	fresh := federatedIRI("/activity/3")
+	for i, id := range []*url.URL{older, newer, fresh} {
+		if err := f.DB.Create(c, newNote(id)); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if err := db.SetFetched(c, id, since.Add(time.Duration(i-2)*time.Second)); err != nil {
+			t.Fatalf("SetFetched: %v", err)
+		}
+	}
+	if err := db.SetAccessed(c, fresh, since.Add(time.Second)); err != nil {
+		t.Fatalf("SetAccessed: %v", err)
+	}
+	// Entries on the host of the actor are owned whatever their scheme.
+	owned := &url.URL{Scheme: "http", Host: f.Actor.Host, Path: "/note/1"}
+	if err := f.DB.Create(c, newNote(owned)); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	// An earlier time does not make an entry stale again.
+	if err := db.SetFetched(c, fresh, since.Add(-time.Hour)); err != nil {
+		t.Fatalf("SetFetched: %v", err)
+	}
+	ids, err := db.StaleEntries(c, since, 10)
+	if err != nil {
+		t.Fatalf("StaleEntries: %v", err)
+	}
+	var got []string
+	for _, id := range ids {
+		got = append(got, id.String())
+	}
+	// The local actor and the owned note are never stale.
+	if want := []string{older.String(), newer.String()}; !reflect.DeepEqual(got, want) {
+		t.Errorf("StaleEntries = %v, want %v", got, want)
+	}
+	if ids, err = db.StaleEntries(c, since, 1); err != nil {
+		t.Fatalf("StaleEntries: %v", err)
+	} else if len(ids) != 1 {
+		t.Errorf("StaleEntries with limit 1 returned %d entries", len(ids))
+	}
+	if referenced, err := db.IsReferenced(c, older); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if referenced {
+		t.Errorf("IsReferenced(%s) = true before delivery", older)
+	}
+	if err := prependToInbox(c, f.DB, f.Inbox, older); err != nil {
+		t.Fatalf("SetInbox: %v", err)
+	}
+	if referenced, err := db.IsReferenced(c, older); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if !referenced {
+		t.Errorf("IsReferenced(%s) = false after delivery to the inbox", older)
+	}
+	if err := f.DB.Delete(c, newer); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if ids, err = db.StaleEntries(c, since, 10); err != nil {
+		t.Fatalf("StaleEntries: %v", err)
+	} else if len(ids) != 1 || ids[0].String() != older.String() {
+		t.Errorf("StaleEntries = %v after Delete, want [%s]", ids, older)
+	}
+}
+
+func testCacheIsReferencedByOwnedEntry(t *testing.T, f Fixture) {
+	db, ok := f.DB.(pub.CacheDatabase)
+	if !ok {
+		t.Skip("not a CacheDatabase")
+	}
+	c := context.Background()
+	federated := federatedIRI("/note/1")
+	if err := f.DB.Create(c, newNote(federated)); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	reply := newNote(&url.URL{Scheme: f.Actor.Scheme, Host: f.Actor.Host, Path: "/note/1"})
+	inReplyTo := streams.NewActivityStreamsInReplyToProperty()
+	inReplyTo.AppendIRI(federated)
+	reply.SetActivityStreamsInReplyTo(inReplyTo)
+	if err := f.DB.Create(c, reply); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if referenced, err := db.IsReferenced(c, federated); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if !referenced {
+		t.Errorf("IsReferenced(%s) = false with an owned reply", federated)
+	}
+	reply.SetActivityStreamsInReplyTo(nil)
+	if err := f.DB.Update(c, reply); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	if referenced, err := db.IsReferenced(c, federated); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if referenced {
+		t.Errorf("IsReferenced(%s) = true once the reply no longer refers to it", federated)
+	}
+}
+
+func testPurgeActor(t *testing.T, f Fixture) {
+	pdb, ok := f.DB.(pub.ActorPurgeDatabase)
+	if !ok {
+		t.Skip("not an ActorPurgeDatabase")
+	}
+	c := context.Background()
+	actor := federatedIRI("/users/sam")
+	attributed := func(note vocab.ActivityStreamsNote) vocab.ActivityStreamsNote {
+		attr := streams.NewActivityStreamsAttributedToProperty()
+		attr.AppendIRI(actor)
+		note.SetActivityStreamsAttributedTo(attr)
+		return note
+	}
+	under := newNote(federatedIRI("/users/sam/statuses/1"))
+	elsewhere := attributed(newNote(federatedIRI("/notes/1")))
+	other := newNote(federatedIRI("/users/sammy/statuses/1"))
+	parent := newNote(federatedIRI("/users/sammy/statuses/2"))
+	elsewhereInReplyTo := streams.NewActivityStreamsInReplyToProperty()
+	elsewhereInReplyTo.AppendIRI(parent.GetJSONLDId().Get())
+	elsewhere.SetActivityStreamsInReplyTo(elsewhereInReplyTo)
+	// An owned entry attributed to the actor, such as a copy of its
+	// note, belongs to this server.
+	owned := attributed(newNote(&url.URL{Scheme: f.Actor.Scheme, Host: f.Actor.Host, Path: "/note/1"}))
+	inReplyTo := streams.NewActivityStreamsInReplyToProperty()
+	inReplyTo.AppendIRI(other.GetJSONLDId().Get())
+	owned.SetActivityStreamsInReplyTo(inReplyTo)
+	for _, note := range []vocab.ActivityStreamsNote{under, elsewhere, other, parent, owned} {
+		if err := f.DB.Create(c, note); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	if err := pdb.PurgeActor(c, actor); err != nil {
+		t.Fatalf("PurgeActor: %v", err)
+	}
+	for _, test := range []struct {
+		note vocab.ActivityStreamsNote
+		want bool
+	}{
+		{under, false},
+		{elsewhere, false},
+		{other, true},
+		{parent, true},
+		{owned, true},
+	} {
+		id := test.note.GetJSONLDId().Get()
+		if exists, err := f.DB.Exists(c, id); err != nil {
+			t.Fatalf("Exists: %v", err)
+		} else if exists != test.want {
+			t.Errorf("Exists(%s) = %v after PurgeActor, want %v", id, exists, test.want)
+		}
+	}
+	cdb, ok := f.DB.(pub.CacheDatabase)
+	if !ok {
+		return
+	}
+	// No references of the purged entries remain, and the ones of the
+	// owned entry are kept with it.
+	if referenced, err := cdb.IsReferenced(c, parent.GetJSONLDId().Get()); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if referenced {
+		t.Errorf("IsReferenced(%s) = true with only a purged reply", parent.GetJSONLDId().Get())
+	}
+	if referenced, err := cdb.IsReferenced(c, other.GetJSONLDId().Get()); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if !referenced {
+		t.Errorf("IsReferenced(%s) = false with an owned reply left by PurgeActor", other.GetJSONLDId().Get())
+	}
+	if err := f.DB.Delete(c, owned.GetJSONLDId().Get()); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if referenced, err := cdb.IsReferenced(c, other.GetJSONLDId().Get()); err != nil {
+		t.Fatalf("IsReferenced: %v", err)
+	} else if referenced {
+		t.Errorf("IsReferenced(%s) = true once no entry refers to it", other.GetJSONLDId().Get())
+	}
+}
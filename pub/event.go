@@ -0,0 +1,166 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// EventType is the kind of change to the database described by an Event.
+type EventType int
+
+const (
+	// EventInboxItemAdded is an activity added to an inbox.
+	EventInboxItemAdded EventType = iota
+	// EventOutboxItemAdded is an activity added to an outbox.
+	EventOutboxItemAdded
+	// EventObjectCreated is a new entry in the database, such as an
+	// activity, the object of a Create, or a fetched Announced object.
+	EventObjectCreated
+	// EventObjectUpdated is an entry replaced by the object of an Update.
+	EventObjectUpdated
+	// EventObjectDeleted is an entry removed from the database.
+	EventObjectDeleted
+	// EventObjectTombstoned is an entry replaced by a Tombstone.
+	EventObjectTombstoned
+	// EventActorPurged is a federated actor deleting itself, whose data
+	// was removed from an ActorPurgeDatabase.
+	EventActorPurged
+	// EventFollowerAdded is an actor added to the followers collection of
+	// a local actor.
+	EventFollowerAdded
+	// EventFollowingAdded is an actor added to the following collection of
+	// a local actor.
+	EventFollowingAdded
+	// EventLikeAdded is a Like activity added to the likes collection of a
+	// local object.
+	EventLikeAdded
+	// EventLikedAdded is an object added to the liked collection of a
+	// local actor.
+	EventLikedAdded
+	// EventShareAdded is an Announce activity added to the shares
+	// collection of a local object.
+	EventShareAdded
+	// EventCollectionItemAdded is an object added to a local collection by
+	// an Add activity.
+	EventCollectionItemAdded
+	// EventCollectionItemRemoved is an object removed from a local
+	// collection by a Remove activity.
+	EventCollectionItemRemoved
+	// EventReportSent is a Report forwarded to a peer by SendReport. Its
+	// Object is the id of the Report, and its Activity the id of the Flag
+	// sent.
+	EventReportSent
+)
+
+// String returns the name of the EventType.
+func (e EventType) String() string {
+	switch e {
+	case EventInboxItemAdded:
+		return "InboxItemAdded"
+	case EventOutboxItemAdded:
+		return "OutboxItemAdded"
+	case EventObjectCreated:
+		return "ObjectCreated"
+	case EventObjectUpdated:
+		return "ObjectUpdated"
+	case EventObjectDeleted:
+		return "ObjectDeleted"
+	case EventObjectTombstoned:
+		return "ObjectTombstoned"
+	case EventActorPurged:
+		return "ActorPurged"
+	case EventFollowerAdded:
+		return "FollowerAdded"
+	case EventFollowingAdded:
+		return "FollowingAdded"
+	case EventLikeAdded:
+		return "LikeAdded"
+	case EventLikedAdded:
+		return "LikedAdded"
+	case EventShareAdded:
+		return "ShareAdded"
+	case EventCollectionItemAdded:
+		return "CollectionItemAdded"
+	case EventCollectionItemRemoved:
+		return "CollectionItemRemoved"
+	case EventReportSent:
+		return "ReportSent"
+	default:
+		return fmt.Sprintf("EventType(%d)", int(e))
+	}
+}
+
+// Event is a change the library made to the database while handling an
+// activity.
+type Event struct {
+	// Type is the kind of change.
+	Type EventType
+	// Actor is the first actor of the activity that caused the change, or
+	// nil if it has none.
+	Actor *url.URL
+	// Collection is the id of the collection changed, such as the inbox or
+	// the followers collection. It is nil if the change is not to a
+	// collection, or if the collection has no id.
+	Collection *url.URL
+	// Object is the id of the entry written, or of the item added to or
+	// removed from the Collection. For EventLikeAdded and EventShareAdded
+	// it is the id of the liked or shared object.
+	Object *url.URL
+	// Activity is the id of the activity that caused the change.
+	Activity *url.URL
+}
+
+// EventSubscriber receives the changes the library makes to the database, so
+// applications can build timelines, notifications, and search indexes that
+// are consistent with what was written.
+//
+// It is optionally implemented by the CommonBehavior, or by the DelegateActor
+// of an actor built with NewCustomActor, which receives only the Events of
+// ApproveFollow and SendReport. When the Database is a TransactionDatabase, Events are received only after the transaction commits
+// and are discarded if it is rolled back. Otherwise, each Event is received
+// right after its change is written.
+type EventSubscriber interface {
+	// Receive is called once for each Event, in the order the changes
+	// were made. It is called while processing the request, so long
+	// running work should be done elsewhere.
+	Receive(c context.Context, e Event)
+}
+
+// newEvent builds the Event of a change caused by the activity.
+func newEvent(t EventType, activity Activity, collection, object *url.URL) Event {
+	e := Event{
+		Type:       t,
+		Collection: collection,
+		Object:     object,
+	}
+	if activity == nil {
+		return e
+	}
+	if id := activity.GetJSONLDId(); id != nil {
+		e.Activity = id.Get()
+	}
+	if actors := activity.GetActivityStreamsActor(); actors != nil && actors.Len() > 0 {
+		if id, err := ToId(actors.At(0)); err == nil {
+			e.Actor = id
+		}
+	}
+	return e
+}
+
+// publishTo sends the Event to the EventSubscriber of the actor, if it has
+// one, once the transaction carried by the context commits.
+func publishTo(c context.Context, a FederatingActor, e Event) {
+	b, ok := a.(*baseActorFederating)
+	if !ok {
+		return
+	}
+	s, ok := b.eventSubscriber()
+	if !ok {
+		return
+	}
+	onCommit(c, func(c context.Context) error {
+		s.Receive(c, e)
+		return nil
+	})
+}
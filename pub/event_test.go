@@ -0,0 +1,199 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// subscribingBehavior is a CommonBehavior that also is an EventSubscriber.
+type subscribingBehavior struct {
+	*MockCommonBehavior
+	*MockEventSubscriber
+}
+
+// TestEventSubscriber tests publishing the changes made to the database.
+func TestEventSubscriber(t *testing.T) {
+	ctx := context.Background()
+	tx := context.WithValue(ctx, txKey{}, "tx")
+	inboxAdded := Event{
+		Type:       EventInboxItemAdded,
+		Actor:      mustParse(testFederatedActorIRI),
+		Collection: mustParse(testMyInboxIRI),
+		Object:     mustParse(testFederatedActivityIRI),
+		Activity:   mustParse(testFederatedActivityIRI),
+	}
+	setupFn := func(ctl *gomock.Controller, db Database) (fp *MockFederatingProtocol, es *MockEventSubscriber, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		es = NewMockEventSubscriber(ctl)
+		a = &sideEffectActor{
+			common: subscribingBehavior{NewMockCommonBehavior(ctl), es},
+			s2s:    fp,
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		return
+	}
+	t.Run("PublishesInboxItemAdded", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		fp, es, a := setupFn(ctl, db)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			es.EXPECT().Receive(ctx, inboxAdded),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotPublishIfAlreadyInInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		_, _, a := setupFn(ctl, db)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().InboxContains(ctx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("PublishesAfterCommit", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tdb := NewMockTransactionDatabase(ctl)
+		fp, es, a := setupFn(ctl, transactionDatabase{db, tdb})
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			tdb.EXPECT().Begin(gomock.Any()).Return(tx, nil),
+			db.EXPECT().Lock(gomock.Any(), inboxIRI),
+			db.EXPECT().InboxContains(gomock.Any(), inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(gomock.Any(), inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(gomock.Any(), testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(gomock.Any(), inboxIRI),
+			tdb.EXPECT().Commit(gomock.Any()),
+			es.EXPECT().Receive(ctx, inboxAdded),
+		)
+		fp.EXPECT().Callbacks(gomock.Any()).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(gomock.Any(), testListen).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("DiscardsOnRollback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tdb := NewMockTransactionDatabase(ctl)
+		fp, _, a := setupFn(ctl, transactionDatabase{db, tdb})
+		inboxIRI := mustParse(testMyInboxIRI)
+		testErr := errors.New("test error")
+		gomock.InOrder(
+			tdb.EXPECT().Begin(gomock.Any()).Return(tx, nil),
+			db.EXPECT().Lock(gomock.Any(), inboxIRI),
+			db.EXPECT().InboxContains(gomock.Any(), inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(gomock.Any(), inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(gomock.Any(), testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(gomock.Any(), inboxIRI),
+			tdb.EXPECT().Rollback(gomock.Any()),
+		)
+		fp.EXPECT().Callbacks(gomock.Any()).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(gomock.Any(), testListen).Return(testErr)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+	t.Run("FederatedCreatePublishesObjectCreated", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		var got []Event
+		w := FederatingWrappedCallbacks{
+			db: db,
+			publish: func(c context.Context, e Event) {
+				got = append(got, e)
+			},
+		}
+		note := newOriginNote(testNoteId1, testPersonIRI)
+		noteId := mustParse(testNoteId1)
+		create := newOriginCreate(testFederatedActorIRI, note)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Create(ctx, note),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.create(ctx, create)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(got), 1)
+		assertEqual(t, got[0].Type, EventObjectCreated)
+		assertEqual(t, got[0].Object.String(), testNoteId1)
+		assertEqual(t, got[0].Actor.String(), testFederatedActorIRI)
+	})
+	t.Run("RemovePublishesOnlyRemovedItems", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		var got []*url.URL
+		removed := func(target, object *url.URL) {
+			got = append(got, object)
+		}
+		targetId := mustParse(testMyOutboxIRI)
+		col := streams.NewActivityStreamsOrderedCollection()
+		oi := streams.NewActivityStreamsOrderedItemsProperty()
+		oi.AppendIRI(mustParse(testNoteId1))
+		oi.AppendIRI(mustParse(testNoteId2))
+		col.SetActivityStreamsOrderedItems(oi)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, targetId),
+			db.EXPECT().Owns(ctx, targetId).Return(true, nil),
+			db.EXPECT().Get(ctx, targetId).Return(col, nil),
+			db.EXPECT().Update(ctx, col),
+			db.EXPECT().Unlock(ctx, targetId),
+		)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse(testNoteId1))
+		op.AppendIRI(mustParse(testNewActivityIRI))
+		target := streams.NewActivityStreamsTargetProperty()
+		target.AppendIRI(targetId)
+		// Run
+		err := remove(ctx, op, target, db, removed)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(got), 1)
+		assertEqual(t, got[0].String(), testNoteId1)
+	})
+}
+
+func TestEventTypeString(t *testing.T) {
+	assertEqual(t, EventFollowerAdded.String(), "FollowerAdded")
+	assertEqual(t, EventReportSent.String(), "ReportSent")
+	assertEqual(t, EventType(-1).String(), "EventType(-1)")
+}
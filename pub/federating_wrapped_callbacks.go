@@ -23,6 +23,26 @@ const (
 	// OnFollowAutomaticallyAccept triggers the side effect of sending a
 	// Reject of this Follow request in response.
 	OnFollowAutomaticallyReject
+	// OnFollowManuallyApprove stores the Follow request as pending until
+	// the application calls ApproveFollow or RejectFollow. The Database
+	// must also be a FollowRequestDatabase.
+	OnFollowManuallyApprove
+)
+
+// OnDeleteBehavior enumerates the different default actions that the go-fed
+// library can provide when receiving a Delete Activity from a peer.
+type OnDeleteBehavior int
+
+const (
+	// OnDeleteRemove removes the cached copy of the deleted objects from
+	// the database.
+	OnDeleteRemove OnDeleteBehavior = iota
+	// OnDeleteTombstone replaces the cached copy of the deleted objects
+	// with a Tombstone, so replies and shares of them can be shown as
+	// deleted. The cached 'replies', 'likes', and 'shares' collections of
+	// the deleted objects, and the cached replies, likes, and shares they
+	// hold, are removed from the database.
+	OnDeleteTombstone
 )
 
 // FederatingWrappedCallbacks lists the callback functions that already have
@@ -36,7 +56,8 @@ type FederatingWrappedCallbacks struct {
 	// The wrapping callback for the Federating Protocol ensures the
 	// 'object' property is created in the database.
 	//
-	// Create calls Create for each object in the federated Activity.
+	// Create calls Create for each object in the federated Activity. The
+	// origin of the objects is first verified according to OriginCheck.
 	Create func(context.Context, vocab.ActivityStreamsCreate) error
 	// Update handles additional side effects for the Update ActivityStreams
 	// type, specific to the application using go-fed.
@@ -45,18 +66,26 @@ type FederatingWrappedCallbacks struct {
 	// 'object' property is updated in the database.
 	//
 	// Update calls Update on the federated entry from the database, with a
-	// new value.
+	// new value. The origin of the objects is first verified according to
+	// OriginCheck.
 	Update func(context.Context, vocab.ActivityStreamsUpdate) error
 	// Delete handles additional side effects for the Delete ActivityStreams
 	// type, specific to the application using go-fed.
 	//
-	// Delete removes the federated entry from the database.
+	// Delete removes the federated entry from the database, or replaces it
+	// with a Tombstone, depending on the value of the OnDelete setting. If
+	// an actor deletes itself and the Database is an ActorPurgeDatabase,
+	// everything held for the actor is purged.
 	Delete func(context.Context, vocab.ActivityStreamsDelete) error
+	// OnDelete determines what action to take for this particular callback
+	// if a Delete Activity is handled.
+	OnDelete OnDeleteBehavior
 	// Follow handles additional side effects for the Follow ActivityStreams
 	// type, specific to the application using go-fed.
 	//
 	// The wrapping function can have one of several default behaviors,
-	// depending on the value of the OnFollow setting.
+	// depending on the value of the OnFollow setting. Requests kept for
+	// manual approval are answered with ApproveFollow or RejectFollow.
 	Follow func(context.Context, vocab.ActivityStreamsFollow) error
 	// OnFollow determines what action to take for this particular callback
 	// if a Follow Activity is handled.
@@ -103,7 +132,23 @@ type FederatingWrappedCallbacks struct {
 	//
 	// The wrapping function will add the activity to the "shares"
 	// collection on all 'object' targets owned by this server.
+	//
+	// If FetchAnnounced is set, the 'object' IRIs not owned by this server
+	// are first dereferenced, stored with Create if not already in the
+	// database, and embedded in a copy of the activity given to Announce.
+	// Objects that cannot be dereferenced or verified are left as IRIs.
 	Announce func(context.Context, vocab.ActivityStreamsAnnounce) error
+	// FetchAnnounced determines whether the objects of an Announce Activity
+	// are dereferenced and stored when it is handled.
+	FetchAnnounced bool
+	// MaxAnnounceDepth limits the levels of nested Announce objects that
+	// are dereferenced when FetchAnnounced is set. DefaultMaxAnnounceDepth
+	// is used if zero.
+	MaxAnnounceDepth int
+	// MaxAnnounceSize limits the size, in bytes, of the objects that are
+	// stored when FetchAnnounced is set. Larger objects are neither stored
+	// nor embedded. DefaultMaxAnnounceSize is used if zero.
+	MaxAnnounceSize int
 	// Undo handles additional side effects for the Undo ActivityStreams
 	// type, specific to the application using go-fed.
 	//
@@ -123,6 +168,21 @@ type FederatingWrappedCallbacks struct {
 	// received from a federated peer, as delivering Blocks explicitly
 	// deviates from the original ActivityPub specification.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Flag handles additional side effects for the Flag ActivityStreams
+	// type, specific to the application using go-fed.
+	//
+	// The wrapping function builds a Report of the Flag. The Report is
+	// saved if the Database is a ReportDatabase, and is then passed to
+	// NotifyModerators.
+	//
+	// Flags are not passed to DefaultCallback, even if this is nil.
+	Flag func(context.Context, vocab.ActivityStreamsFlag) error
+	// NotifyModerators is called with the Report of every Flag received
+	// from a federated peer, after it has been saved.
+	NotifyModerators func(context.Context, *Report) error
+	// OriginCheck determines how the origin of the objects is verified
+	// when a Create or Update Activity is handled.
+	OriginCheck OriginCheckBehavior
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -138,6 +198,10 @@ type FederatingWrappedCallbacks struct {
 	deliver func(c context.Context, outboxIRI *url.URL, activity Activity) error
 	// newTransport creates a new Transport.
 	newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)
+	// clock is the server's clock.
+	clock Clock
+	// publish sends an Event to the EventSubscriber, if any.
+	publish func(c context.Context, e Event)
 }
 
 // callbacks returns the WrappedCallbacks members into a single interface slice
@@ -158,6 +222,7 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	enableAnnounce := true
 	enableUndo := true
 	enableBlock := true
+	enableFlag := true
 	for _, fn := range fns {
 		switch fn.(type) {
 		default:
@@ -186,6 +251,8 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 			enableUndo = false
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			enableBlock = false
+		case func(context.Context, vocab.ActivityStreamsFlag) error:
+			enableFlag = false
 		}
 	}
 	if enableCreate {
@@ -224,6 +291,9 @@ func (w FederatingWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	if enableBlock {
 		fns = append(fns, w.block)
 	}
+	if enableFlag {
+		fns = append(fns, w.flag)
+	}
 	return fns
 }
 
@@ -233,12 +303,17 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
+	if err := w.verifyActivityOrigin(a); err != nil {
+		return err
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
 		t := iter.GetType()
+		var fetchedFrom *url.URL
 		if t == nil && iter.IsIRI() {
 			// Attempt to dereference the IRI instead
+			fetchedFrom = iter.GetIRI()
 			tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
 			if err != nil {
 				return err
@@ -258,6 +333,10 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 		} else if t == nil {
 			return fmt.Errorf("cannot handle federated create: object is neither a value nor IRI")
 		}
+		t, err := w.verifyObjectOrigin(c, a, t, fetchedFrom)
+		if err != nil {
+			return err
+		}
 		id, err := GetId(t)
 		if err != nil {
 			return err
@@ -270,6 +349,10 @@ func (w FederatingWrappedCallbacks) create(c context.Context, a vocab.ActivitySt
 		if err := w.db.Create(c, t); err != nil {
 			return err
 		}
+		if err := setFetched(c, w.db, w.clock, id); err != nil {
+			return err
+		}
+		w.emit(c, EventObjectCreated, a, nil, id)
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -289,7 +372,14 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 	if op == nil || op.Len() == 0 {
 		return ErrObjectRequired
 	}
-	if err := mustHaveActivityOriginMatchObjects(a); err != nil {
+	// With OriginCheckRefetch, objects not in the activity origin are
+	// fetched from their own origin instead of being rejected.
+	if w.OriginCheck != OriginCheckRefetch {
+		if err := mustHaveActivityOriginMatchObjects(a); err != nil {
+			return err
+		}
+	}
+	if err := w.verifyActivityOrigin(a); err != nil {
 		return err
 	}
 	// Create anonymous loop function to be able to properly scope the defer
@@ -299,6 +389,10 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 		if t == nil {
 			return fmt.Errorf("update requires an object to be wholly provided")
 		}
+		t, err := w.verifyObjectOrigin(c, a, t, nil)
+		if err != nil {
+			return err
+		}
 		id, err := GetId(t)
 		if err != nil {
 			return err
@@ -311,6 +405,10 @@ func (w FederatingWrappedCallbacks) update(c context.Context, a vocab.ActivitySt
 		if err := w.db.Update(c, t); err != nil {
 			return err
 		}
+		if err := setFetched(c, w.db, w.clock, id); err != nil {
+			return err
+		}
+		w.emit(c, EventObjectUpdated, a, nil, id)
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -333,8 +431,21 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 	if err := mustHaveActivityOriginMatchObjects(a); err != nil {
 		return err
 	}
+	// Determine the actors of the Delete, to detect actors deleting
+	// themselves.
+	actorIds := make(map[string]bool)
+	if actors := a.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			actorIds[id.String()] = true
+		}
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
+	var collections, items []*url.URL
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
 		id, err := ToId(iter)
 		if err != nil {
@@ -345,8 +456,26 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 			return err
 		}
 		defer w.db.Unlock(c, id)
-		if err := w.db.Delete(c, id); err != nil {
+		if w.OnDelete == OnDeleteTombstone {
+			cols, its, tombstoned, err := w.tombstone(c, id)
+			if err != nil {
+				return err
+			}
+			collections = append(collections, cols...)
+			items = append(items, its...)
+			if tombstoned {
+				w.emit(c, EventObjectTombstoned, a, nil, id)
+			}
+		} else if err := w.db.Delete(c, id); err != nil {
 			return err
+		} else {
+			w.emit(c, EventObjectDeleted, a, nil, id)
+		}
+		if pdb, ok := w.db.(ActorPurgeDatabase); ok && actorIds[id.String()] {
+			if err := pdb.PurgeActor(c, id); err != nil {
+				return err
+			}
+			w.emit(c, EventActorPurged, a, nil, id)
 		}
 		return nil
 	}
@@ -355,12 +484,126 @@ func (w FederatingWrappedCallbacks) deleteFn(c context.Context, a vocab.Activity
 			return err
 		}
 	}
+	// Remove the cached collections of the deleted objects, and then the
+	// cached replies, likes, and shares they hold.
+	cachedLoopFn := func(id *url.URL, isCollection bool) error {
+		err := w.db.Lock(c, id)
+		if err != nil {
+			return err
+		}
+		defer w.db.Unlock(c, id)
+		if owns, err := w.db.Owns(c, id); err != nil {
+			return err
+		} else if owns {
+			return nil
+		}
+		if exists, err := w.db.Exists(c, id); err != nil {
+			return err
+		} else if !exists {
+			return nil
+		}
+		if isCollection {
+			col, err := w.db.Get(c, id)
+			if err != nil {
+				return err
+			}
+			items = append(items, collectionItemIds(col)...)
+		}
+		if err := w.db.Delete(c, id); err != nil {
+			return err
+		}
+		w.emit(c, EventObjectDeleted, a, nil, id)
+		return nil
+	}
+	for _, id := range collections {
+		if err := cachedLoopFn(id, true); err != nil {
+			return err
+		}
+	}
+	seen := make(map[string]bool, len(items))
+	for _, id := range items {
+		if seen[id.String()] {
+			continue
+		}
+		seen[id.String()] = true
+		if err := cachedLoopFn(id, false); err != nil {
+			return err
+		}
+	}
 	if w.Delete != nil {
 		return w.Delete(c, a)
 	}
 	return nil
 }
 
+// tombstone replaces the cached copy of a deleted object with a Tombstone. It
+// returns the ids of the 'replies', 'likes', and 'shares' collections of the
+// deleted object, the ids of the items of those embedded in the object, and
+// whether a Tombstone was written.
+//
+// The library makes this call only after acquiring a lock first.
+func (w FederatingWrappedCallbacks) tombstone(c context.Context, id *url.URL) (collections, items []*url.URL, tombstoned bool, err error) {
+	exists, err := w.db.Exists(c, id)
+	if err != nil {
+		return
+	} else if !exists {
+		return
+	}
+	t, err := w.db.Get(c, id)
+	if err != nil {
+		return
+	}
+	if streams.IsOrExtendsActivityStreamsTombstone(t) {
+		return
+	}
+	var props []IdProperty
+	if r, ok := t.(replieser); ok && r.GetActivityStreamsReplies() != nil {
+		props = append(props, r.GetActivityStreamsReplies())
+	}
+	if l, ok := t.(likeser); ok && l.GetActivityStreamsLikes() != nil {
+		props = append(props, l.GetActivityStreamsLikes())
+	}
+	if s, ok := t.(shareser); ok && s.GetActivityStreamsShares() != nil {
+		props = append(props, s.GetActivityStreamsShares())
+	}
+	for _, p := range props {
+		if cid, err := ToId(p); err == nil {
+			collections = append(collections, cid)
+		}
+		if col := p.GetType(); col != nil {
+			items = append(items, collectionItemIds(col)...)
+		}
+	}
+	err = w.db.Update(c, toTombstone(t, id, w.clock.Now()))
+	tombstoned = err == nil
+	return
+}
+
+// collectionItemIds returns the ids of the items of a collection, and of the
+// items of its first page when it is embedded.
+func collectionItemIds(t vocab.Type) (ids []*url.URL) {
+	if i, ok := t.(itemser); ok && i.GetActivityStreamsItems() != nil {
+		for iter := i.GetActivityStreamsItems().Begin(); iter != i.GetActivityStreamsItems().End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if oi, ok := t.(orderedItemser); ok && oi.GetActivityStreamsOrderedItems() != nil {
+		for iter := oi.GetActivityStreamsOrderedItems().Begin(); iter != oi.GetActivityStreamsOrderedItems().End(); iter = iter.Next() {
+			if id, err := ToId(iter); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if f, ok := t.(firster); ok && f.GetActivityStreamsFirst() != nil {
+		if page := f.GetActivityStreamsFirst().GetType(); page != nil {
+			ids = append(ids, collectionItemIds(page)...)
+		}
+	}
+	return
+}
+
 // follow implements the federating Follow activity side effects.
 func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivityStreamsFollow) error {
 	op := a.GetActivityStreamsObject()
@@ -396,36 +639,25 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 			}
 		}
 	}
-	if isMe {
+	if isMe && w.OnFollow == OnFollowManuallyApprove {
+		// Keep the Follow until the application decides to approve or
+		// reject it.
+		if err := addFollowRequest(c, w.db, actorIRI, a); err != nil {
+			return err
+		}
+	} else if isMe {
 		// Prepare the response.
-		var response Activity
+		var accept bool
 		if w.OnFollow == OnFollowAutomaticallyAccept {
-			response = streams.NewActivityStreamsAccept()
+			accept = true
 		} else if w.OnFollow == OnFollowAutomaticallyReject {
-			response = streams.NewActivityStreamsReject()
+			accept = false
 		} else {
 			return fmt.Errorf("unknown OnFollowBehavior: %d", w.OnFollow)
 		}
-		// Set us as the 'actor'.
-		me := streams.NewActivityStreamsActorProperty()
-		response.SetActivityStreamsActor(me)
-		me.AppendIRI(actorIRI)
-		// Set the Follow as the 'object' property.
-		op := streams.NewActivityStreamsObjectProperty()
-		response.SetActivityStreamsObject(op)
-		op.AppendActivityStreamsFollow(a)
-		// Add all actors on the original Follow to the 'to' property.
-		recipients := make([]*url.URL, 0)
-		to := streams.NewActivityStreamsToProperty()
-		response.SetActivityStreamsTo(to)
-		followActors := a.GetActivityStreamsActor()
-		for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
-			id, err := ToId(iter)
-			if err != nil {
-				return err
-			}
-			to.AppendIRI(id)
-			recipients = append(recipients, id)
+		response, recipients, err := newFollowResponse(a, actorIRI, accept)
+		if err != nil {
+			return err
 		}
 		if w.OnFollow == OnFollowAutomaticallyAccept {
 			// If automatically accepting, then also update our
@@ -452,6 +684,13 @@ func (w FederatingWrappedCallbacks) follow(c context.Context, a vocab.ActivitySt
 			}
 			w.db.Unlock(c, actorIRI)
 			// Unlock must be called by now and every branch above.
+			followersId, err := GetId(followers)
+			if err != nil {
+				return err
+			}
+			for _, elem := range recipients {
+				w.emit(c, EventFollowerAdded, a, followersId, elem)
+			}
 		}
 		// Lock without defer!
 		w.db.Lock(c, w.inboxIRI)
@@ -580,8 +819,8 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 				}
 				// Ensure that we are one of the actors on the Follow.
 				ok = false
-				actors := follow.GetActivityStreamsActor()
-				for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+				followActors := follow.GetActivityStreamsActor()
+				for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
 					id, err := ToId(iter)
 					if err != nil {
 						return err
@@ -635,6 +874,7 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 				return err
 			}
 			items := following.GetActivityStreamsItems()
+			var followed []*url.URL
 			for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
 				id, err := ToId(iter)
 				if err != nil {
@@ -642,6 +882,7 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 					return err
 				}
 				items.PrependIRI(id)
+				followed = append(followed, id)
 			}
 			if err = w.db.Update(c, following); err != nil {
 				w.db.Unlock(c, actorIRI)
@@ -649,6 +890,13 @@ func (w FederatingWrappedCallbacks) accept(c context.Context, a vocab.ActivitySt
 			}
 			w.db.Unlock(c, actorIRI)
 			// Unlock must be called by now and every branch above.
+			followingId, err := GetId(following)
+			if err != nil {
+				return err
+			}
+			for _, id := range followed {
+				w.emit(c, EventFollowingAdded, a, followingId, id)
+			}
 		}
 	}
 	if w.Accept != nil {
@@ -675,7 +923,10 @@ func (w FederatingWrappedCallbacks) add(c context.Context, a vocab.ActivityStrea
 	if target == nil || target.Len() == 0 {
 		return ErrTargetRequired
 	}
-	if err := add(c, op, target, w.db); err != nil {
+	added := func(target, object *url.URL) {
+		w.emit(c, EventCollectionItemAdded, a, target, object)
+	}
+	if err := add(c, op, target, w.db, added); err != nil {
 		return err
 	}
 	if w.Add != nil {
@@ -694,7 +945,10 @@ func (w FederatingWrappedCallbacks) remove(c context.Context, a vocab.ActivitySt
 	if target == nil || target.Len() == 0 {
 		return ErrTargetRequired
 	}
-	if err := remove(c, op, target, w.db); err != nil {
+	removed := func(target, object *url.URL) {
+		w.emit(c, EventCollectionItemRemoved, a, target, object)
+	}
+	if err := remove(c, op, target, w.db, removed); err != nil {
 		return err
 	}
 	if w.Remove != nil {
@@ -774,6 +1028,8 @@ func (w FederatingWrappedCallbacks) like(c context.Context, a vocab.ActivityStre
 		if err != nil {
 			return err
 		}
+		likesId, _ := GetId(likesT)
+		w.emit(c, EventLikeAdded, a, likesId, objId)
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -794,6 +1050,15 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 		return err
 	}
 	op := a.GetActivityStreamsObject()
+	// Embed the announced objects in a copy, leaving the received
+	// activity as it was delivered.
+	resolved := a
+	if w.FetchAnnounced {
+		resolved = a.Clone()
+		if err := w.fetchAnnounced(c, a, resolved.GetActivityStreamsObject(), 0); err != nil {
+			return err
+		}
+	}
 	// Create anonymous loop function to be able to properly scope the defer
 	// for the database lock at each iteration.
 	loopFn := func(iter vocab.ActivityStreamsObjectPropertyIterator) error {
@@ -855,6 +1120,8 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 		if err != nil {
 			return err
 		}
+		sharesId, _ := GetId(sharesT)
+		w.emit(c, EventShareAdded, a, sharesId, objId)
 		return nil
 	}
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
@@ -863,7 +1130,7 @@ func (w FederatingWrappedCallbacks) announce(c context.Context, a vocab.Activity
 		}
 	}
 	if w.Announce != nil {
-		return w.Announce(c, a)
+		return w.Announce(c, resolved)
 	}
 	return nil
 }
@@ -895,3 +1162,27 @@ func (w FederatingWrappedCallbacks) block(c context.Context, a vocab.ActivityStr
 	}
 	return nil
 }
+
+// flag implements the federating Flag activity side effects.
+func (w FederatingWrappedCallbacks) flag(c context.Context, a vocab.ActivityStreamsFlag) error {
+	r, err := NewReport(a)
+	if err != nil {
+		return err
+	}
+	r.Federated = true
+	if err := saveReport(c, w.db, r, w.NotifyModerators); err != nil {
+		return err
+	}
+	if w.Flag != nil {
+		return w.Flag(c, a)
+	}
+	return nil
+}
+
+// emit publishes the Event of a change to the database caused by the
+// activity.
+func (w FederatingWrappedCallbacks) emit(c context.Context, t EventType, a Activity, collection, object *url.URL) {
+	if w.publish != nil {
+		w.publish(c, newEvent(t, a, collection, object))
+	}
+}
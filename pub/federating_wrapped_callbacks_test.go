@@ -1,6 +1,10 @@
 package pub
 
 import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
 	"testing"
 )
 
@@ -113,6 +117,166 @@ func TestFederatedDelete(t *testing.T) {
 	})
 }
 
+// actorPurgeDatabase combines the mocks of the Database and the
+// ActorPurgeDatabase.
+type actorPurgeDatabase struct {
+	*MockDatabase
+	*MockActorPurgeDatabase
+}
+
+// newFederatedDelete creates a Delete by the actor of the object.
+func newFederatedDelete(actor, object string) vocab.ActivityStreamsDelete {
+	del := streams.NewActivityStreamsDelete()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI2))
+	del.SetJSONLDId(id)
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(mustParse(actor))
+	del.SetActivityStreamsActor(actorProp)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(object))
+	del.SetActivityStreamsObject(op)
+	return del
+}
+
+func TestFederatedDeleteBehaviors(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OnDeleteTombstoneReplacesCachedObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		clock := NewMockClock(ctl)
+		w := FederatingWrappedCallbacks{
+			OnDelete: OnDeleteTombstone,
+			db:       db,
+			clock:    clock,
+		}
+		noteId := mustParse(testFederatedActivityIRI)
+		repliesId := mustParse(testFederatedActivityIRI + "/replies")
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(noteId)
+		note.SetJSONLDId(id)
+		replies := streams.NewActivityStreamsRepliesProperty()
+		replies.SetIRI(repliesId)
+		note.SetActivityStreamsReplies(replies)
+		shareId := mustParse(testFederatedActivityIRI + "/announce")
+		shares := streams.NewActivityStreamsSharesProperty()
+		sharesCol := streams.NewActivityStreamsCollection()
+		sharesItems := streams.NewActivityStreamsItemsProperty()
+		sharesItems.AppendIRI(shareId)
+		sharesCol.SetActivityStreamsItems(sharesItems)
+		shares.SetActivityStreamsCollection(sharesCol)
+		note.SetActivityStreamsShares(shares)
+		replyId := mustParse(testFederatedActivityIRI2 + "/reply")
+		repliesCol := streams.NewActivityStreamsOrderedCollection()
+		repliesColId := streams.NewJSONLDIdProperty()
+		repliesColId.Set(repliesId)
+		repliesCol.SetJSONLDId(repliesColId)
+		repliesItems := streams.NewActivityStreamsOrderedItemsProperty()
+		repliesItems.AppendIRI(replyId)
+		repliesItems.AppendIRI(mustParse(testNoteId1))
+		repliesCol.SetActivityStreamsOrderedItems(repliesItems)
+		var tomb vocab.Type
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Exists(ctx, noteId).Return(true, nil),
+			db.EXPECT().Get(ctx, noteId).Return(note, nil),
+			clock.EXPECT().Now().Return(now()),
+			db.EXPECT().Update(ctx, gomock.Any()).Do(func(c context.Context, v vocab.Type) {
+				tomb = v
+			}),
+			db.EXPECT().Unlock(ctx, noteId),
+			db.EXPECT().Lock(ctx, repliesId),
+			db.EXPECT().Owns(ctx, repliesId).Return(false, nil),
+			db.EXPECT().Exists(ctx, repliesId).Return(true, nil),
+			db.EXPECT().Get(ctx, repliesId).Return(repliesCol, nil),
+			db.EXPECT().Delete(ctx, repliesId),
+			db.EXPECT().Unlock(ctx, repliesId),
+			db.EXPECT().Lock(ctx, shareId),
+			db.EXPECT().Owns(ctx, shareId).Return(false, nil),
+			db.EXPECT().Exists(ctx, shareId).Return(true, nil),
+			db.EXPECT().Delete(ctx, shareId),
+			db.EXPECT().Unlock(ctx, shareId),
+			db.EXPECT().Lock(ctx, replyId),
+			db.EXPECT().Owns(ctx, replyId).Return(false, nil),
+			db.EXPECT().Exists(ctx, replyId).Return(true, nil),
+			db.EXPECT().Delete(ctx, replyId),
+			db.EXPECT().Unlock(ctx, replyId),
+			db.EXPECT().Lock(ctx, mustParse(testNoteId1)),
+			db.EXPECT().Owns(ctx, mustParse(testNoteId1)).Return(true, nil),
+			db.EXPECT().Unlock(ctx, mustParse(testNoteId1)),
+		)
+		// Run
+		err := w.deleteFn(ctx, newFederatedDelete(testFederatedActorIRI, testFederatedActivityIRI))
+		// Verify
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(tomb), mustSerializeToBytes(toTombstone(note, noteId, now())))
+	})
+	t.Run("OnDeleteTombstoneIgnoresUncachedObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		w := FederatingWrappedCallbacks{
+			OnDelete: OnDeleteTombstone,
+			db:       db,
+		}
+		noteId := mustParse(testFederatedActivityIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Exists(ctx, noteId).Return(false, nil),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.deleteFn(ctx, newFederatedDelete(testFederatedActorIRI, testFederatedActivityIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("PurgesActorDeletingItself", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		pdb := NewMockActorPurgeDatabase(ctl)
+		w := FederatingWrappedCallbacks{
+			db: actorPurgeDatabase{db, pdb},
+		}
+		actorId := mustParse(testFederatedActorIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, actorId),
+			db.EXPECT().Delete(ctx, actorId),
+			pdb.EXPECT().PurgeActor(ctx, actorId),
+			db.EXPECT().Unlock(ctx, actorId),
+		)
+		// Run
+		err := w.deleteFn(ctx, newFederatedDelete(testFederatedActorIRI, testFederatedActorIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("DoesNotPurgeActorOfDeletedObject", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		pdb := NewMockActorPurgeDatabase(ctl)
+		w := FederatingWrappedCallbacks{
+			db: actorPurgeDatabase{db, pdb},
+		}
+		noteId := mustParse(testFederatedActivityIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Delete(ctx, noteId),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.deleteFn(ctx, newFederatedDelete(testFederatedActorIRI, testFederatedActivityIRI))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
+
 func TestFederatedFollow(t *testing.T) {
 	t.Run("ErrorIfNoObject", func(t *testing.T) {
 		t.Errorf("Not yet implemented.")
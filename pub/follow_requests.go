@@ -0,0 +1,243 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+var (
+	// ErrFollowRequestNotFound indicates that the Follow to approve or
+	// reject is not pending for the actor.
+	ErrFollowRequestNotFound = errors.New("follow request is not pending")
+)
+
+// ApproveFollow accepts a pending Follow request received by the actor owning
+// the outbox.
+//
+// The Accept is sent through the FederatingActor, so it is given a new id,
+// added to the outbox, and delivered to the actors of the Follow. Those actors
+// are then added to the followers collection, with an EventFollowerAdded for
+// each, and the request is removed from the pending requests.
+//
+// The Database must also be a FollowRequestDatabase.
+func ApproveFollow(c context.Context, a FederatingActor, db Database, outboxIRI, followIRI *url.URL) (Activity, error) {
+	return respondToFollowRequest(c, a, db, outboxIRI, followIRI, true)
+}
+
+// RejectFollow rejects a pending Follow request received by the actor owning
+// the outbox.
+//
+// The Reject is sent through the FederatingActor, so it is given a new id,
+// added to the outbox, and delivered to the actors of the Follow. The request
+// is then removed from the pending requests without modifying the followers
+// collection.
+//
+// The Database must also be a FollowRequestDatabase.
+func RejectFollow(c context.Context, a FederatingActor, db Database, outboxIRI, followIRI *url.URL) (Activity, error) {
+	return respondToFollowRequest(c, a, db, outboxIRI, followIRI, false)
+}
+
+// respondToFollowRequest implements the common logic of ApproveFollow and
+// RejectFollow.
+func respondToFollowRequest(c context.Context, a FederatingActor, db Database, outboxIRI, followIRI *url.URL, accept bool) (Activity, error) {
+	frdb, ok := db.(FollowRequestDatabase)
+	if !ok {
+		return nil, fmt.Errorf("database %T is not a FollowRequestDatabase", db)
+	}
+	// Get this actor's IRI.
+	if err := db.Lock(c, outboxIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := db.ActorForOutbox(c, outboxIRI)
+	if err != nil {
+		db.Unlock(c, outboxIRI)
+		return nil, err
+	}
+	db.Unlock(c, outboxIRI)
+	// Unlock must be called by now and every branch above.
+	//
+	// Find the pending Follow.
+	if err := db.Lock(c, actorIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	requests, err := frdb.FollowRequests(c, actorIRI)
+	if err != nil {
+		db.Unlock(c, actorIRI)
+		return nil, err
+	}
+	follow, err := findFollowRequest(requests, followIRI)
+	db.Unlock(c, actorIRI)
+	// Unlock must be called by now and every branch above.
+	if err != nil {
+		return nil, err
+	}
+	response, recipients, err := newFollowResponse(follow, actorIRI, accept)
+	if err != nil {
+		return nil, err
+	}
+	activity, err := a.Send(c, outboxIRI, response)
+	if err != nil {
+		return nil, err
+	}
+	// Now that the response is sent, update the followers and remove the
+	// pending request.
+	if err := db.Lock(c, actorIRI); err != nil {
+		return nil, err
+	}
+	defer db.Unlock(c, actorIRI)
+	if accept {
+		followers, err := db.Followers(c, actorIRI)
+		if err != nil {
+			return nil, err
+		}
+		items := followers.GetActivityStreamsItems()
+		if items == nil {
+			items = streams.NewActivityStreamsItemsProperty()
+			followers.SetActivityStreamsItems(items)
+		}
+		for _, elem := range recipients {
+			items.PrependIRI(elem)
+		}
+		if err = db.Update(c, followers); err != nil {
+			return nil, err
+		}
+		followersId, err := GetId(followers)
+		if err != nil {
+			return nil, err
+		}
+		for _, elem := range recipients {
+			publishTo(c, a, newEvent(EventFollowerAdded, activity, followersId, elem))
+		}
+	}
+	requests, err = frdb.FollowRequests(c, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	if err = removeFollowRequest(requests, followIRI); err != nil {
+		return nil, err
+	}
+	if err = db.Update(c, requests); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// addFollowRequest prepends the Follow to the pending requests of the actor,
+// unless it is already pending.
+func addFollowRequest(c context.Context, db Database, actorIRI *url.URL, follow vocab.ActivityStreamsFollow) error {
+	frdb, ok := db.(FollowRequestDatabase)
+	if !ok {
+		return fmt.Errorf("database %T is not a FollowRequestDatabase", db)
+	}
+	followIRI, err := GetId(follow)
+	if err != nil {
+		return err
+	}
+	if err := db.Lock(c, actorIRI); err != nil {
+		return err
+	}
+	defer db.Unlock(c, actorIRI)
+	requests, err := frdb.FollowRequests(c, actorIRI)
+	if err != nil {
+		return err
+	}
+	if _, err := findFollowRequest(requests, followIRI); err == nil {
+		return nil
+	} else if err != ErrFollowRequestNotFound {
+		return err
+	}
+	oi := requests.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+		requests.SetActivityStreamsOrderedItems(oi)
+	}
+	oi.PrependActivityStreamsFollow(follow)
+	return db.Update(c, requests)
+}
+
+// findFollowRequest returns the pending Follow with the given id.
+//
+// Returns ErrFollowRequestNotFound if there is no such Follow.
+func findFollowRequest(requests vocab.ActivityStreamsOrderedCollection, followIRI *url.URL) (vocab.ActivityStreamsFollow, error) {
+	oi := requests.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		return nil, ErrFollowRequestNotFound
+	}
+	for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+		if !iter.IsActivityStreamsFollow() {
+			continue
+		}
+		follow := iter.GetActivityStreamsFollow()
+		id, err := GetId(follow)
+		if err != nil {
+			return nil, err
+		}
+		if id.String() == followIRI.String() {
+			return follow, nil
+		}
+	}
+	return nil, ErrFollowRequestNotFound
+}
+
+// removeFollowRequest removes the pending Follow with the given id.
+func removeFollowRequest(requests vocab.ActivityStreamsOrderedCollection, followIRI *url.URL) error {
+	oi := requests.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		return nil
+	}
+	for i := 0; i < oi.Len(); /*Conditional*/ {
+		id, err := ToId(oi.At(i))
+		if err != nil {
+			return err
+		}
+		if id.String() == followIRI.String() {
+			oi.Remove(i)
+		} else {
+			i++
+		}
+	}
+	return nil
+}
+
+// newFollowResponse creates the Accept or Reject of a Follow on behalf of the
+// followed actor. It is addressed to all of the actors of the Follow, which
+// are also returned.
+func newFollowResponse(follow vocab.ActivityStreamsFollow, actorIRI *url.URL, accept bool) (response Activity, recipients []*url.URL, err error) {
+	if accept {
+		response = streams.NewActivityStreamsAccept()
+	} else {
+		response = streams.NewActivityStreamsReject()
+	}
+	// Set us as the 'actor'.
+	me := streams.NewActivityStreamsActorProperty()
+	response.SetActivityStreamsActor(me)
+	me.AppendIRI(actorIRI)
+	// Set the Follow as the 'object' property.
+	op := streams.NewActivityStreamsObjectProperty()
+	response.SetActivityStreamsObject(op)
+	op.AppendActivityStreamsFollow(follow)
+	// Add all actors on the original Follow to the 'to' property.
+	recipients = make([]*url.URL, 0)
+	to := streams.NewActivityStreamsToProperty()
+	response.SetActivityStreamsTo(to)
+	followActors := follow.GetActivityStreamsActor()
+	if followActors == nil {
+		return
+	}
+	for iter := followActors.Begin(); iter != followActors.End(); iter = iter.Next() {
+		var id *url.URL
+		id, err = ToId(iter)
+		if err != nil {
+			return
+		}
+		to.AppendIRI(id)
+		recipients = append(recipients, id)
+	}
+	return
+}
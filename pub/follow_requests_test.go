@@ -0,0 +1,283 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+const (
+	testMyActorIRI     = "https://example.com/addison"
+	testFollowersIRI   = "https://example.com/addison/followers"
+	testFollowRequests = "https://example.com/addison/requests"
+)
+
+// followRequestDatabase combines the mocks of the Database and the
+// FollowRequestDatabase.
+type followRequestDatabase struct {
+	*MockDatabase
+	*MockFollowRequestDatabase
+}
+
+// subscribingDelegate is a DelegateActor that also is an EventSubscriber.
+type subscribingDelegate struct {
+	*MockDelegateActor
+	*MockEventSubscriber
+}
+
+// newFollowOfMe creates a Follow from a federated peer of the test actor.
+func newFollowOfMe() vocab.ActivityStreamsFollow {
+	follow := streams.NewActivityStreamsFollow()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI))
+	follow.SetJSONLDId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(mustParse(testFederatedActorIRI))
+	follow.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(testMyActorIRI))
+	follow.SetActivityStreamsObject(op)
+	return follow
+}
+
+// newFollowRequests creates a pending requests collection with the given
+// Follows.
+func newFollowRequests(follows ...vocab.ActivityStreamsFollow) vocab.ActivityStreamsOrderedCollection {
+	oc := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFollowRequests))
+	oc.SetJSONLDId(id)
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, f := range follows {
+		oi.AppendActivityStreamsFollow(f)
+	}
+	oc.SetActivityStreamsOrderedItems(oi)
+	return oc
+}
+
+// newFollowers creates an empty followers collection.
+func newFollowers() vocab.ActivityStreamsCollection {
+	col := streams.NewActivityStreamsCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFollowersIRI))
+	col.SetJSONLDId(id)
+	col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+	return col
+}
+
+// TestFollowRequests ensures Follow requests are kept pending and that
+// approving and rejecting them has the proper side effects.
+func TestFollowRequests(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, frdb *MockFollowRequestDatabase, d *MockDelegateActor, a FederatingActor, both Database) {
+		setupData()
+		db = NewMockDatabase(ctl)
+		frdb = NewMockFollowRequestDatabase(ctl)
+		d = NewMockDelegateActor(ctl)
+		a = NewCustomActor(d, false, true, NewMockClock(ctl))
+		both = followRequestDatabase{db, frdb}
+		return
+	}
+	t.Run("FollowIsStoredAsPending", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, frdb, _, _, both := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		follow := newFollowOfMe()
+		w := FederatingWrappedCallbacks{
+			OnFollow: OnFollowManuallyApprove,
+			db:       both,
+			inboxIRI: inboxIRI,
+		}
+		var updated vocab.Type
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(), nil),
+			db.EXPECT().Update(ctx, gomock.Any()).Do(func(c context.Context, v vocab.Type) {
+				updated = v
+			}),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := w.follow(ctx, follow)
+		// Verify
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(updated), mustSerializeToBytes(newFollowRequests(follow)))
+	})
+	t.Run("FollowAlreadyPendingIsNotStoredTwice", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, frdb, _, _, both := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		follow := newFollowOfMe()
+		w := FederatingWrappedCallbacks{
+			OnFollow: OnFollowManuallyApprove,
+			db:       both,
+			inboxIRI: inboxIRI,
+		}
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			db.EXPECT().ActorForInbox(ctx, inboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		err := w.follow(ctx, follow)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("ApproveFollowSendsAcceptAndAddsFollower", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, frdb, d, a, both := setupFn(ctl)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		followIRI := mustParse(testFederatedActivityIRI)
+		follow := newFollowOfMe()
+		followers := newFollowers()
+		var sent Activity
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+			d.EXPECT().AddNewIds(ctx, gomock.Any()).Do(func(c context.Context, a Activity) {
+				sent = a
+			}),
+			d.EXPECT().PostOutbox(ctx, gomock.Any(), outboxIRI, gomock.Any()).Return(true, nil),
+			d.EXPECT().Deliver(ctx, outboxIRI, gomock.Any()),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Followers(ctx, actorIRI).Return(followers, nil),
+			db.EXPECT().Update(ctx, followers),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Update(ctx, gomock.Any()).Do(func(c context.Context, v vocab.Type) {
+				assertByteEqual(t, mustSerializeToBytes(v), mustSerializeToBytes(newFollowRequests()))
+			}),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		_, err := ApproveFollow(ctx, a, both, outboxIRI, followIRI)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsAccept(sent), true)
+		assertEqual(t, followers.GetActivityStreamsItems().Len(), 1)
+		assertEqual(t, followers.GetActivityStreamsItems().At(0).GetIRI().String(), testFederatedActorIRI)
+	})
+	t.Run("ApproveFollowPublishesFollowerAdded", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, frdb, d, _, both := setupFn(ctl)
+		es := NewMockEventSubscriber(ctl)
+		a := NewCustomActor(subscribingDelegate{d, es}, false, true, NewMockClock(ctl))
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		followIRI := mustParse(testFederatedActivityIRI)
+		follow := newFollowOfMe()
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+			d.EXPECT().AddNewIds(ctx, gomock.Any()),
+			d.EXPECT().PostOutbox(ctx, gomock.Any(), outboxIRI, gomock.Any()).Return(true, nil),
+			d.EXPECT().Deliver(ctx, outboxIRI, gomock.Any()),
+			db.EXPECT().Lock(ctx, actorIRI),
+			db.EXPECT().Followers(ctx, actorIRI).Return(newFollowers(), nil),
+			db.EXPECT().Update(ctx, gomock.Any()),
+			es.EXPECT().Receive(ctx, Event{
+				Type:       EventFollowerAdded,
+				Actor:      actorIRI,
+				Collection: mustParse(testFollowersIRI),
+				Object:     mustParse(testFederatedActorIRI),
+			}),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Update(ctx, gomock.Any()),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		_, err := ApproveFollow(ctx, a, both, outboxIRI, followIRI)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("RejectFollowSendsRejectWithoutAddingFollower", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, frdb, d, a, both := setupFn(ctl)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		followIRI := mustParse(testFederatedActivityIRI)
+		follow := newFollowOfMe()
+		var sent Activity
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+			d.EXPECT().AddNewIds(ctx, gomock.Any()).Do(func(c context.Context, a Activity) {
+				sent = a
+			}),
+			d.EXPECT().PostOutbox(ctx, gomock.Any(), outboxIRI, gomock.Any()).Return(true, nil),
+			d.EXPECT().Deliver(ctx, outboxIRI, gomock.Any()),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(follow), nil),
+			db.EXPECT().Update(ctx, gomock.Any()),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		_, err := RejectFollow(ctx, a, both, outboxIRI, followIRI)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, streams.IsOrExtendsActivityStreamsReject(sent), true)
+	})
+	t.Run("ErrorIfFollowNotPending", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, frdb, _, a, both := setupFn(ctl)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		followIRI := mustParse(testFederatedActivityIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+			db.EXPECT().Lock(ctx, actorIRI),
+			frdb.EXPECT().FollowRequests(ctx, actorIRI).Return(newFollowRequests(), nil),
+			db.EXPECT().Unlock(ctx, actorIRI),
+		)
+		// Run
+		_, err := ApproveFollow(ctx, a, both, outboxIRI, followIRI)
+		// Verify
+		assertEqual(t, err, ErrFollowRequestNotFound)
+	})
+	t.Run("ErrorIfNotFollowRequestDatabase", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, _, _, a, _ := setupFn(ctl)
+		// Run
+		_, err := ApproveFollow(ctx, a, db, mustParse(testMyOutboxIRI), mustParse(testFederatedActivityIRI))
+		// Verify
+		assertNotEqual(t, err, nil)
+	})
+}
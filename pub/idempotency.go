@@ -0,0 +1,152 @@
+package pub
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultIdempotencyWindow is how long a MemoryIdempotencyStore
+	// remembers a key when its window is not set.
+	DefaultIdempotencyWindow = 24 * time.Hour
+)
+
+// IdempotencyStore remembers the activity created by a POST to an outbox with
+// an Idempotency-Key header, so a client retrying the POST, such as after a
+// timeout, gets the same activity instead of publishing and delivering it
+// twice.
+//
+// It is optionally implemented by the SocialProtocol, such as by embedding a
+// MemoryIdempotencyStore, or by the DelegateActor of NewCustomActor. When it
+// is, a POST to an outbox with a key already set is answered with the
+// Location of the activity it created, without running side effects or
+// delivering it again.
+//
+// Keys are scoped by the outbox, since different clients may choose the same
+// key. How long a key is remembered is up to the store.
+type IdempotencyStore interface {
+	// LockIdempotencyKey takes the lock of the key of the outbox, so that
+	// a retry arriving while the original POST is still processed waits
+	// for it to finish.
+	LockIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) error
+	// UnlockIdempotencyKey releases the lock of the key of the outbox.
+	UnlockIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) error
+	// GetIdempotencyKey returns the id of the activity created with the
+	// key of the outbox, or nil if the key is not set.
+	//
+	// The library makes this call only after acquiring a lock first.
+	GetIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) (id *url.URL, err error)
+	// SetIdempotencyKey records the id of the activity created with the
+	// key of the outbox.
+	//
+	// The library makes this call only after acquiring a lock first.
+	SetIdempotencyKey(c context.Context, outboxIRI *url.URL, key string, id *url.URL) error
+}
+
+// MemoryIdempotencyStore is an IdempotencyStore keeping the keys in memory,
+// for applications running in a single process.
+type MemoryIdempotencyStore struct {
+	window time.Duration
+	clock  Clock
+	mu     sync.Mutex
+	// swept is when the keys whose window has passed were last forgotten.
+	swept time.Time
+	keys  map[string]idempotencyEntry
+	locks map[string]chan struct{}
+}
+
+var _ IdempotencyStore = &MemoryIdempotencyStore{}
+
+// idempotencyEntry is the activity created with a key.
+type idempotencyEntry struct {
+	id      *url.URL
+	expires time.Time
+}
+
+// NewMemoryIdempotencyStore creates a MemoryIdempotencyStore remembering each
+// key for the window, or for the DefaultIdempotencyWindow if it is zero.
+func NewMemoryIdempotencyStore(window time.Duration, clock Clock) *MemoryIdempotencyStore {
+	if window == 0 {
+		window = DefaultIdempotencyWindow
+	}
+	return &MemoryIdempotencyStore{
+		window: window,
+		clock:  clock,
+		swept:  clock.Now(),
+		keys:   make(map[string]idempotencyEntry),
+		locks:  make(map[string]chan struct{}),
+	}
+}
+
+// LockIdempotencyKey waits until the key is not locked, or the context is
+// done.
+func (s *MemoryIdempotencyStore) LockIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) error {
+	k := idempotencyKey(outboxIRI, key)
+	for {
+		s.mu.Lock()
+		held, ok := s.locks[k]
+		if !ok {
+			s.locks[k] = make(chan struct{})
+			s.mu.Unlock()
+			return nil
+		}
+		s.mu.Unlock()
+		select {
+		case <-held:
+		case <-c.Done():
+			return c.Err()
+		}
+	}
+}
+
+// UnlockIdempotencyKey releases the lock of the key.
+func (s *MemoryIdempotencyStore) UnlockIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) error {
+	k := idempotencyKey(outboxIRI, key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if held, ok := s.locks[k]; ok {
+		close(held)
+		delete(s.locks, k)
+	}
+	return nil
+}
+
+// GetIdempotencyKey returns the id of the activity created with the key within
+// the window.
+func (s *MemoryIdempotencyStore) GetIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) (*url.URL, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.keys[idempotencyKey(outboxIRI, key)]
+	if !ok || !s.clock.Now().Before(e.expires) {
+		return nil, nil
+	}
+	return e.id, nil
+}
+
+// SetIdempotencyKey records the id of the activity created with the key. Once
+// per window, it also forgets the keys whose window has passed.
+func (s *MemoryIdempotencyStore) SetIdempotencyKey(c context.Context, outboxIRI *url.URL, key string, id *url.URL) error {
+	now := s.clock.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.Sub(s.swept) >= s.window {
+		for k, e := range s.keys {
+			if !now.Before(e.expires) {
+				delete(s.keys, k)
+			}
+		}
+		s.swept = now
+	}
+	s.keys[idempotencyKey(outboxIRI, key)] = idempotencyEntry{
+		id:      id,
+		expires: now.Add(s.window),
+	}
+	return nil
+}
+
+// idempotencyKey scopes the key to the outbox.
+func idempotencyKey(outboxIRI *url.URL, key string) string {
+	return outboxIRI.String() + " " + key
+}
@@ -0,0 +1,168 @@
+package pub
+
+import (
+	"context"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// idempotentDelegate is a DelegateActor that also is an IdempotencyStore.
+type idempotentDelegate struct {
+	*MockDelegateActor
+	*MockIdempotencyStore
+}
+
+// TestIdempotencyKey tests retrying a POST to an outbox with an
+// Idempotency-Key header.
+func TestIdempotencyKey(t *testing.T) {
+	setupData()
+	ctx := context.Background()
+	const key = "retry-me"
+	setupFn := func(ctl *gomock.Controller) (delegate *MockDelegateActor, store *MockIdempotencyStore, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		store = NewMockIdempotencyStore(ctl)
+		a = NewCustomActor(
+			idempotentDelegate{delegate, store},
+			/*enableSocialProtocol=*/ true,
+			/*enableFederatedProtocol=*/ false,
+			NewMockClock(ctl))
+		return
+	}
+	t.Run("SetsKeyOfNewActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, store, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
+		req.Header.Set(idempotencyKeyHeader, key)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		gomock.InOrder(
+			store.EXPECT().LockIdempotencyKey(ctx, outboxIRI, key),
+			store.EXPECT().GetIdempotencyKey(ctx, outboxIRI, key).Return(nil, nil),
+			delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil),
+			delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+				withNewId(activity)
+				return nil
+			}),
+			delegate.EXPECT().PostOutbox(
+				ctx,
+				withNewId(toDeserializedForm(testCreateNoId)),
+				outboxIRI,
+				mustSerialize(testCreateNoId),
+			).Return(true, nil),
+			store.EXPECT().SetIdempotencyKey(ctx, outboxIRI, key, mustParse(testNewActivityIRI)),
+			store.EXPECT().UnlockIdempotencyKey(ctx, outboxIRI, key),
+		)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+		assertEqual(t, resp.Result().Header.Get(locationHeader), testNewActivityIRI)
+	})
+	t.Run("RespondsWithActivityOfRetriedKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, store, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
+		req.Header.Set(idempotencyKeyHeader, key)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		gomock.InOrder(
+			store.EXPECT().LockIdempotencyKey(ctx, outboxIRI, key),
+			store.EXPECT().GetIdempotencyKey(ctx, outboxIRI, key).Return(mustParse(testNewActivityIRI), nil),
+			store.EXPECT().UnlockIdempotencyKey(ctx, outboxIRI, key),
+		)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+		assertEqual(t, resp.Result().Header.Get(locationHeader), testNewActivityIRI)
+	})
+	t.Run("IgnoresStoreWithoutKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, _, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(testCreateNoId))
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AddNewIds(ctx, toDeserializedForm(testCreateNoId)).DoAndReturn(func(c context.Context, activity Activity) error {
+			withNewId(activity)
+			return nil
+		})
+		delegate.EXPECT().PostOutbox(
+			ctx,
+			withNewId(toDeserializedForm(testCreateNoId)),
+			mustParse(testMyOutboxIRI),
+			mustSerialize(testCreateNoId),
+		).Return(true, nil)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusCreated)
+	})
+}
+
+func TestMemoryIdempotencyStore(t *testing.T) {
+	ctx := context.Background()
+	outboxIRI := mustParse(testMyOutboxIRI)
+	id := mustParse(testNewActivityIRI)
+	setupFn := func(ctl *gomock.Controller) (s *MemoryIdempotencyStore, now *time.Time) {
+		cl := NewMockClock(ctl)
+		t := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		now = &t
+		cl.EXPECT().Now().DoAndReturn(func() time.Time { return *now }).AnyTimes()
+		s = NewMemoryIdempotencyStore(time.Hour, cl)
+		return
+	}
+	t.Run("RemembersKeyWithinWindow", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		s, now := setupFn(ctl)
+		// Run
+		err := s.SetIdempotencyKey(ctx, outboxIRI, "a", id)
+		// Verify
+		assertEqual(t, err, nil)
+		got, err := s.GetIdempotencyKey(ctx, outboxIRI, "a")
+		assertEqual(t, err, nil)
+		assertEqual(t, got.String(), id.String())
+		got, err = s.GetIdempotencyKey(ctx, mustParse(testMyInboxIRI), "a")
+		assertEqual(t, err, nil)
+		assertEqual(t, got == nil, true)
+		*now = now.Add(time.Hour)
+		got, err = s.GetIdempotencyKey(ctx, outboxIRI, "a")
+		assertEqual(t, err, nil)
+		assertEqual(t, got == nil, true)
+	})
+	t.Run("LockWaitsForUnlock", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		s, _ := setupFn(ctl)
+		assertEqual(t, s.LockIdempotencyKey(ctx, outboxIRI, "a"), nil)
+		// Run
+		timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+		err := s.LockIdempotencyKey(timeout, outboxIRI, "a")
+		// Verify
+		assertEqual(t, err, context.DeadlineExceeded)
+		assertEqual(t, s.LockIdempotencyKey(ctx, outboxIRI, "b"), nil)
+		assertEqual(t, s.UnlockIdempotencyKey(ctx, outboxIRI, "a"), nil)
+		assertEqual(t, s.LockIdempotencyKey(ctx, outboxIRI, "a"), nil)
+	})
+}
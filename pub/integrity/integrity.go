@@ -0,0 +1,328 @@
+// Package integrity creates and verifies Data Integrity proofs of
+// ActivityStreams documents with the eddsa-jcs-2022 cryptographic suite, as
+// described by FEP-8b32.
+//
+// A proof travels with the document it proves, so an activity relayed or
+// forwarded by another server can be verified against the Ed25519 key of its
+// author. The keys of actors are published as Multikey values of their
+// assertionMethod property, which EncodeMultikey and DecodeMultikey convert.
+//
+// The document without its proofs and the proof options are each encoded with
+// the JSON Canonicalization Scheme of streams.Canonicalize and hashed with
+// SHA-256. The concatenation of the hash of the options then of the document
+// is signed with Ed25519, and the signature is the base58btc multibase
+// proofValue.
+package integrity
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/jsonld"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"time"
+)
+
+const (
+	// DataIntegrityProof is the type of the proofs of this package.
+	DataIntegrityProof = "DataIntegrityProof"
+	// Cryptosuite is the cryptographic suite of the proofs of this
+	// package.
+	Cryptosuite = "eddsa-jcs-2022"
+	// AssertionMethod is the purpose of the proofs of this package: the
+	// key is one of the assertionMethod of its controller.
+	AssertionMethod = "assertionMethod"
+
+	contextKey            = "@context"
+	proofKey              = "proof"
+	typeKey               = "type"
+	cryptosuiteKey        = "cryptosuite"
+	proofPurposeKey       = "proofPurpose"
+	verificationMethodKey = "verificationMethod"
+	createdKey            = "created"
+	proofValueKey         = "proofValue"
+
+	// multibaseBase58 is the multibase prefix of base58btc.
+	multibaseBase58 = 'z'
+)
+
+// multicodecEd25519Pub is the multicodec prefix of Ed25519 public keys.
+var multicodecEd25519Pub = []byte{0xed, 0x01}
+
+// ErrNoProof indicates that a document has no proof.
+var ErrNoProof = errors.New("document has no data integrity proof")
+
+// VerificationError indicates that a proof of a document is invalid, or is
+// not made with the key of the expected controller.
+type VerificationError struct {
+	// Err describes why the proof is invalid.
+	Err error
+}
+
+// Error returns the reason the proof is invalid.
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("invalid data integrity proof: %v", e.Err)
+}
+
+// IsVerificationError returns true if the error is a VerificationError.
+func IsVerificationError(err error) bool {
+	_, ok := err.(*VerificationError)
+	return ok
+}
+
+// KeyResolver returns the public keys of verification methods, such as from a
+// cache of the assertionMethod of actors, so that proofs are verified without
+// network access.
+type KeyResolver interface {
+	// ResolveKey returns the Ed25519 public key of the verification
+	// method, and the IRI of its controller, such as the actor whose
+	// assertionMethod it is.
+	ResolveKey(c context.Context, verificationMethod *url.URL) (key ed25519.PublicKey, controller *url.URL, err error)
+}
+
+// Provable is an ActivityStreams value with proofs, such as any type extending
+// Object.
+type Provable interface {
+	vocab.Type
+	// GetW3IDDataIntegrityV1Proof returns the "proof" property if it
+	// exists, and nil otherwise.
+	GetW3IDDataIntegrityV1Proof() vocab.W3IDDataIntegrityV1ProofProperty
+	// SetW3IDDataIntegrityV1Proof sets the "proof" property.
+	SetW3IDDataIntegrityV1Proof(i vocab.W3IDDataIntegrityV1ProofProperty)
+}
+
+// EncodeMultikey returns the publicKeyMultibase of an Ed25519 public key.
+func EncodeMultikey(key ed25519.PublicKey) string {
+	return string(multibaseBase58) + base58Encode(append(append([]byte(nil), multicodecEd25519Pub...), key...))
+}
+
+// DecodeMultikey returns the Ed25519 public key of a publicKeyMultibase.
+func DecodeMultikey(s string) (ed25519.PublicKey, error) {
+	b, err := decodeMultibase(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != len(multicodecEd25519Pub)+ed25519.PublicKeySize || b[0] != multicodecEd25519Pub[0] || b[1] != multicodecEd25519Pub[1] {
+		return nil, fmt.Errorf("multikey is not an Ed25519 public key")
+	}
+	return ed25519.PublicKey(b[len(multicodecEd25519Pub):]), nil
+}
+
+// Sign returns a copy of the document with an eddsa-jcs-2022 proof made with
+// the private key, added to any existing proofs. The verification method is
+// the IRI of the public key, such as the id of the Multikey in the
+// assertionMethod of the actor, and created is the time of signing.
+//
+// The Data Integrity context is added to the @context of the copy if it is
+// missing, so that the proof is understood.
+func Sign(c context.Context, m map[string]interface{}, key ed25519.PrivateKey, verificationMethod *url.URL, created time.Time) (map[string]interface{}, error) {
+	doc := jsonld.CopyObject(m)
+	proofs := toSlice(doc[proofKey])
+	delete(doc, proofKey)
+	if raw, ok := doc[contextKey]; ok && !references(raw, jsonld.DataIntegrityV1Context) {
+		doc[contextKey] = append(toSlice(raw), jsonld.DataIntegrityV1Context)
+	}
+	options := map[string]interface{}{
+		typeKey:               DataIntegrityProof,
+		cryptosuiteKey:        Cryptosuite,
+		proofPurposeKey:       AssertionMethod,
+		verificationMethodKey: verificationMethod.String(),
+		createdKey:            created.UTC().Truncate(time.Second).Format(time.RFC3339),
+	}
+	data, err := hashData(doc, options)
+	if err != nil {
+		return nil, err
+	}
+	options[proofValueKey] = string(multibaseBase58) + base58Encode(ed25519.Sign(key, data))
+	if len(proofs) == 0 {
+		doc[proofKey] = options
+	} else {
+		doc[proofKey] = append(proofs, options)
+	}
+	return doc, nil
+}
+
+// Verify verifies every proof of the document with the keys returned by the
+// resolver, and returns the controllers of the keys in the order of the
+// proofs. The document must be as received, as any change to it, including
+// to its @context, invalidates the proofs.
+//
+// A VerificationError is returned if a proof is invalid or is not an
+// eddsa-jcs-2022 proof of the assertionMethod purpose, and ErrNoProof if there
+// is no proof. Errors of the resolver are returned as is.
+func Verify(c context.Context, m map[string]interface{}, r KeyResolver) ([]*url.URL, error) {
+	raw, ok := m[proofKey]
+	if !ok {
+		return nil, ErrNoProof
+	}
+	doc := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != proofKey {
+			doc[k] = v
+		}
+	}
+	var controllers []*url.URL
+	for _, p := range toSlice(raw) {
+		controller, err := verifyProof(c, doc, p, r)
+		if err != nil {
+			return nil, err
+		}
+		controllers = append(controllers, controller)
+	}
+	return controllers, nil
+}
+
+// verifyProof verifies a proof of the document without its proofs, and
+// returns the controller of its key.
+func verifyProof(c context.Context, doc map[string]interface{}, raw interface{}, r KeyResolver) (*url.URL, error) {
+	proof, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, &VerificationError{fmt.Errorf("proof is not an object: %T", raw)}
+	}
+	for k, expected := range map[string]string{
+		typeKey:         DataIntegrityProof,
+		cryptosuiteKey:  Cryptosuite,
+		proofPurposeKey: AssertionMethod,
+	} {
+		if s, _ := proof[k].(string); s != expected {
+			return nil, &VerificationError{fmt.Errorf("proof %s is not %s: %v", k, expected, proof[k])}
+		}
+	}
+	value, _ := proof[proofValueKey].(string)
+	sig, err := decodeMultibase(value)
+	if err != nil {
+		return nil, &VerificationError{err}
+	}
+	vm, _ := proof[verificationMethodKey].(string)
+	vmIRI, err := url.Parse(vm)
+	if err != nil || len(vm) == 0 {
+		return nil, &VerificationError{fmt.Errorf("proof has no valid %s: %q", verificationMethodKey, vm)}
+	}
+	options := make(map[string]interface{}, len(proof))
+	for k, v := range proof {
+		if k != proofValueKey {
+			options[k] = v
+		}
+	}
+	data, err := hashData(doc, options)
+	if err != nil {
+		return nil, &VerificationError{err}
+	}
+	key, controller, err := r.ResolveKey(c, vmIRI)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, &VerificationError{fmt.Errorf("key of %s is %d bytes, not %d", vm, len(key), ed25519.PublicKeySize)}
+	}
+	if !ed25519.Verify(key, data, sig) {
+		return nil, &VerificationError{fmt.Errorf("proof of %s does not match", vm)}
+	}
+	return controller, nil
+}
+
+// SignType creates a proof of the serialized value as Sign does, and adds it
+// to its proof property.
+func SignType(c context.Context, t Provable, key ed25519.PrivateKey, verificationMethod *url.URL, created time.Time) error {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	signed, err := Sign(c, m, key, verificationMethod, created)
+	if err != nil {
+		return err
+	}
+	proofs := toSlice(signed[proofKey])
+	options := proofs[len(proofs)-1].(map[string]interface{})
+	proof := streams.NewW3IDDataIntegrityV1DataIntegrityProof()
+	cryptosuite := streams.NewW3IDDataIntegrityV1CryptosuiteProperty()
+	cryptosuite.Set(Cryptosuite)
+	proof.SetW3IDDataIntegrityV1Cryptosuite(cryptosuite)
+	purpose := streams.NewW3IDDataIntegrityV1ProofPurposeProperty()
+	purpose.Set(AssertionMethod)
+	proof.SetW3IDDataIntegrityV1ProofPurpose(purpose)
+	vm := streams.NewW3IDDataIntegrityV1VerificationMethodProperty()
+	vm.Set(verificationMethod)
+	proof.SetW3IDDataIntegrityV1VerificationMethod(vm)
+	createdProp := streams.NewW3IDDataIntegrityV1CreatedProperty()
+	createdProp.Set(created.UTC().Truncate(time.Second))
+	proof.SetW3IDDataIntegrityV1Created(createdProp)
+	value := streams.NewW3IDDataIntegrityV1ProofValueProperty()
+	value.Set(options[proofValueKey].(string))
+	proof.SetW3IDDataIntegrityV1ProofValue(value)
+	prop := t.GetW3IDDataIntegrityV1Proof()
+	if prop == nil {
+		prop = streams.NewW3IDDataIntegrityV1ProofProperty()
+		t.SetW3IDDataIntegrityV1Proof(prop)
+	}
+	prop.AppendW3IDDataIntegrityV1DataIntegrityProof(proof)
+	return nil
+}
+
+// VerifyType verifies the proofs of the serialized value as Verify does.
+//
+// Values deserialized from a document may serialize differently, such as with
+// another @context, so Verify should be used on received documents instead.
+func VerifyType(c context.Context, t Provable, r KeyResolver) ([]*url.URL, error) {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return nil, err
+	}
+	return Verify(c, m, r)
+}
+
+// hashData returns the data to sign: the SHA-256 hashes of the canonical
+// options then document. The options have the @context of the document.
+func hashData(doc, options map[string]interface{}) ([]byte, error) {
+	o := make(map[string]interface{}, len(options)+1)
+	for k, v := range options {
+		o[k] = v
+	}
+	if raw, ok := doc[contextKey]; ok {
+		o[contextKey] = raw
+	}
+	canonicalOptions, err := streams.Canonicalize(o)
+	if err != nil {
+		return nil, err
+	}
+	canonicalDoc, err := streams.Canonicalize(doc)
+	if err != nil {
+		return nil, err
+	}
+	optionsHash := sha256.Sum256(canonicalOptions)
+	docHash := sha256.Sum256(canonicalDoc)
+	return append(optionsHash[:], docHash[:]...), nil
+}
+
+// decodeMultibase decodes a base58btc multibase string.
+func decodeMultibase(s string) ([]byte, error) {
+	if len(s) == 0 || s[0] != multibaseBase58 {
+		return nil, fmt.Errorf("multibase value is not base58btc: %q", s)
+	}
+	return base58Decode(s[1:])
+}
+
+// references returns true if the @context value references the context.
+func references(raw interface{}, context string) bool {
+	for _, v := range toSlice(raw) {
+		if s, ok := v.(string); ok && s == context {
+			return true
+		}
+	}
+	return false
+}
+
+// toSlice returns the value as the elements of an array, which is empty for
+// nil.
+func toSlice(v interface{}) []interface{} {
+	if v == nil {
+		return nil
+	} else if arr, ok := v.([]interface{}); ok {
+		return append([]interface{}(nil), arr...)
+	}
+	return []interface{}{v}
+}
@@ -0,0 +1,273 @@
+package integrity
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const testDocument = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/sally/note/1/activity",
+  "type": "Create",
+  "actor": "https://example.com/sally",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "object": {
+    "id": "https://example.com/sally/note/1",
+    "type": "Note",
+    "attributedTo": "https://example.com/sally",
+    "content": "hello"
+  }
+}`
+
+var (
+	testActor              = mustParse("https://example.com/sally")
+	testVerificationMethod = mustParse("https://example.com/sally#ed25519-key")
+	testCreated            = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func mustKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pub, priv
+}
+
+func mustUnmarshal(t *testing.T, s string) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// roundTrip encodes and decodes the document, as when it is delivered.
+func roundTrip(t *testing.T, m map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mustUnmarshal(t, string(b))
+}
+
+// testResolver resolves verification methods with a map of keys, all of
+// which are controlled by testActor.
+type testResolver map[string]ed25519.PublicKey
+
+func (r testResolver) ResolveKey(c context.Context, verificationMethod *url.URL) (ed25519.PublicKey, *url.URL, error) {
+	key, ok := r[verificationMethod.String()]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown verification method %s", verificationMethod)
+	}
+	return key, testActor, nil
+}
+
+func TestMultikey(t *testing.T) {
+	t.Run("Specification example", func(t *testing.T) {
+		const s = "z6MkrJVnaZkeFzdQyMZu1cgjg7k1pZZ6pvBQ7XJPt4swbTQ2"
+		key, err := DecodeMultikey(s)
+		if err != nil {
+			t.Fatalf("DecodeMultikey returned error: %v", err)
+		}
+		if len(key) != ed25519.PublicKeySize {
+			t.Fatalf("expected a key of %d bytes, got %d", ed25519.PublicKeySize, len(key))
+		}
+		if actual := EncodeMultikey(key); actual != s {
+			t.Errorf("expected %s, got %s", s, actual)
+		}
+	})
+	t.Run("Round trip", func(t *testing.T) {
+		pub, _ := mustKey(t)
+		key, err := DecodeMultikey(EncodeMultikey(pub))
+		if err != nil {
+			t.Fatalf("DecodeMultikey returned error: %v", err)
+		}
+		if !bytes.Equal(key, pub) {
+			t.Errorf("expected %x, got %x", pub, key)
+		}
+	})
+	t.Run("Rejects other encodings", func(t *testing.T) {
+		for _, s := range []string{"", "u7QE", "z0OIl", "z6Mk"} {
+			if _, err := DecodeMultikey(s); err == nil {
+				t.Errorf("expected an error for %q", s)
+			}
+		}
+	})
+}
+
+func TestSignAndVerify(t *testing.T) {
+	ctx := context.Background()
+	pub, priv := mustKey(t)
+	r := testResolver{testVerificationMethod.String(): pub}
+	signed, err := Sign(ctx, mustUnmarshal(t, testDocument), priv, testVerificationMethod, testCreated)
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	signed = roundTrip(t, signed)
+	t.Run("Verifies", func(t *testing.T) {
+		controllers, err := Verify(ctx, signed, r)
+		if err != nil {
+			t.Fatalf("Verify returned error: %v", err)
+		}
+		if len(controllers) != 1 || controllers[0].String() != testActor.String() {
+			t.Errorf("expected controllers [%s], got %v", testActor, controllers)
+		}
+	})
+	t.Run("Proof", func(t *testing.T) {
+		proof := signed["proof"].(map[string]interface{})
+		for k, expected := range map[string]string{
+			"type":               DataIntegrityProof,
+			"cryptosuite":        Cryptosuite,
+			"proofPurpose":       AssertionMethod,
+			"verificationMethod": testVerificationMethod.String(),
+			"created":            "2020-01-02T03:04:05Z",
+		} {
+			if proof[k] != expected {
+				t.Errorf("expected %s %q, got %v", k, expected, proof[k])
+			}
+		}
+		if _, ok := proof["@context"]; ok {
+			t.Errorf("expected the proof to have no @context")
+		}
+		if !references(signed["@context"], "https://w3id.org/security/data-integrity/v1") {
+			t.Errorf("expected the data integrity context, got %v", signed["@context"])
+		}
+	})
+	t.Run("Verifies reordered document", func(t *testing.T) {
+		var b bytes.Buffer
+		enc := json.NewEncoder(&b)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(signed); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Verify(ctx, mustUnmarshal(t, b.String()), r); err != nil {
+			t.Errorf("Verify returned error: %v", err)
+		}
+	})
+	t.Run("Multiple proofs", func(t *testing.T) {
+		otherPub, otherPriv := mustKey(t)
+		other := mustParse("https://example.com/sally#other-key")
+		twice, err := Sign(ctx, signed, otherPriv, other, testCreated)
+		if err != nil {
+			t.Fatalf("Sign returned error: %v", err)
+		}
+		twice = roundTrip(t, twice)
+		if n := len(twice["proof"].([]interface{})); n != 2 {
+			t.Fatalf("expected 2 proofs, got %d", n)
+		}
+		controllers, err := Verify(ctx, twice, testResolver{testVerificationMethod.String(): pub, other.String(): otherPub})
+		if err != nil {
+			t.Fatalf("Verify returned error: %v", err)
+		}
+		if len(controllers) != 2 {
+			t.Errorf("expected 2 controllers, got %v", controllers)
+		}
+	})
+	t.Run("Rejects modified document", func(t *testing.T) {
+		m := roundTrip(t, signed)
+		m["object"].(map[string]interface{})["content"] = "goodbye"
+		if _, err := Verify(ctx, m, r); !IsVerificationError(err) {
+			t.Errorf("expected a VerificationError, got %v", err)
+		}
+	})
+	t.Run("Rejects modified proof", func(t *testing.T) {
+		m := roundTrip(t, signed)
+		m["proof"].(map[string]interface{})["created"] = "2021-01-02T03:04:05Z"
+		if _, err := Verify(ctx, m, r); !IsVerificationError(err) {
+			t.Errorf("expected a VerificationError, got %v", err)
+		}
+	})
+	t.Run("Rejects other cryptosuite", func(t *testing.T) {
+		m := roundTrip(t, signed)
+		m["proof"].(map[string]interface{})["cryptosuite"] = "eddsa-rdfc-2022"
+		if _, err := Verify(ctx, m, r); !IsVerificationError(err) {
+			t.Errorf("expected a VerificationError, got %v", err)
+		}
+	})
+	t.Run("Rejects other key", func(t *testing.T) {
+		otherPub, _ := mustKey(t)
+		if _, err := Verify(ctx, signed, testResolver{testVerificationMethod.String(): otherPub}); !IsVerificationError(err) {
+			t.Errorf("expected a VerificationError, got %v", err)
+		}
+	})
+	t.Run("Rejects key of wrong size", func(t *testing.T) {
+		short := testResolver{testVerificationMethod.String(): pub[:ed25519.PublicKeySize-1]}
+		if _, err := Verify(ctx, signed, short); !IsVerificationError(err) {
+			t.Errorf("expected a VerificationError, got %v", err)
+		}
+	})
+	t.Run("Returns resolver errors", func(t *testing.T) {
+		_, err := Verify(ctx, signed, testResolver{})
+		if err == nil || IsVerificationError(err) {
+			t.Errorf("expected the error of the resolver, got %v", err)
+		}
+	})
+	t.Run("Rejects document without proof", func(t *testing.T) {
+		if _, err := Verify(ctx, mustUnmarshal(t, testDocument), r); err != ErrNoProof {
+			t.Errorf("expected ErrNoProof, got %v", err)
+		}
+	})
+}
+
+func TestSignType(t *testing.T) {
+	ctx := context.Background()
+	pub, priv := mustKey(t)
+	r := testResolver{testVerificationMethod.String(): pub}
+	v, err := streams.ToType(ctx, mustUnmarshal(t, testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+	create := v.(Provable)
+	if err := SignType(ctx, create, priv, testVerificationMethod, testCreated); err != nil {
+		t.Fatalf("SignType returned error: %v", err)
+	}
+	if _, err := VerifyType(ctx, create, r); err != nil {
+		t.Errorf("VerifyType returned error: %v", err)
+	}
+	// The proof survives delivery and deserialization.
+	m, err := streams.Serialize(create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m = roundTrip(t, m)
+	if _, err := Verify(ctx, m, r); err != nil {
+		t.Errorf("Verify returned error: %v", err)
+	}
+	v, err = streams.ToType(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := v.(Provable).GetW3IDDataIntegrityV1Proof()
+	if proof == nil || proof.Len() != 1 || !proof.At(0).IsW3IDDataIntegrityV1DataIntegrityProof() {
+		t.Fatalf("expected a DataIntegrityProof, got %v", proof)
+	}
+	if vm := proof.At(0).Get().GetW3IDDataIntegrityV1VerificationMethod().Get(); vm.String() != testVerificationMethod.String() {
+		t.Errorf("expected verification method %s, got %s", testVerificationMethod, vm)
+	}
+}
+
+func TestIsVerificationError(t *testing.T) {
+	if !IsVerificationError(&VerificationError{errors.New("test")}) {
+		t.Errorf("expected true for a VerificationError")
+	}
+	if IsVerificationError(errors.New("test")) {
+		t.Errorf("expected false for another error")
+	}
+}
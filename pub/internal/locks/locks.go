@@ -0,0 +1,86 @@
+// Package locks implements the in-process locks keyed by IRI shared by the
+// Database implementations of the pub package.
+package locks
+
+import (
+	"context"
+	"sync"
+)
+
+// Set is a set of locks keyed by IRI. A lock only exists while it is held or
+// waited on, so keys that are never locked again do not leak memory.
+//
+// Unlike a sync.Mutex, unlocking a key that is not locked does nothing.
+type Set struct {
+	mu sync.Mutex
+	m  map[string]*entry
+}
+
+// entry is a lock and the number of goroutines holding or waiting on it. The
+// lock is held while its channel is full.
+type entry struct {
+	held chan struct{}
+	n    int
+}
+
+// New creates an empty set of locks.
+func New() *Set {
+	return &Set{m: make(map[string]*entry)}
+}
+
+// Lock blocks until the lock for the key is acquired, or until the context is
+// done, in which case it returns the error of the context.
+func (s *Set) Lock(c context.Context, key string) error {
+	s.mu.Lock()
+	e, ok := s.m[key]
+	if !ok {
+		e = &entry{held: make(chan struct{}, 1)}
+		s.m[key] = e
+	}
+	e.n++
+	s.mu.Unlock()
+	select {
+	case e.held <- struct{}{}:
+		return nil
+	case <-c.Done():
+		s.mu.Lock()
+		s.release(key, e)
+		s.mu.Unlock()
+		return c.Err()
+	}
+}
+
+// Unlock releases the lock for the key. It returns false if the key was not
+// locked.
+func (s *Set) Unlock(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.m[key]
+	if !ok {
+		return false
+	}
+	select {
+	case <-e.held:
+	default:
+		// Only waited on.
+		return false
+	}
+	s.release(key, e)
+	return true
+}
+
+// Len returns the number of keys that are locked or waited on.
+func (s *Set) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.m)
+}
+
+// release stops counting a goroutine holding or waiting on the lock of the
+// key, and removes the lock once there are none. The caller must hold mu.
+func (s *Set) release(key string, e *entry) {
+	e.n--
+	if e.n == 0 {
+		delete(s.m, key)
+	}
+}
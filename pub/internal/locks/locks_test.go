@@ -0,0 +1,82 @@
+package locks
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSet(t *testing.T) {
+	ctx := context.Background()
+	t.Run("IsExclusivePerKey", func(t *testing.T) {
+		s := New()
+		counter := 0
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.Lock(ctx, "a")
+				// A different key never blocks.
+				s.Lock(ctx, "b")
+				s.Unlock("b")
+				counter++
+				s.Unlock("a")
+			}()
+		}
+		wg.Wait()
+		if counter != 50 {
+			t.Errorf("expected 50, got %d", counter)
+		}
+		if n := s.Len(); n != 0 {
+			t.Errorf("expected no locks left, got %d", n)
+		}
+	})
+	t.Run("UnlockIfNotLocked", func(t *testing.T) {
+		s := New()
+		if s.Unlock("a") {
+			t.Errorf("expected false for a key never locked")
+		}
+		s.Lock(ctx, "a")
+		if !s.Unlock("a") {
+			t.Errorf("expected true for a locked key")
+		}
+		if s.Unlock("a") {
+			t.Errorf("expected false for a key unlocked twice")
+		}
+	})
+	t.Run("WaiterTakesReleasedLock", func(t *testing.T) {
+		s := New()
+		s.Lock(ctx, "a")
+		c, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		done := make(chan error)
+		go func() {
+			done <- s.Lock(c, "a")
+		}()
+		s.Unlock("a")
+		if err := <-done; err != nil {
+			t.Fatalf("expected the waiter to take the lock, got %v", err)
+		}
+		if !s.Unlock("a") {
+			t.Errorf("expected the lock of the waiter to be held")
+		}
+		if s.Unlock("a") {
+			t.Errorf("expected false once released")
+		}
+	})
+	t.Run("LockStopsWhenContextIsDone", func(t *testing.T) {
+		s := New()
+		s.Lock(ctx, "a")
+		c, cancel := context.WithCancel(ctx)
+		cancel()
+		if err := s.Lock(c, "a"); err != context.Canceled {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		s.Unlock("a")
+		if n := s.Len(); n != 0 {
+			t.Errorf("expected no locks left, got %d", n)
+		}
+	})
+}
@@ -0,0 +1,114 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// DefaultLanguager provides the language of the text that clients post to an
+// outbox without one.
+//
+// It is optionally implemented by the SocialProtocol. When it is, the plain
+// 'content', 'name' and 'summary' of a value posted to an outbox, and of the
+// objects embedded in its 'object', are copied into 'contentMap', 'nameMap'
+// and 'summaryMap' under the default language before the value is handled, so
+// that peers and clients can select the language they prefer. The plain values
+// are kept for peers that do not read the maps.
+type DefaultLanguager interface {
+	// DefaultLanguage returns the BCP 47 language tag of the text posted
+	// to the outbox, such as the language preferred by its actor.
+	//
+	// Returning an empty string leaves the value unchanged.
+	DefaultLanguage(c context.Context, outboxIRI *url.URL) string
+}
+
+// defaultLanguager returns the DefaultLanguager of the delegate, or of the
+// SocialProtocol of a sideEffectActor, if any.
+func (b *baseActor) defaultLanguager() (DefaultLanguager, bool) {
+	if d, ok := b.delegate.(DefaultLanguager); ok {
+		return d, true
+	} else if a, ok := b.delegate.(*sideEffectActor); ok {
+		d, ok := a.c2s.(DefaultLanguager)
+		return d, ok
+	}
+	return nil, false
+}
+
+// normalizeLanguage copies the plain 'content', 'name' and 'summary' of the
+// value, and of the objects embedded in its 'object', into language maps under
+// the language, keeping the plain values alongside them.
+func normalizeLanguage(obj vocab.Type, lang string) {
+	walkObjects(obj, func(t vocab.Type) {
+		if c, ok := t.(contenter); ok && c.GetActivityStreamsContent() != nil {
+			p := c.GetActivityStreamsContent()
+			if m, ok := withLanguage(streams.ContentMap(p), lang); ok {
+				streams.SetContentMap(p, m)
+			}
+		}
+		if n, ok := t.(namer); ok && n.GetActivityStreamsName() != nil {
+			p := n.GetActivityStreamsName()
+			if m, ok := withLanguage(streams.NameMap(p), lang); ok {
+				streams.SetNameMap(p, m)
+			}
+		}
+		if s, ok := t.(summaryer); ok && s.GetActivityStreamsSummary() != nil {
+			p := s.GetActivityStreamsSummary()
+			if m, ok := withLanguage(streams.SummaryMap(p), lang); ok {
+				streams.SetSummaryMap(p, m)
+			}
+		}
+	})
+}
+
+// withLanguage returns the language map with the value without a language
+// also under the language, unless the language already has a value. It returns
+// false if the map is left unchanged.
+func withLanguage(m map[string]string, lang string) (map[string]string, bool) {
+	s, ok := m[streams.UndeterminedLanguage]
+	if !ok {
+		return nil, false
+	} else if _, ok := m[lang]; ok {
+		return nil, false
+	}
+	m[lang] = s
+	return m, true
+}
+
+// languageMapProperties are the properties of the language maps set by
+// normalizeLanguage.
+var languageMapProperties = []string{"contentMap", "nameMap", "summaryMap"}
+
+// copyLanguageMaps sets the language maps of the serialized value, and of the
+// objects embedded in its 'object', to the ones of its normalized
+// serialization. The rest of the value is left as it was posted, such as the
+// literal nulls of an Update.
+func copyLanguageMaps(dst, src map[string]interface{}) {
+	for _, p := range languageMapProperties {
+		if v, ok := src[p]; ok {
+			dst[p] = v
+		}
+	}
+	dstObjs, srcObjs := asSlice(dst["object"]), asSlice(src["object"])
+	if len(dstObjs) != len(srcObjs) {
+		return
+	}
+	for i := range dstObjs {
+		d, dok := dstObjs[i].(map[string]interface{})
+		s, sok := srcObjs[i].(map[string]interface{})
+		if dok && sok {
+			copyLanguageMaps(d, s)
+		}
+	}
+}
+
+// asSlice returns the serialized property value as a slice of its values.
+func asSlice(v interface{}) []interface{} {
+	if s, ok := v.([]interface{}); ok {
+		return s
+	} else if v == nil {
+		return nil
+	}
+	return []interface{}{v}
+}
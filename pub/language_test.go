@@ -0,0 +1,163 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/go-test/deep"
+	"github.com/golang/mock/gomock"
+	"net/http/httptest"
+	"testing"
+)
+
+// languageDelegate is a DelegateActor that also is a DefaultLanguager.
+type languageDelegate struct {
+	*MockDelegateActor
+	*MockDefaultLanguager
+}
+
+// newPlainNoteCreate returns a Create of a Note whose content and summary have
+// no language.
+func newPlainNoteCreate() vocab.ActivityStreamsCreate {
+	note := streams.NewActivityStreamsNote()
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("hello")
+	note.SetActivityStreamsContent(content)
+	summary := streams.NewActivityStreamsSummaryProperty()
+	summary.AppendRDFLangString(map[string]string{"fr": "salut"})
+	note.SetActivityStreamsSummary(summary)
+	c := streams.NewActivityStreamsCreate()
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	c.SetActivityStreamsObject(op)
+	name := streams.NewActivityStreamsNameProperty()
+	name.AppendXMLSchemaString("greeting")
+	c.SetActivityStreamsName(name)
+	return c
+}
+
+func TestNormalizeLanguage(t *testing.T) {
+	c := newPlainNoteCreate()
+	normalizeLanguage(c, "en")
+	note := c.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	if diff := deep.Equal(streams.ContentMap(note.GetActivityStreamsContent()), map[string]string{"en": "hello", "und": "hello"}); diff != nil {
+		t.Errorf("content: %v", diff)
+	}
+	if diff := deep.Equal(streams.SummaryMap(note.GetActivityStreamsSummary()), map[string]string{"fr": "salut"}); diff != nil {
+		t.Errorf("summary: %v", diff)
+	}
+	if diff := deep.Equal(streams.NameMap(c.GetActivityStreamsName()), map[string]string{"en": "greeting", "und": "greeting"}); diff != nil {
+		t.Errorf("name: %v", diff)
+	}
+	m, err := streams.Serialize(note)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(m["contentMap"], map[string]string{"en": "hello"}); diff != nil {
+		t.Errorf("contentMap: %v", diff)
+	}
+	if m["content"] != "hello" {
+		t.Errorf("expected content to survive, got %v", m)
+	}
+}
+
+func TestPostOutboxNormalizesLanguage(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (delegate *MockDelegateActor, dl *MockDefaultLanguager, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		dl = NewMockDefaultLanguager(ctl)
+		a = NewCustomActor(
+			languageDelegate{delegate, dl},
+			/*enableSocialProtocol=*/ true,
+			/*enableFederatedProtocol=*/ true,
+			NewMockClock(ctl))
+		return
+	}
+	expectErr := errors.New("stop")
+	t.Run("MovesTextIntoDefaultLanguage", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, dl, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(newPlainNoteCreate()))
+		var posted vocab.Type
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		dl.EXPECT().DefaultLanguage(ctx, mustParse(testMyOutboxIRI)).Return("de")
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).DoAndReturn(
+			func(c context.Context, r interface{}, data vocab.Type) (context.Context, error) {
+				posted = data
+				return c, expectErr
+			})
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, expectErr)
+		assertEqual(t, handled, true)
+		note := posted.(vocab.ActivityStreamsCreate).GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+		if diff := deep.Equal(streams.ContentMap(note.GetActivityStreamsContent()), map[string]string{"de": "hello", "und": "hello"}); diff != nil {
+			t.Errorf("%v", diff)
+		}
+	})
+	t.Run("CopiesLanguageMapsIntoRawJSON", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, dl, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(newPlainNoteCreate()))
+		var raw map[string]interface{}
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		dl.EXPECT().DefaultLanguage(ctx, mustParse(testMyOutboxIRI)).Return("de")
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AddNewIds(ctx, gomock.Any()).Return(nil)
+		delegate.EXPECT().PostOutbox(ctx, gomock.Any(), mustParse(testMyOutboxIRI), gomock.Any()).DoAndReturn(
+			func(c context.Context, a Activity, outbox interface{}, m map[string]interface{}) (bool, error) {
+				raw = m
+				return false, expectErr
+			})
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, expectErr)
+		assertEqual(t, handled, true)
+		if diff := deep.Equal(raw["nameMap"], map[string]string{"de": "greeting"}); diff != nil {
+			t.Errorf("nameMap: %v", diff)
+		}
+		assertEqual(t, raw["name"], "greeting")
+		note, ok := raw["object"].(map[string]interface{})
+		if !ok {
+			t.Fatalf("expected an embedded object, got %v", raw["object"])
+		}
+		if diff := deep.Equal(note["contentMap"], map[string]string{"de": "hello"}); diff != nil {
+			t.Errorf("contentMap: %v", diff)
+		}
+		assertEqual(t, note["content"], "hello")
+	})
+	t.Run("LeavesTextWithoutDefaultLanguage", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, dl, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(newPlainNoteCreate()))
+		var posted vocab.Type
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		dl.EXPECT().DefaultLanguage(ctx, mustParse(testMyOutboxIRI)).Return("")
+		delegate.EXPECT().PostOutboxRequestBodyHook(ctx, req, gomock.Any()).DoAndReturn(
+			func(c context.Context, r interface{}, data vocab.Type) (context.Context, error) {
+				posted = data
+				return c, expectErr
+			})
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, expectErr)
+		assertEqual(t, handled, true)
+		note := posted.(vocab.ActivityStreamsCreate).GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+		if diff := deep.Equal(streams.ContentMap(note.GetActivityStreamsContent()), map[string]string{"und": "hello"}); diff != nil {
+			t.Errorf("%v", diff)
+		}
+	})
+}
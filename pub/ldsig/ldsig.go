@@ -0,0 +1,264 @@
+// Package ldsig signs and verifies ActivityStreams documents with Linked Data
+// Signatures of the RsaSignature2017 suite, as used by Mastodon and others.
+//
+// Unlike HTTP Signatures, which only authenticate the server sending a
+// request, a Linked Data Signature travels with the document. An activity
+// relayed or forwarded by another server, such as with the InboxForwarding
+// behavior of the pub package, can be verified against the key of its
+// original author, which may have been fetched and cached earlier.
+//
+// The document and the signature options are each canonicalized with the
+// URDNA2015 algorithm of jsonld.Canonize and hashed with SHA-256. The
+// concatenation of the hexadecimal hashes of the options then of the document
+// is signed with RSASSA-PKCS1-v1_5 and SHA-256.
+package ldsig
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/jsonld"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"time"
+)
+
+const (
+	// RsaSignature2017 is the type of the signatures of this package.
+	RsaSignature2017 = "RsaSignature2017"
+
+	contextKey        = "@context"
+	signatureKey      = "signature"
+	typeKey           = "type"
+	idKey             = "id"
+	creatorKey        = "creator"
+	createdKey        = "created"
+	signatureValueKey = "signatureValue"
+)
+
+var (
+	// ErrNotSigned indicates that a document has no signature.
+	ErrNotSigned = errors.New("document has no linked data signature")
+	// ErrNoContext indicates that a document to sign has no @context, so
+	// it has no linked data meaning to sign.
+	ErrNoContext = errors.New("document to sign has no @context")
+)
+
+// Signable is an ActivityStreams value with a signature, such as any type
+// extending Object.
+type Signable interface {
+	vocab.Type
+	// GetW3IDSecurityV1Signature returns the "signature" property if it
+	// exists, and nil otherwise.
+	GetW3IDSecurityV1Signature() vocab.W3IDSecurityV1SignatureProperty
+	// SetW3IDSecurityV1Signature sets the "signature" property.
+	SetW3IDSecurityV1Signature(i vocab.W3IDSecurityV1SignatureProperty)
+}
+
+// Sign returns a copy of the document with an RsaSignature2017 signature made
+// with the private key, replacing any existing signature. The creator is the
+// IRI of the public key, such as the id of the publicKey of the actor, and
+// created is the time of signing.
+//
+// The security context is added to the @context of the copy if it is missing,
+// so that the signature is understood.
+func Sign(c context.Context, m map[string]interface{}, creator *url.URL, created time.Time, key *rsa.PrivateKey) (map[string]interface{}, error) {
+	raw, ok := m[contextKey]
+	if !ok {
+		return nil, ErrNoContext
+	}
+	doc := jsonld.CopyObject(m)
+	delete(doc, signatureKey)
+	if !referencesSecurityContext(raw) {
+		doc[contextKey] = append(toSlice(raw), jsonld.SecurityV1Context)
+	}
+	options := map[string]interface{}{
+		creatorKey: creator.String(),
+		createdKey: created.UTC().Truncate(time.Second).Format(time.RFC3339),
+	}
+	digest, err := digest(c, doc, options)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+	if err != nil {
+		return nil, err
+	}
+	options[typeKey] = RsaSignature2017
+	options[signatureValueKey] = base64.StdEncoding.EncodeToString(sig)
+	doc[signatureKey] = options
+	return doc, nil
+}
+
+// Verify returns nil if the RsaSignature2017 signature of the document was
+// made by the private key of the public key. The document must be as
+// received, as any change to it, including to its @context, may change its
+// meaning and invalidate the signature.
+//
+// Use Creator to determine which public key to verify with.
+func Verify(c context.Context, m map[string]interface{}, key *rsa.PublicKey) error {
+	sig, err := signature(m)
+	if err != nil {
+		return err
+	}
+	value, ok := sig[signatureValueKey].(string)
+	if !ok {
+		return fmt.Errorf("linked data signature has no %s", signatureValueKey)
+	}
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return err
+	}
+	doc := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != signatureKey {
+			doc[k] = v
+		}
+	}
+	options := make(map[string]interface{}, len(sig))
+	for k, v := range sig {
+		if k != typeKey && k != idKey && k != signatureValueKey && k != contextKey {
+			options[k] = v
+		}
+	}
+	digest, err := digest(c, doc, options)
+	if err != nil {
+		return err
+	}
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, b); err != nil {
+		return fmt.Errorf("linked data signature does not match: %v", err)
+	}
+	return nil
+}
+
+// Creator returns the IRI of the public key verifying the signature of the
+// document.
+func Creator(m map[string]interface{}) (*url.URL, error) {
+	sig, err := signature(m)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := sig[creatorKey].(string)
+	if !ok {
+		return nil, fmt.Errorf("linked data signature has no %s", creatorKey)
+	}
+	return url.Parse(s)
+}
+
+// SignType signs the serialized value as Sign does, and sets its signature
+// property.
+func SignType(c context.Context, t Signable, creator *url.URL, created time.Time, key *rsa.PrivateKey) error {
+	t.SetW3IDSecurityV1Signature(nil)
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	signed, err := Sign(c, m, creator, created, key)
+	if err != nil {
+		return err
+	}
+	options := signed[signatureKey].(map[string]interface{})
+	sig := streams.NewW3IDSecurityV1RsaSignature2017()
+	creatorProp := streams.NewW3IDSecurityV1CreatorProperty()
+	creatorProp.Set(creator)
+	sig.SetW3IDSecurityV1Creator(creatorProp)
+	createdProp := streams.NewW3IDSecurityV1CreatedProperty()
+	createdProp.Set(created.UTC().Truncate(time.Second))
+	sig.SetW3IDSecurityV1Created(createdProp)
+	valueProp := streams.NewW3IDSecurityV1SignatureValueProperty()
+	valueProp.Set(options[signatureValueKey].(string))
+	sig.SetW3IDSecurityV1SignatureValue(valueProp)
+	sigProp := streams.NewW3IDSecurityV1SignatureProperty()
+	sigProp.Set(sig)
+	t.SetW3IDSecurityV1Signature(sigProp)
+	return nil
+}
+
+// VerifyType verifies the signature of the serialized value as Verify does.
+//
+// Values deserialized from a document may serialize differently, such as
+// without the terms defined by its @context for properties unknown to the
+// streams package, so Verify should be used on received documents instead.
+func VerifyType(c context.Context, t Signable, key *rsa.PublicKey) error {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	return Verify(c, m, key)
+}
+
+// signature returns the RsaSignature2017 signature of the document.
+func signature(m map[string]interface{}) (map[string]interface{}, error) {
+	raw, ok := m[signatureKey]
+	if !ok {
+		return nil, ErrNotSigned
+	}
+	sig, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("linked data signature is not an object: %T", raw)
+	}
+	if typ, _ := sig[typeKey].(string); typ != RsaSignature2017 {
+		return nil, fmt.Errorf("linked data signature type is not %s: %v", RsaSignature2017, sig[typeKey])
+	}
+	return sig, nil
+}
+
+// digest returns the SHA-256 digest of the data to sign: the hexadecimal
+// SHA-256 hashes of the canonical options and document.
+//
+// The options are interpreted with the security context, whose terms of the
+// options are the same as those of the identity context used by other
+// implementations.
+func digest(c context.Context, doc, options map[string]interface{}) ([]byte, error) {
+	o := make(map[string]interface{}, len(options)+1)
+	for k, v := range options {
+		o[k] = v
+	}
+	o[contextKey] = jsonld.SecurityV1Context
+	optionsHash, err := canonicalHash(c, o)
+	if err != nil {
+		return nil, err
+	}
+	docHash, err := canonicalHash(c, doc)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(optionsHash + docHash))
+	return sum[:], nil
+}
+
+// canonicalHash returns the hexadecimal SHA-256 hash of the canonical N-Quads
+// of the document.
+func canonicalHash(c context.Context, m map[string]interface{}) (string, error) {
+	nquads, err := jsonld.Canonize(c, m)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(nquads))
+	return fmt.Sprintf("%x", sum), nil
+}
+
+// referencesSecurityContext returns true if the @context value references
+// the security context.
+func referencesSecurityContext(raw interface{}) bool {
+	for _, v := range toSlice(raw) {
+		if s, ok := v.(string); ok && (s == jsonld.SecurityV1Context || s == "http://w3id.org/security/v1") {
+			return true
+		}
+	}
+	return false
+}
+
+// toSlice returns the value as the elements of an array.
+func toSlice(v interface{}) []interface{} {
+	if arr, ok := v.([]interface{}); ok {
+		return append([]interface{}(nil), arr...)
+	}
+	return []interface{}{v}
+}
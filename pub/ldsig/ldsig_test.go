@@ -0,0 +1,163 @@
+package ldsig
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"github.com/go-fed/activity/streams"
+	"net/url"
+	"testing"
+	"time"
+)
+
+const testDocument = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/sally/note/1/activity",
+  "type": "Create",
+  "actor": "https://example.com/sally",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "object": {
+    "id": "https://example.com/sally/note/1",
+    "type": "Note",
+    "attributedTo": "https://example.com/sally",
+    "content": "hello"
+  }
+}`
+
+var (
+	testCreator = mustParse("https://example.com/sally#main-key")
+	testCreated = time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func mustKey(t *testing.T) *rsa.PrivateKey {
+	k, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func mustUnmarshal(t *testing.T, s string) map[string]interface{} {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// roundTrip encodes and decodes the document, as when it is delivered.
+func roundTrip(t *testing.T, m map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mustUnmarshal(t, string(b))
+}
+
+func TestSignAndVerify(t *testing.T) {
+	ctx := context.Background()
+	key := mustKey(t)
+	signed, err := Sign(ctx, mustUnmarshal(t, testDocument), testCreator, testCreated, key)
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	signed = roundTrip(t, signed)
+	t.Run("Verifies", func(t *testing.T) {
+		if err := Verify(ctx, signed, &key.PublicKey); err != nil {
+			t.Errorf("Verify returned error: %v", err)
+		}
+	})
+	t.Run("Creator", func(t *testing.T) {
+		creator, err := Creator(signed)
+		if err != nil {
+			t.Fatalf("Creator returned error: %v", err)
+		}
+		if creator.String() != testCreator.String() {
+			t.Errorf("expected %s, got %s", testCreator, creator)
+		}
+	})
+	t.Run("VerifiesEquivalentDocument", func(t *testing.T) {
+		// The same statements, using full IRIs for a property and the
+		// type.
+		m := roundTrip(t, signed)
+		obj := m["object"].(map[string]interface{})
+		obj["https://www.w3.org/ns/activitystreams#content"] = obj["content"]
+		delete(obj, "content")
+		obj["type"] = "https://www.w3.org/ns/activitystreams#Note"
+		if err := Verify(ctx, m, &key.PublicKey); err != nil {
+			t.Errorf("Verify returned error: %v", err)
+		}
+	})
+	t.Run("RejectsModifiedDocument", func(t *testing.T) {
+		m := roundTrip(t, signed)
+		m["object"].(map[string]interface{})["content"] = "goodbye"
+		if err := Verify(ctx, m, &key.PublicKey); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+	t.Run("RejectsModifiedOptions", func(t *testing.T) {
+		m := roundTrip(t, signed)
+		m["signature"].(map[string]interface{})["created"] = "2021-01-02T03:04:05Z"
+		if err := Verify(ctx, m, &key.PublicKey); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+	t.Run("RejectsOtherKey", func(t *testing.T) {
+		if err := Verify(ctx, signed, &mustKey(t).PublicKey); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+	t.Run("RejectsUnsigned", func(t *testing.T) {
+		if err := Verify(ctx, mustUnmarshal(t, testDocument), &key.PublicKey); err != ErrNotSigned {
+			t.Errorf("expected ErrNotSigned, got %v", err)
+		}
+	})
+}
+
+func TestSignType(t *testing.T) {
+	ctx := context.Background()
+	key := mustKey(t)
+	v, err := streams.ToType(ctx, mustUnmarshal(t, testDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+	create := v.(Signable)
+	if err := SignType(ctx, create, testCreator, testCreated, key); err != nil {
+		t.Fatalf("SignType returned error: %v", err)
+	}
+	if err := VerifyType(ctx, create, &key.PublicKey); err != nil {
+		t.Errorf("VerifyType returned error: %v", err)
+	}
+	// The signature survives delivery and deserialization.
+	m, err := streams.Serialize(create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m = roundTrip(t, m)
+	if err := Verify(ctx, m, &key.PublicKey); err != nil {
+		t.Errorf("Verify returned error: %v", err)
+	}
+	v, err = streams.ToType(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := v.(Signable).GetW3IDSecurityV1Signature()
+	if sig == nil || !sig.IsW3IDSecurityV1RsaSignature2017() {
+		t.Fatalf("expected an RsaSignature2017, got %v", sig)
+	}
+	if creator := sig.Get().GetW3IDSecurityV1Creator().Get(); creator.String() != testCreator.String() {
+		t.Errorf("expected creator %s, got %s", testCreator, creator)
+	}
+	if err := VerifyType(ctx, v.(Signable), &key.PublicKey); err != nil {
+		t.Errorf("VerifyType returned error after deserializing: %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package memdb
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// CreatePerson creates a local Person with the preferred username, such as
+// "https://example.com/users/alex", and its empty inbox, outbox, followers,
+// following, liked, and pending Follow requests collections.
+func (d *Database) CreatePerson(c context.Context, username string) (vocab.ActivityStreamsPerson, error) {
+	actorIRI := &url.URL{
+		Scheme: d.scheme,
+		Host:   d.host,
+		Path:   "/users/" + username,
+	}
+	boxIRI := func(name string) *url.URL {
+		u := *actorIRI
+		u.Path += "/" + name
+		return &u
+	}
+	boxes := &actorBoxes{
+		inbox:          boxIRI("inbox"),
+		outbox:         boxIRI("outbox"),
+		followers:      boxIRI("followers"),
+		following:      boxIRI("following"),
+		liked:          boxIRI("liked"),
+		followRequests: boxIRI("requests"),
+	}
+	d.mu.RLock()
+	_, exists := d.actors[actorIRI.String()]
+	d.mu.RUnlock()
+	if exists {
+		return nil, fmt.Errorf("memdb: actor %s already exists", actorIRI)
+	}
+	// Create the actor.
+	person := streams.NewActivityStreamsPerson()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(actorIRI)
+	person.SetJSONLDId(id)
+	name := streams.NewActivityStreamsPreferredUsernameProperty()
+	name.SetXMLSchemaString(username)
+	person.SetActivityStreamsPreferredUsername(name)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(boxes.inbox)
+	person.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(boxes.outbox)
+	person.SetActivityStreamsOutbox(outbox)
+	followers := streams.NewActivityStreamsFollowersProperty()
+	followers.SetIRI(boxes.followers)
+	person.SetActivityStreamsFollowers(followers)
+	following := streams.NewActivityStreamsFollowingProperty()
+	following.SetIRI(boxes.following)
+	person.SetActivityStreamsFollowing(following)
+	liked := streams.NewActivityStreamsLikedProperty()
+	liked.SetIRI(boxes.liked)
+	person.SetActivityStreamsLiked(liked)
+	// Create its collections.
+	values := []vocab.Type{
+		person,
+		newOrderedCollection(boxes.inbox),
+		newOrderedCollection(boxes.outbox),
+		newCollection(boxes.followers),
+		newCollection(boxes.following),
+		newCollection(boxes.liked),
+		newOrderedCollection(boxes.followRequests),
+	}
+	for _, v := range values {
+		if err := d.set(v); err != nil {
+			return nil, err
+		}
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.actors[actorIRI.String()] = boxes
+	d.inboxes[boxes.inbox.String()] = actorIRI.String()
+	d.outboxes[boxes.outbox.String()] = actorIRI.String()
+	return person, nil
+}
+
+// newCollection creates an empty Collection with the id.
+func newCollection(iri *url.URL) vocab.ActivityStreamsCollection {
+	col := streams.NewActivityStreamsCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(iri)
+	col.SetJSONLDId(id)
+	col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(0)
+	col.SetActivityStreamsTotalItems(total)
+	return col
+}
+
+// newOrderedCollection creates an empty OrderedCollection with the id.
+func newOrderedCollection(iri *url.URL) vocab.ActivityStreamsOrderedCollection {
+	oc := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(iri)
+	oc.SetJSONLDId(id)
+	oc.SetActivityStreamsOrderedItems(streams.NewActivityStreamsOrderedItemsProperty())
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(0)
+	oc.SetActivityStreamsTotalItems(total)
+	return oc
+}
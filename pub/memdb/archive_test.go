@@ -0,0 +1,93 @@
+package memdb
+
+import (
+	"bytes"
+	"context"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"testing"
+)
+
+// TestArchive tests moving an actor between two Databases with ExportActor and
+// ImportActor.
+func TestArchive(t *testing.T) {
+	ctx := context.Background()
+	// Setup the exporting server.
+	src := New("a.example.com")
+	alex, err := src.CreatePerson(ctx, "alex")
+	assertEqual(t, err, nil)
+	alexIRI := alex.GetJSONLDId().Get()
+	name := streams.NewActivityStreamsNameProperty()
+	name.AppendXMLSchemaString("Alex")
+	alex.SetActivityStreamsName(name)
+	assertEqual(t, src.Update(ctx, alex), nil)
+	note := streams.NewActivityStreamsNote()
+	noteId, err := src.NewId(ctx, note)
+	assertEqual(t, err, nil)
+	id := streams.NewJSONLDIdProperty()
+	id.Set(noteId)
+	note.SetJSONLDId(id)
+	attr := streams.NewActivityStreamsAttributedToProperty()
+	attr.AppendIRI(alexIRI)
+	note.SetActivityStreamsAttributedTo(attr)
+	create := streams.NewActivityStreamsCreate()
+	createId, err := src.NewId(ctx, create)
+	assertEqual(t, err, nil)
+	id = streams.NewJSONLDIdProperty()
+	id.Set(createId)
+	create.SetJSONLDId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(alexIRI)
+	create.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	create.SetActivityStreamsObject(op)
+	assertEqual(t, src.Create(ctx, note), nil)
+	assertEqual(t, src.Create(ctx, create), nil)
+	assertEqual(t, src.PrependItem(ctx, alex.GetActivityStreamsOutbox().GetIRI(), createId), nil)
+	follower := mustParse("https://other.example.com/users/sam")
+	followers, err := src.Followers(ctx, alexIRI)
+	assertEqual(t, err, nil)
+	followers.GetActivityStreamsItems().AppendIRI(follower)
+	assertEqual(t, src.Update(ctx, followers), nil)
+	// Setup the importing server.
+	dst := New("b.example.com")
+	newAlex, err := dst.CreatePerson(ctx, "alex")
+	assertEqual(t, err, nil)
+	newAlexIRI := newAlex.GetJSONLDId().Get()
+	// Run
+	var b bytes.Buffer
+	assertEqual(t, pub.ExportActor(ctx, src, alexIRI, &b), nil)
+	r := bytes.NewReader(b.Bytes())
+	ids, err := pub.ImportActor(ctx, dst, r, r.Size(), newAlexIRI)
+	// Verify
+	assertEqual(t, err, nil)
+	newCreateId := ids[createId.String()]
+	newNoteId := ids[noteId.String()]
+	assertEqual(t, newCreateId.Host, "b.example.com")
+	assertEqual(t, newNoteId.Host, "b.example.com")
+	items, err := dst.GetItems(ctx, newAlex.GetActivityStreamsOutbox().GetIRI(), 0, 10)
+	assertEqual(t, err, nil)
+	assertEqual(t, len(items), 1)
+	assertEqual(t, items[0].String(), newCreateId.String())
+	got, err := dst.Get(ctx, newCreateId)
+	assertEqual(t, err, nil)
+	gotCreate := got.(vocab.ActivityStreamsCreate)
+	assertEqual(t, gotCreate.GetActivityStreamsActor().At(0).GetIRI().String(), newAlexIRI.String())
+	gotObject := gotCreate.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	assertEqual(t, gotObject.GetJSONLDId().Get().String(), newNoteId.String())
+	got, err = dst.Get(ctx, newNoteId)
+	assertEqual(t, err, nil)
+	gotNote := got.(vocab.ActivityStreamsNote)
+	assertEqual(t, gotNote.GetActivityStreamsAttributedTo().At(0).GetIRI().String(), newAlexIRI.String())
+	gotFollowers, err := dst.Followers(ctx, newAlexIRI)
+	assertEqual(t, err, nil)
+	assertEqual(t, gotFollowers.GetActivityStreamsItems().Len(), 1)
+	assertEqual(t, gotFollowers.GetActivityStreamsItems().At(0).GetIRI().String(), follower.String())
+	got, err = dst.Get(ctx, newAlexIRI)
+	assertEqual(t, err, nil)
+	gotAlex := got.(vocab.ActivityStreamsPerson)
+	assertEqual(t, gotAlex.GetActivityStreamsName().At(0).GetXMLSchemaString(), "Alex")
+	assertEqual(t, gotAlex.GetActivityStreamsInbox().GetIRI().String(), newAlex.GetActivityStreamsInbox().GetIRI().String())
+}
@@ -0,0 +1,176 @@
+package memdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// SetFetched records that the federated entry was fetched at the time.
+func (d *Database) SetFetched(c context.Context, id *url.URL, t time.Time) error {
+	d.setTime(d.fetched, id, t)
+	return nil
+}
+
+// SetAccessed records that the federated entry was read at the time.
+func (d *Database) SetAccessed(c context.Context, id *url.URL, t time.Time) error {
+	d.setTime(d.accessed, id, t)
+	return nil
+}
+
+// StaleEntries returns at most 'limit' federated entries neither fetched nor
+// accessed since the time, least recently used first. Entries never recorded
+// as fetched or accessed are the least recently used.
+func (d *Database) StaleEntries(c context.Context, since time.Time, limit int) (ids []*url.URL, err error) {
+	type entry struct {
+		id   string
+		used time.Time
+	}
+	var stale []entry
+	d.mu.RLock()
+	for id := range d.values {
+		if d.owns(id) {
+			continue
+		}
+		if used := d.lastUsed(id); used.Before(since) {
+			stale = append(stale, entry{id, used})
+		}
+	}
+	d.mu.RUnlock()
+	sort.Slice(stale, func(i, j int) bool {
+		if !stale[i].used.Equal(stale[j].used) {
+			return stale[i].used.Before(stale[j].used)
+		}
+		return stale[i].id < stale[j].id
+	})
+	for i := 0; i < len(stale) && i < limit; i++ {
+		var u *url.URL
+		if u, err = url.Parse(stale[i].id); err != nil {
+			return
+		}
+		ids = append(ids, u)
+	}
+	return
+}
+
+// IsReferenced returns true if an owned entry mentions the federated entry, or
+// if the 'attributedTo' or 'actor' of the entry is in the following collection
+// of a local actor.
+func (d *Database) IsReferenced(c context.Context, id *url.URL) (referenced bool, err error) {
+	iri := id.String()
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	var owner string
+	if b, ok := d.values[iri]; ok {
+		var m map[string]interface{}
+		if err = json.Unmarshal(b, &m); err != nil {
+			return
+		}
+		owner = ownerOf(m)
+	}
+	for key, b := range d.values {
+		if !d.owns(key) {
+			continue
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal(b, &m); err != nil {
+			return
+		}
+		if mentions(m, iri) {
+			return true, nil
+		}
+	}
+	if len(owner) == 0 {
+		return
+	}
+	for _, boxes := range d.actors {
+		b, ok := d.values[boxes.following.String()]
+		if !ok {
+			continue
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal(b, &m); err != nil {
+			return
+		}
+		if refersTo(m["items"], owner) || refersTo(m["orderedItems"], owner) {
+			return true, nil
+		}
+	}
+	return
+}
+
+// setTime records the time the entry was last fetched or accessed in the
+// map, keeping the latest.
+func (d *Database) setTime(times map[string]time.Time, id *url.URL, t time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if t.After(times[id.String()]) {
+		times[id.String()] = t
+	}
+}
+
+// lastUsed returns the latest time the entry was fetched or accessed.
+//
+// Must be called with the lock held.
+func (d *Database) lastUsed(id string) time.Time {
+	if accessed := d.accessed[id]; accessed.After(d.fetched[id]) {
+		return accessed
+	}
+	return d.fetched[id]
+}
+
+// owns returns true if the IRI is on the host of the Database.
+func (d *Database) owns(iri string) bool {
+	u, err := url.Parse(iri)
+	return err == nil && u.Host == d.host
+}
+
+// ownerOf returns the first 'attributedTo' or 'actor' IRI of a serialized
+// value, or an empty string.
+func ownerOf(m map[string]interface{}) string {
+	if o := firstIRI(m["attributedTo"]); len(o) > 0 {
+		return o
+	}
+	return firstIRI(m["actor"])
+}
+
+// firstIRI returns the first IRI of a serialized property value.
+func firstIRI(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case map[string]interface{}:
+		return firstIRI(t["id"])
+	case []interface{}:
+		for _, e := range t {
+			if s := firstIRI(e); len(s) > 0 {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// mentions returns true if the IRI is anywhere in the serialized value, other
+// than its JSON-LD context.
+func mentions(v interface{}, iri string) bool {
+	switch t := v.(type) {
+	case string:
+		return t == iri
+	case map[string]interface{}:
+		for k, e := range t {
+			if k != "@context" && mentions(e, iri) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range t {
+			if mentions(e, iri) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,247 @@
+package memdb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// network is a set of servers reachable by host, standing in for HTTP.
+type network map[string]*server
+
+// server is one federating instance backed by its own Database.
+type server struct {
+	db    *Database
+	actor pub.FederatingActor
+	net   network
+}
+
+// newServer creates a federating instance for the host on the network.
+func (n network) newServer(host string) *server {
+	s := &server{db: New(host), net: n}
+	s.actor = pub.NewFederatingActor(s, s, s.db, s)
+	n[host] = s
+	return s
+}
+
+// Now implements pub.Clock.
+func (s *server) Now() time.Time {
+	return time.Date(2019, 2, 24, 0, 0, 0, 0, time.UTC)
+}
+
+// AuthenticateGetInbox implements pub.CommonBehavior.
+func (s *server) AuthenticateGetInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// AuthenticateGetOutbox implements pub.CommonBehavior.
+func (s *server) AuthenticateGetOutbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// GetOutbox implements pub.CommonBehavior.
+func (s *server) GetOutbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return s.db.GetOutbox(c, r.URL)
+}
+
+// NewTransport implements pub.CommonBehavior.
+func (s *server) NewTransport(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (pub.Transport, error) {
+	return transport{s.net}, nil
+}
+
+// PostInboxRequestBodyHook implements pub.FederatingProtocol.
+func (s *server) PostInboxRequestBodyHook(c context.Context, r *http.Request, activity pub.Activity) (context.Context, error) {
+	return c, nil
+}
+
+// AuthenticatePostInbox implements pub.FederatingProtocol.
+func (s *server) AuthenticatePostInbox(c context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool, error) {
+	return c, true, nil
+}
+
+// Blocked implements pub.FederatingProtocol.
+func (s *server) Blocked(c context.Context, actorIRIs []*url.URL) (bool, error) {
+	return false, nil
+}
+
+// Callbacks implements pub.FederatingProtocol.
+func (s *server) Callbacks(c context.Context) (pub.FederatingWrappedCallbacks, []interface{}, error) {
+	return pub.FederatingWrappedCallbacks{
+		OnFollow: pub.OnFollowAutomaticallyAccept,
+	}, nil, nil
+}
+
+// DefaultCallback implements pub.FederatingProtocol.
+func (s *server) DefaultCallback(c context.Context, activity pub.Activity) error {
+	return nil
+}
+
+// MaxInboxForwardingRecursionDepth implements pub.FederatingProtocol.
+func (s *server) MaxInboxForwardingRecursionDepth(c context.Context) int {
+	return 4
+}
+
+// MaxDeliveryRecursionDepth implements pub.FederatingProtocol.
+func (s *server) MaxDeliveryRecursionDepth(c context.Context) int {
+	return 4
+}
+
+// FilterForwarding implements pub.FederatingProtocol.
+func (s *server) FilterForwarding(c context.Context, potentialRecipients []*url.URL, a pub.Activity) ([]*url.URL, error) {
+	return potentialRecipients, nil
+}
+
+// GetInbox implements pub.FederatingProtocol.
+func (s *server) GetInbox(c context.Context, r *http.Request) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	return s.db.GetInbox(c, r.URL)
+}
+
+// transport dereferences from and delivers to the servers of a network.
+type transport struct {
+	net network
+}
+
+// Dereference serializes the entry from the Database of the IRI's host.
+func (t transport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	s, ok := t.net[iri.Host]
+	if !ok {
+		return nil, fmt.Errorf("unknown host %s", iri.Host)
+	}
+	if err := s.db.Lock(c, iri); err != nil {
+		return nil, err
+	}
+	v, err := s.db.Get(c, iri)
+	s.db.Unlock(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	m, err := streams.Serialize(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(m)
+}
+
+// Deliver posts the payload to the inbox on the server of its host.
+func (t transport) Deliver(c context.Context, b []byte, to *url.URL) error {
+	s, ok := t.net[to.Host]
+	if !ok {
+		return fmt.Errorf("unknown host %s", to.Host)
+	}
+	r := httptest.NewRequest("POST", to.String(), bytes.NewReader(b))
+	r.Header.Set("Content-Type", "application/activity+json")
+	w := httptest.NewRecorder()
+	if handled, err := s.actor.PostInbox(c, w, r); err != nil {
+		return err
+	} else if !handled {
+		return fmt.Errorf("inbox %s did not handle the request", to)
+	} else if w.Code >= 300 {
+		return fmt.Errorf("inbox %s responded %d", to, w.Code)
+	}
+	return nil
+}
+
+// BatchDeliver delivers the payload to each recipient.
+func (t transport) BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error {
+	for _, to := range recipients {
+		if err := t.Deliver(c, b, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectionContains returns true if the Collection has the IRI in its items.
+func collectionContains(col vocab.ActivityStreamsCollection, iri *url.URL) bool {
+	items := col.GetActivityStreamsItems()
+	if items == nil {
+		return false
+	}
+	for iter := items.Begin(); iter != items.End(); iter = iter.Next() {
+		if id, err := pub.ToId(iter); err == nil && id.String() == iri.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// TestFederation tests federating actors backed by Databases end-to-end.
+func TestFederation(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(t *testing.T) (a, b *server, alex, sam vocab.ActivityStreamsPerson) {
+		n := make(network)
+		a = n.newServer("a.example.com")
+		b = n.newServer("b.example.com")
+		var err error
+		if alex, err = a.db.CreatePerson(ctx, "alex"); err != nil {
+			t.Fatal(err)
+		}
+		if sam, err = b.db.CreatePerson(ctx, "sam"); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	t.Run("SendsNoteToPeer", func(t *testing.T) {
+		// Setup
+		a, b, alex, sam := setupFn(t)
+		note := streams.NewActivityStreamsNote()
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString("Hello, Sam!")
+		note.SetActivityStreamsContent(content)
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(sam.GetJSONLDId().Get())
+		note.SetActivityStreamsTo(to)
+		// Run
+		create, err := a.actor.Send(ctx, alex.GetActivityStreamsOutbox().GetIRI(), note)
+		// Verify
+		if err != nil {
+			t.Fatal(err)
+		}
+		createId := create.GetJSONLDId().Get()
+		assertEqual(t, createId.Host, "a.example.com")
+		outbox, err := a.db.GetOutbox(ctx, alex.GetActivityStreamsOutbox().GetIRI())
+		assertEqual(t, err, nil)
+		assertEqual(t, outbox.GetActivityStreamsOrderedItems().At(0).GetIRI().String(), createId.String())
+		contains, err := b.db.InboxContains(ctx, sam.GetActivityStreamsInbox().GetIRI(), createId)
+		assertEqual(t, err, nil)
+		assertEqual(t, contains, true)
+		exists, err := b.db.Exists(ctx, note.GetJSONLDId().Get())
+		assertEqual(t, err, nil)
+		assertEqual(t, exists, true)
+	})
+	t.Run("FollowIsAutomaticallyAccepted", func(t *testing.T) {
+		// Setup
+		a, b, alex, sam := setupFn(t)
+		follow := streams.NewActivityStreamsFollow()
+		actor := streams.NewActivityStreamsActorProperty()
+		actor.AppendIRI(sam.GetJSONLDId().Get())
+		follow.SetActivityStreamsActor(actor)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(alex.GetJSONLDId().Get())
+		follow.SetActivityStreamsObject(op)
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(alex.GetJSONLDId().Get())
+		follow.SetActivityStreamsTo(to)
+		// Run
+		_, err := b.actor.Send(ctx, sam.GetActivityStreamsOutbox().GetIRI(), follow)
+		// Verify
+		if err != nil {
+			t.Fatal(err)
+		}
+		followers, err := a.db.Followers(ctx, alex.GetJSONLDId().Get())
+		assertEqual(t, err, nil)
+		assertEqual(t, collectionContains(followers, sam.GetJSONLDId().Get()), true)
+		following, err := b.db.Following(ctx, sam.GetJSONLDId().Get())
+		assertEqual(t, err, nil)
+		assertEqual(t, collectionContains(following, alex.GetJSONLDId().Get()), true)
+	})
+}
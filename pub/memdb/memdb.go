@@ -0,0 +1,534 @@
+// Package memdb implements an in-memory pub.Database.
+//
+// It is meant for prototypes, examples, and tests. All data is lost when the
+// process exits.
+package memdb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/internal/locks"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNotFound indicates that there is no entry for an IRI.
+	ErrNotFound = errors.New("memdb: not found")
+	// ErrNotLocked indicates that Unlock was called for an IRI that is
+	// not locked.
+	ErrNotLocked = errors.New("memdb: not locked")
+)
+
+// Database must implement pub.Database and its optional extensions.
+var _ pub.Database = &Database{}
+var _ pub.FollowRequestDatabase = &Database{}
+var _ pub.ReportDatabase = &Database{}
+var _ pub.ActorPurgeDatabase = &Database{}
+var _ pub.CollectionDatabase = &Database{}
+var _ pub.CacheDatabase = &Database{}
+
+// Database is an in-memory pub.Database for the actors of a single host.
+//
+// Entries whose IRI is on this host are owned by it, and all other entries are
+// federated data. New ids are generated on this host.
+//
+// Values are stored as serialized JSON, so the values returned are never
+// shared between callers. It is safe for concurrent use.
+type Database struct {
+	scheme string
+	host   string
+	// locks are the per-IRI locks.
+	locks *locks.Set
+	// mu protects the fields below.
+	mu       sync.RWMutex
+	values   map[string][]byte
+	fetched  map[string]time.Time
+	accessed map[string]time.Time
+	actors   map[string]*actorBoxes
+	inboxes  map[string]string
+	outboxes map[string]string
+	reports  []*pub.Report
+	nextId   int
+}
+
+// actorBoxes are the IRIs of the collections of a local actor.
+type actorBoxes struct {
+	inbox          *url.URL
+	outbox         *url.URL
+	followers      *url.URL
+	following      *url.URL
+	liked          *url.URL
+	followRequests *url.URL
+}
+
+// New creates an empty Database owning the IRIs of the host, such as
+// "example.com", using the "https" scheme.
+func New(host string) *Database {
+	return &Database{
+		scheme:   "https",
+		host:     host,
+		locks:    locks.New(),
+		values:   make(map[string][]byte),
+		fetched:  make(map[string]time.Time),
+		accessed: make(map[string]time.Time),
+		actors:   make(map[string]*actorBoxes),
+		inboxes:  make(map[string]string),
+		outboxes: make(map[string]string),
+	}
+}
+
+// Host returns the host owned by the Database.
+func (d *Database) Host() string {
+	return d.host
+}
+
+// Lock takes a lock for the object at the specified id. It blocks until the
+// lock is taken or the context is done.
+func (d *Database) Lock(c context.Context, id *url.URL) error {
+	return d.locks.Lock(c, id.String())
+}
+
+// Unlock makes the lock for the object at the specified id available.
+func (d *Database) Unlock(c context.Context, id *url.URL) error {
+	if !d.locks.Unlock(id.String()) {
+		return ErrNotLocked
+	}
+	return nil
+}
+
+// InboxContains returns true if the OrderedCollection at 'inbox' contains the
+// specified 'id'.
+func (d *Database) InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error) {
+	return d.ContainsItem(c, inbox, id)
+}
+
+// GetInbox returns the only page of the inbox at the specified IRI, with all of
+// its items, newest first.
+func (d *Database) GetInbox(c context.Context, inboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	return d.firstPage(c, inboxIRI)
+}
+
+// SetInbox saves the items of the inbox page given from GetInbox.
+func (d *Database) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return d.setFirstPage(c, inbox)
+}
+
+// Owns returns true if the IRI is on the host of the Database.
+func (d *Database) Owns(c context.Context, id *url.URL) (owns bool, err error) {
+	return id.Host == d.host, nil
+}
+
+// ActorForOutbox fetches the actor's IRI for the given outbox IRI.
+func (d *Database) ActorForOutbox(c context.Context, outboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	actor, ok := d.outboxes[outboxIRI.String()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return url.Parse(actor)
+}
+
+// ActorForInbox fetches the actor's IRI for the given inbox IRI.
+func (d *Database) ActorForInbox(c context.Context, inboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	actor, ok := d.inboxes[inboxIRI.String()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return url.Parse(actor)
+}
+
+// OutboxForInbox fetches the corresponding actor's outbox IRI for the actor's
+// inbox IRI.
+func (d *Database) OutboxForInbox(c context.Context, inboxIRI *url.URL) (outboxIRI *url.URL, err error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	actor, ok := d.inboxes[inboxIRI.String()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return d.actors[actor].outbox, nil
+}
+
+// Exists returns true if the database has an entry for the specified id.
+func (d *Database) Exists(c context.Context, id *url.URL) (exists bool, err error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	_, exists = d.values[id.String()]
+	return
+}
+
+// Get returns the database entry for the specified id.
+//
+// Returns ErrNotFound if there is no such entry.
+func (d *Database) Get(c context.Context, id *url.URL) (value vocab.Type, err error) {
+	d.mu.RLock()
+	b, ok := d.values[id.String()]
+	d.mu.RUnlock()
+	if !ok {
+		return nil, ErrNotFound
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return
+	}
+	return streams.ToType(c, m)
+}
+
+// Create adds a new entry to the database, keyed by its id.
+func (d *Database) Create(c context.Context, asType vocab.Type) error {
+	return d.set(asType)
+}
+
+// Update sets an existing entry to the database based on the value's id.
+func (d *Database) Update(c context.Context, asType vocab.Type) error {
+	return d.set(asType)
+}
+
+// Delete removes the entry with the given id.
+func (d *Database) Delete(c context.Context, id *url.URL) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.values, id.String())
+	delete(d.fetched, id.String())
+	delete(d.accessed, id.String())
+	return nil
+}
+
+// GetOutbox returns the only page of the outbox at the specified IRI, with all
+// of its items, newest first.
+func (d *Database) GetOutbox(c context.Context, outboxIRI *url.URL) (outbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	return d.firstPage(c, outboxIRI)
+}
+
+// SetOutbox saves the items of the outbox page given from GetOutbox.
+func (d *Database) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return d.setFirstPage(c, outbox)
+}
+
+// NewId creates a new IRI on the host of the Database, such as
+// "https://example.com/note/1".
+func (d *Database) NewId(c context.Context, t vocab.Type) (id *url.URL, err error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for {
+		d.nextId++
+		id = &url.URL{
+			Scheme: d.scheme,
+			Host:   d.host,
+			Path:   fmt.Sprintf("/%s/%d", strings.ToLower(t.GetTypeName()), d.nextId),
+		}
+		if _, ok := d.values[id.String()]; !ok {
+			return
+		}
+	}
+}
+
+// PrependItem adds the item to the front of the OrderedCollection at the
+// specified IRI.
+func (d *Database) PrependItem(c context.Context, collectionIRI, item *url.URL) error {
+	oc, err := d.orderedCollection(c, collectionIRI)
+	if err != nil {
+		return err
+	}
+	oi := oc.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	oi.PrependIRI(item)
+	oc.SetActivityStreamsOrderedItems(oi)
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(oi.Len())
+	oc.SetActivityStreamsTotalItems(total)
+	return d.set(oc)
+}
+
+// GetItems returns at most 'limit' items of the OrderedCollection at the
+// specified IRI, newest first, skipping the 'offset' newest ones.
+func (d *Database) GetItems(c context.Context, collectionIRI *url.URL, offset, limit int) (items []*url.URL, err error) {
+	items, err = d.items(c, collectionIRI)
+	if err != nil {
+		return
+	}
+	if offset >= len(items) {
+		return nil, nil
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return
+}
+
+// CountItems returns the number of items of the OrderedCollection at the
+// specified IRI.
+func (d *Database) CountItems(c context.Context, collectionIRI *url.URL) (n int, err error) {
+	items, err := d.items(c, collectionIRI)
+	return len(items), err
+}
+
+// ContainsItem returns true if the OrderedCollection at the specified IRI
+// contains the item.
+func (d *Database) ContainsItem(c context.Context, collectionIRI, item *url.URL) (contains bool, err error) {
+	items, err := d.items(c, collectionIRI)
+	if err != nil {
+		return
+	}
+	for _, id := range items {
+		if id.String() == item.String() {
+			return true, nil
+		}
+	}
+	return
+}
+
+// Followers obtains the Followers Collection of a local actor.
+func (d *Database) Followers(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error) {
+	boxes, err := d.actorBoxes(actorIRI)
+	if err != nil {
+		return
+	}
+	return d.collection(c, boxes.followers)
+}
+
+// Following obtains the Following Collection of a local actor.
+func (d *Database) Following(c context.Context, actorIRI *url.URL) (following vocab.ActivityStreamsCollection, err error) {
+	boxes, err := d.actorBoxes(actorIRI)
+	if err != nil {
+		return
+	}
+	return d.collection(c, boxes.following)
+}
+
+// Liked obtains the Liked Collection of a local actor.
+func (d *Database) Liked(c context.Context, actorIRI *url.URL) (liked vocab.ActivityStreamsCollection, err error) {
+	boxes, err := d.actorBoxes(actorIRI)
+	if err != nil {
+		return
+	}
+	return d.collection(c, boxes.liked)
+}
+
+// FollowRequests obtains the OrderedCollection of pending Follow requests of a
+// local actor.
+func (d *Database) FollowRequests(c context.Context, actorIRI *url.URL) (requests vocab.ActivityStreamsOrderedCollection, err error) {
+	boxes, err := d.actorBoxes(actorIRI)
+	if err != nil {
+		return
+	}
+	return d.orderedCollection(c, boxes.followRequests)
+}
+
+// CreateReport saves a new Report. A Report with the same id as an existing one
+// replaces it.
+func (d *Database) CreateReport(c context.Context, r *pub.Report) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if r.Id != nil {
+		for i, existing := range d.reports {
+			if existing.Id != nil && existing.Id.String() == r.Id.String() {
+				d.reports[i] = r
+				return nil
+			}
+		}
+	}
+	d.reports = append(d.reports, r)
+	return nil
+}
+
+// Reports returns the saved Reports, oldest first.
+func (d *Database) Reports() []*pub.Report {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	r := make([]*pub.Report, len(d.reports))
+	copy(r, d.reports)
+	return r
+}
+
+// PurgeActor deletes the federated entries of the actor: the ones under the
+// actor's IRI, and the ones whose 'actor' or 'attributedTo' is the actor.
+// Owned entries are left, even if attributed to the actor.
+func (d *Database) PurgeActor(c context.Context, actorIRI *url.URL) error {
+	if actorIRI.Host == d.host {
+		return fmt.Errorf("memdb: cannot purge local actor %s", actorIRI)
+	}
+	actor := actorIRI.String()
+	prefix := strings.TrimSuffix(actor, "/") + "/"
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for id, b := range d.values {
+		if d.owns(id) {
+			continue
+		}
+		if id == actor || strings.HasPrefix(id, prefix) {
+			delete(d.values, id)
+			delete(d.fetched, id)
+			delete(d.accessed, id)
+			continue
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(b, &m); err != nil {
+			return err
+		}
+		if refersTo(m["actor"], actor) || refersTo(m["attributedTo"], actor) {
+			delete(d.values, id)
+			delete(d.fetched, id)
+			delete(d.accessed, id)
+		}
+	}
+	return nil
+}
+
+// set serializes and stores the value, keyed by its id.
+func (d *Database) set(t vocab.Type) error {
+	id, err := pub.GetId(t)
+	if err != nil {
+		return err
+	}
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.values[id.String()] = b
+	return nil
+}
+
+// actorBoxes returns the collections of a local actor.
+func (d *Database) actorBoxes(actorIRI *url.URL) (*actorBoxes, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	boxes, ok := d.actors[actorIRI.String()]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return boxes, nil
+}
+
+// collection gets the Collection at the IRI.
+func (d *Database) collection(c context.Context, iri *url.URL) (vocab.ActivityStreamsCollection, error) {
+	t, err := d.Get(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	col, ok := t.(vocab.ActivityStreamsCollection)
+	if !ok {
+		return nil, fmt.Errorf("memdb: %s is not a Collection: %T", iri, t)
+	}
+	return col, nil
+}
+
+// orderedCollection gets the OrderedCollection at the IRI.
+func (d *Database) orderedCollection(c context.Context, iri *url.URL) (vocab.ActivityStreamsOrderedCollection, error) {
+	t, err := d.Get(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	oc, ok := t.(vocab.ActivityStreamsOrderedCollection)
+	if !ok {
+		return nil, fmt.Errorf("memdb: %s is not an OrderedCollection: %T", iri, t)
+	}
+	return oc, nil
+}
+
+// items returns the ids of the items of the OrderedCollection at the IRI.
+func (d *Database) items(c context.Context, iri *url.URL) (items []*url.URL, err error) {
+	oc, err := d.orderedCollection(c, iri)
+	if err != nil {
+		return
+	}
+	oi := oc.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		return
+	}
+	for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+		var id *url.URL
+		id, err = pub.ToId(iter)
+		if err != nil {
+			return
+		}
+		items = append(items, id)
+	}
+	return
+}
+
+// firstPage returns a page with all of the items of the OrderedCollection at
+// the IRI.
+func (d *Database) firstPage(c context.Context, iri *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	oc, err := d.orderedCollection(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewJSONLDIdProperty()
+	pageIRI := *iri
+	pageIRI.RawQuery = "page=true"
+	id.Set(&pageIRI)
+	page.SetJSONLDId(id)
+	partOf := streams.NewActivityStreamsPartOfProperty()
+	partOf.SetIRI(iri)
+	page.SetActivityStreamsPartOf(partOf)
+	if oi := oc.GetActivityStreamsOrderedItems(); oi != nil {
+		page.SetActivityStreamsOrderedItems(oi)
+	}
+	return page, nil
+}
+
+// setFirstPage saves the items of a page from firstPage into its
+// OrderedCollection.
+func (d *Database) setFirstPage(c context.Context, page vocab.ActivityStreamsOrderedCollectionPage) error {
+	partOf := page.GetActivityStreamsPartOf()
+	if partOf == nil {
+		return fmt.Errorf("memdb: page has no partOf")
+	}
+	iri, err := pub.ToId(partOf)
+	if err != nil {
+		return err
+	}
+	oc, err := d.orderedCollection(c, iri)
+	if err != nil {
+		return err
+	}
+	oi := page.GetActivityStreamsOrderedItems()
+	if oi == nil {
+		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	}
+	oc.SetActivityStreamsOrderedItems(oi)
+	total := streams.NewActivityStreamsTotalItemsProperty()
+	total.Set(oi.Len())
+	oc.SetActivityStreamsTotalItems(total)
+	return d.set(oc)
+}
+
+// refersTo returns true if the serialized property value is, or contains, the
+// IRI.
+func refersTo(v interface{}, iri string) bool {
+	switch t := v.(type) {
+	case string:
+		return t == iri
+	case map[string]interface{}:
+		return refersTo(t["id"], iri)
+	case []interface{}:
+		for _, e := range t {
+			if refersTo(e, iri) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package memdb
+
+import (
+	"context"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/dbtest"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("expected equal: %v != %v", a, b)
+	}
+}
+
+// TestDatabase tests the pub.Database contracts of the in-memory Database.
+func TestDatabase(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OwnsByHost", func(t *testing.T) {
+		d := New("example.com")
+		owns, err := d.Owns(ctx, mustParse("https://example.com/note/1"))
+		assertEqual(t, err, nil)
+		assertEqual(t, owns, true)
+		owns, err = d.Owns(ctx, mustParse("https://other.example.com/note/1"))
+		assertEqual(t, err, nil)
+		assertEqual(t, owns, false)
+	})
+	t.Run("NewIdIsUniqueOnHost", func(t *testing.T) {
+		d := New("example.com")
+		note := streams.NewActivityStreamsNote()
+		id1, err := d.NewId(ctx, note)
+		assertEqual(t, err, nil)
+		id2, err := d.NewId(ctx, note)
+		assertEqual(t, err, nil)
+		assertEqual(t, id1.Host, "example.com")
+		assertEqual(t, id1.String() != id2.String(), true)
+	})
+	t.Run("GetReturnsCopy", func(t *testing.T) {
+		d := New("example.com")
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse("https://other.example.com/note/1"))
+		note.SetJSONLDId(id)
+		assertEqual(t, d.Create(ctx, note), nil)
+		got, err := d.Get(ctx, id.Get())
+		assertEqual(t, err, nil)
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString("Changed")
+		got.(vocab.ActivityStreamsNote).SetActivityStreamsName(name)
+		got, err = d.Get(ctx, id.Get())
+		assertEqual(t, err, nil)
+		assertEqual(t, got.(vocab.ActivityStreamsNote).GetActivityStreamsName(), nil)
+	})
+	t.Run("GetErrorIfNotFound", func(t *testing.T) {
+		d := New("example.com")
+		_, err := d.Get(ctx, mustParse("https://example.com/note/1"))
+		assertEqual(t, err, ErrNotFound)
+	})
+	t.Run("InboxKeepsNewestFirst", func(t *testing.T) {
+		d := New("example.com")
+		p, err := d.CreatePerson(ctx, "alex")
+		assertEqual(t, err, nil)
+		inboxIRI := p.GetActivityStreamsInbox().GetIRI()
+		for _, id := range []string{"https://other.example.com/1", "https://other.example.com/2"} {
+			page, err := d.GetInbox(ctx, inboxIRI)
+			assertEqual(t, err, nil)
+			oi := page.GetActivityStreamsOrderedItems()
+			if oi == nil {
+				oi = streams.NewActivityStreamsOrderedItemsProperty()
+			}
+			oi.PrependIRI(mustParse(id))
+			page.SetActivityStreamsOrderedItems(oi)
+			assertEqual(t, d.SetInbox(ctx, page), nil)
+		}
+		page, err := d.GetInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		oi := page.GetActivityStreamsOrderedItems()
+		assertEqual(t, oi.Len(), 2)
+		assertEqual(t, oi.At(0).GetIRI().String(), "https://other.example.com/2")
+		contains, err := d.InboxContains(ctx, inboxIRI, mustParse("https://other.example.com/1"))
+		assertEqual(t, err, nil)
+		assertEqual(t, contains, true)
+	})
+	t.Run("ActorForBoxes", func(t *testing.T) {
+		d := New("example.com")
+		p, err := d.CreatePerson(ctx, "alex")
+		assertEqual(t, err, nil)
+		inboxIRI := p.GetActivityStreamsInbox().GetIRI()
+		outboxIRI := p.GetActivityStreamsOutbox().GetIRI()
+		actor, err := d.ActorForInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), "https://example.com/users/alex")
+		actor, err = d.ActorForOutbox(ctx, outboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), "https://example.com/users/alex")
+		outbox, err := d.OutboxForInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, outbox.String(), outboxIRI.String())
+		_, err = d.Followers(ctx, actor)
+		assertEqual(t, err, nil)
+		_, err = d.FollowRequests(ctx, actor)
+		assertEqual(t, err, nil)
+	})
+	t.Run("LockIsExclusivePerIRI", func(t *testing.T) {
+		d := New("example.com")
+		id := mustParse("https://example.com/note/1")
+		other := mustParse("https://example.com/note/2")
+		counter := 0
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.Lock(ctx, id)
+				// A different IRI never blocks.
+				d.Lock(ctx, other)
+				d.Unlock(ctx, other)
+				counter++
+				d.Unlock(ctx, id)
+			}()
+		}
+		wg.Wait()
+		assertEqual(t, counter, 50)
+		assertEqual(t, d.locks.Len(), 0)
+	})
+	t.Run("UnlockErrorIfNotLocked", func(t *testing.T) {
+		d := New("example.com")
+		assertEqual(t, d.Unlock(ctx, mustParse("https://example.com/note/1")), ErrNotLocked)
+	})
+	t.Run("PurgeActorDeletesItsEntries", func(t *testing.T) {
+		d := New("example.com")
+		actor := mustParse("https://other.example.com/users/sam")
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse("https://other.example.com/note/1"))
+		note.SetJSONLDId(id)
+		attr := streams.NewActivityStreamsAttributedToProperty()
+		attr.AppendIRI(actor)
+		note.SetActivityStreamsAttributedTo(attr)
+		other := streams.NewActivityStreamsNote()
+		otherId := streams.NewJSONLDIdProperty()
+		otherId.Set(mustParse("https://other.example.com/note/2"))
+		other.SetJSONLDId(otherId)
+		assertEqual(t, d.Create(ctx, note), nil)
+		assertEqual(t, d.Create(ctx, other), nil)
+		assertEqual(t, d.PurgeActor(ctx, actor), nil)
+		exists, _ := d.Exists(ctx, id.Get())
+		assertEqual(t, exists, false)
+		exists, _ = d.Exists(ctx, otherId.Get())
+		assertEqual(t, exists, true)
+	})
+	t.Run("CreateReportReplacesSameId", func(t *testing.T) {
+		d := New("example.com")
+		id := mustParse("https://other.example.com/flag/1")
+		assertEqual(t, d.CreateReport(ctx, &pub.Report{Id: id}), nil)
+		assertEqual(t, d.CreateReport(ctx, &pub.Report{Id: id, Comment: "spam"}), nil)
+		r := d.Reports()
+		assertEqual(t, len(r), 1)
+		assertEqual(t, r[0].Comment, "spam")
+	})
+}
+
+// TestConformance runs the dbtest suite against the in-memory Database.
+func TestConformance(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) dbtest.Fixture {
+		d := New("example.com")
+		p, err := d.CreatePerson(context.Background(), "alex")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dbtest.Fixture{
+			DB:     d,
+			Actor:  p.GetJSONLDId().Get(),
+			Inbox:  p.GetActivityStreamsInbox().GetIRI(),
+			Outbox: p.GetActivityStreamsOutbox().GetIRI(),
+		}
+	})
+}
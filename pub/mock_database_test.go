@@ -10,6 +10,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	url "net/url"
 	reflect "reflect"
+	time "time"
 )
 
 // MockDatabase is a mock of Database interface
@@ -327,3 +328,344 @@ func (mr *MockDatabaseMockRecorder) Liked(c, actorIRI interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Liked", reflect.TypeOf((*MockDatabase)(nil).Liked), c, actorIRI)
 }
+
+// MockFollowRequestDatabase is a mock of FollowRequestDatabase interface
+type MockFollowRequestDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowRequestDatabaseMockRecorder
+}
+
+// MockFollowRequestDatabaseMockRecorder is the mock recorder for MockFollowRequestDatabase
+type MockFollowRequestDatabaseMockRecorder struct {
+	mock *MockFollowRequestDatabase
+}
+
+// NewMockFollowRequestDatabase creates a new mock instance
+func NewMockFollowRequestDatabase(ctrl *gomock.Controller) *MockFollowRequestDatabase {
+	mock := &MockFollowRequestDatabase{ctrl: ctrl}
+	mock.recorder = &MockFollowRequestDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFollowRequestDatabase) EXPECT() *MockFollowRequestDatabaseMockRecorder {
+	return m.recorder
+}
+
+// FollowRequests mocks base method
+func (m *MockFollowRequestDatabase) FollowRequests(c context.Context, actorIRI *url.URL) (vocab.ActivityStreamsOrderedCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRequests", c, actorIRI)
+	ret0, _ := ret[0].(vocab.ActivityStreamsOrderedCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRequests indicates an expected call of FollowRequests
+func (mr *MockFollowRequestDatabaseMockRecorder) FollowRequests(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRequests", reflect.TypeOf((*MockFollowRequestDatabase)(nil).FollowRequests), c, actorIRI)
+}
+
+// MockReportDatabase is a mock of ReportDatabase interface
+type MockReportDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportDatabaseMockRecorder
+}
+
+// MockReportDatabaseMockRecorder is the mock recorder for MockReportDatabase
+type MockReportDatabaseMockRecorder struct {
+	mock *MockReportDatabase
+}
+
+// NewMockReportDatabase creates a new mock instance
+func NewMockReportDatabase(ctrl *gomock.Controller) *MockReportDatabase {
+	mock := &MockReportDatabase{ctrl: ctrl}
+	mock.recorder = &MockReportDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockReportDatabase) EXPECT() *MockReportDatabaseMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method
+func (m *MockReportDatabase) CreateReport(c context.Context, r *Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", c, r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReport indicates an expected call of CreateReport
+func (mr *MockReportDatabaseMockRecorder) CreateReport(c, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockReportDatabase)(nil).CreateReport), c, r)
+}
+
+// MockActorPurgeDatabase is a mock of ActorPurgeDatabase interface
+type MockActorPurgeDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockActorPurgeDatabaseMockRecorder
+}
+
+// MockActorPurgeDatabaseMockRecorder is the mock recorder for MockActorPurgeDatabase
+type MockActorPurgeDatabaseMockRecorder struct {
+	mock *MockActorPurgeDatabase
+}
+
+// NewMockActorPurgeDatabase creates a new mock instance
+func NewMockActorPurgeDatabase(ctrl *gomock.Controller) *MockActorPurgeDatabase {
+	mock := &MockActorPurgeDatabase{ctrl: ctrl}
+	mock.recorder = &MockActorPurgeDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockActorPurgeDatabase) EXPECT() *MockActorPurgeDatabaseMockRecorder {
+	return m.recorder
+}
+
+// PurgeActor mocks base method
+func (m *MockActorPurgeDatabase) PurgeActor(c context.Context, actorIRI *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeActor", c, actorIRI)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeActor indicates an expected call of PurgeActor
+func (mr *MockActorPurgeDatabaseMockRecorder) PurgeActor(c, actorIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeActor", reflect.TypeOf((*MockActorPurgeDatabase)(nil).PurgeActor), c, actorIRI)
+}
+
+// MockCollectionDatabase is a mock of CollectionDatabase interface
+type MockCollectionDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollectionDatabaseMockRecorder
+}
+
+// MockCollectionDatabaseMockRecorder is the mock recorder for MockCollectionDatabase
+type MockCollectionDatabaseMockRecorder struct {
+	mock *MockCollectionDatabase
+}
+
+// NewMockCollectionDatabase creates a new mock instance
+func NewMockCollectionDatabase(ctrl *gomock.Controller) *MockCollectionDatabase {
+	mock := &MockCollectionDatabase{ctrl: ctrl}
+	mock.recorder = &MockCollectionDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCollectionDatabase) EXPECT() *MockCollectionDatabaseMockRecorder {
+	return m.recorder
+}
+
+// PrependItem mocks base method
+func (m *MockCollectionDatabase) PrependItem(c context.Context, collectionIRI, item *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrependItem", c, collectionIRI, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrependItem indicates an expected call of PrependItem
+func (mr *MockCollectionDatabaseMockRecorder) PrependItem(c, collectionIRI, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrependItem", reflect.TypeOf((*MockCollectionDatabase)(nil).PrependItem), c, collectionIRI, item)
+}
+
+// GetItems mocks base method
+func (m *MockCollectionDatabase) GetItems(c context.Context, collectionIRI *url.URL, offset, limit int) ([]*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItems", c, collectionIRI, offset, limit)
+	ret0, _ := ret[0].([]*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItems indicates an expected call of GetItems
+func (mr *MockCollectionDatabaseMockRecorder) GetItems(c, collectionIRI, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockCollectionDatabase)(nil).GetItems), c, collectionIRI, offset, limit)
+}
+
+// CountItems mocks base method
+func (m *MockCollectionDatabase) CountItems(c context.Context, collectionIRI *url.URL) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountItems", c, collectionIRI)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountItems indicates an expected call of CountItems
+func (mr *MockCollectionDatabaseMockRecorder) CountItems(c, collectionIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountItems", reflect.TypeOf((*MockCollectionDatabase)(nil).CountItems), c, collectionIRI)
+}
+
+// ContainsItem mocks base method
+func (m *MockCollectionDatabase) ContainsItem(c context.Context, collectionIRI, item *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsItem", c, collectionIRI, item)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsItem indicates an expected call of ContainsItem
+func (mr *MockCollectionDatabaseMockRecorder) ContainsItem(c, collectionIRI, item interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsItem", reflect.TypeOf((*MockCollectionDatabase)(nil).ContainsItem), c, collectionIRI, item)
+}
+
+// MockTransactionDatabase is a mock of TransactionDatabase interface
+type MockTransactionDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionDatabaseMockRecorder
+}
+
+// MockTransactionDatabaseMockRecorder is the mock recorder for MockTransactionDatabase
+type MockTransactionDatabaseMockRecorder struct {
+	mock *MockTransactionDatabase
+}
+
+// NewMockTransactionDatabase creates a new mock instance
+func NewMockTransactionDatabase(ctrl *gomock.Controller) *MockTransactionDatabase {
+	mock := &MockTransactionDatabase{ctrl: ctrl}
+	mock.recorder = &MockTransactionDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTransactionDatabase) EXPECT() *MockTransactionDatabaseMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method
+func (m *MockTransactionDatabase) Begin(c context.Context) (context.Context, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", c)
+	ret0, _ := ret[0].(context.Context)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin
+func (mr *MockTransactionDatabaseMockRecorder) Begin(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockTransactionDatabase)(nil).Begin), c)
+}
+
+// Commit mocks base method
+func (m *MockTransactionDatabase) Commit(tx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Commit", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Commit indicates an expected call of Commit
+func (mr *MockTransactionDatabaseMockRecorder) Commit(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockTransactionDatabase)(nil).Commit), tx)
+}
+
+// Rollback mocks base method
+func (m *MockTransactionDatabase) Rollback(tx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollback", tx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rollback indicates an expected call of Rollback
+func (mr *MockTransactionDatabaseMockRecorder) Rollback(tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockTransactionDatabase)(nil).Rollback), tx)
+}
+
+// MockCacheDatabase is a mock of CacheDatabase interface
+type MockCacheDatabase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheDatabaseMockRecorder
+}
+
+// MockCacheDatabaseMockRecorder is the mock recorder for MockCacheDatabase
+type MockCacheDatabaseMockRecorder struct {
+	mock *MockCacheDatabase
+}
+
+// NewMockCacheDatabase creates a new mock instance
+func NewMockCacheDatabase(ctrl *gomock.Controller) *MockCacheDatabase {
+	mock := &MockCacheDatabase{ctrl: ctrl}
+	mock.recorder = &MockCacheDatabaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCacheDatabase) EXPECT() *MockCacheDatabaseMockRecorder {
+	return m.recorder
+}
+
+// SetFetched mocks base method
+func (m *MockCacheDatabase) SetFetched(c context.Context, id *url.URL, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFetched", c, id, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFetched indicates an expected call of SetFetched
+func (mr *MockCacheDatabaseMockRecorder) SetFetched(c, id, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFetched", reflect.TypeOf((*MockCacheDatabase)(nil).SetFetched), c, id, t)
+}
+
+// SetAccessed mocks base method
+func (m *MockCacheDatabase) SetAccessed(c context.Context, id *url.URL, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccessed", c, id, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccessed indicates an expected call of SetAccessed
+func (mr *MockCacheDatabaseMockRecorder) SetAccessed(c, id, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccessed", reflect.TypeOf((*MockCacheDatabase)(nil).SetAccessed), c, id, t)
+}
+
+// StaleEntries mocks base method
+func (m *MockCacheDatabase) StaleEntries(c context.Context, since time.Time, limit int) ([]*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaleEntries", c, since, limit)
+	ret0, _ := ret[0].([]*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaleEntries indicates an expected call of StaleEntries
+func (mr *MockCacheDatabaseMockRecorder) StaleEntries(c, since, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaleEntries", reflect.TypeOf((*MockCacheDatabase)(nil).StaleEntries), c, since, limit)
+}
+
+// IsReferenced mocks base method
+func (m *MockCacheDatabase) IsReferenced(c context.Context, id *url.URL) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsReferenced", c, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsReferenced indicates an expected call of IsReferenced
+func (mr *MockCacheDatabaseMockRecorder) IsReferenced(c, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReferenced", reflect.TypeOf((*MockCacheDatabase)(nil).IsReferenced), c, id)
+}
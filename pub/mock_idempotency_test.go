@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: idempotency.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockIdempotencyStore is a mock of IdempotencyStore interface
+type MockIdempotencyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyStoreMockRecorder
+}
+
+// MockIdempotencyStoreMockRecorder is the mock recorder for MockIdempotencyStore
+type MockIdempotencyStoreMockRecorder struct {
+	mock *MockIdempotencyStore
+}
+
+// NewMockIdempotencyStore creates a new mock instance
+func NewMockIdempotencyStore(ctrl *gomock.Controller) *MockIdempotencyStore {
+	mock := &MockIdempotencyStore{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIdempotencyStore) EXPECT() *MockIdempotencyStoreMockRecorder {
+	return m.recorder
+}
+
+// LockIdempotencyKey mocks base method
+func (m *MockIdempotencyStore) LockIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockIdempotencyKey", c, outboxIRI, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockIdempotencyKey indicates an expected call of LockIdempotencyKey
+func (mr *MockIdempotencyStoreMockRecorder) LockIdempotencyKey(c, outboxIRI, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).LockIdempotencyKey), c, outboxIRI, key)
+}
+
+// UnlockIdempotencyKey mocks base method
+func (m *MockIdempotencyStore) UnlockIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockIdempotencyKey", c, outboxIRI, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockIdempotencyKey indicates an expected call of UnlockIdempotencyKey
+func (mr *MockIdempotencyStoreMockRecorder) UnlockIdempotencyKey(c, outboxIRI, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).UnlockIdempotencyKey), c, outboxIRI, key)
+}
+
+// GetIdempotencyKey mocks base method
+func (m *MockIdempotencyStore) GetIdempotencyKey(c context.Context, outboxIRI *url.URL, key string) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", c, outboxIRI, key)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey
+func (mr *MockIdempotencyStoreMockRecorder) GetIdempotencyKey(c, outboxIRI, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).GetIdempotencyKey), c, outboxIRI, key)
+}
+
+// SetIdempotencyKey mocks base method
+func (m *MockIdempotencyStore) SetIdempotencyKey(c context.Context, outboxIRI *url.URL, key string, id *url.URL) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIdempotencyKey", c, outboxIRI, key, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetIdempotencyKey indicates an expected call of SetIdempotencyKey
+func (mr *MockIdempotencyStoreMockRecorder) SetIdempotencyKey(c, outboxIRI, key, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).SetIdempotencyKey), c, outboxIRI, key, id)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: language.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockDefaultLanguager is a mock of DefaultLanguager interface
+type MockDefaultLanguager struct {
+	ctrl     *gomock.Controller
+	recorder *MockDefaultLanguagerMockRecorder
+}
+
+// MockDefaultLanguagerMockRecorder is the mock recorder for MockDefaultLanguager
+type MockDefaultLanguagerMockRecorder struct {
+	mock *MockDefaultLanguager
+}
+
+// NewMockDefaultLanguager creates a new mock instance
+func NewMockDefaultLanguager(ctrl *gomock.Controller) *MockDefaultLanguager {
+	mock := &MockDefaultLanguager{ctrl: ctrl}
+	mock.recorder = &MockDefaultLanguagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDefaultLanguager) EXPECT() *MockDefaultLanguagerMockRecorder {
+	return m.recorder
+}
+
+// DefaultLanguage mocks base method
+func (m *MockDefaultLanguager) DefaultLanguage(c context.Context, outboxIRI *url.URL) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultLanguage", c, outboxIRI)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// DefaultLanguage indicates an expected call of DefaultLanguage
+func (mr *MockDefaultLanguagerMockRecorder) DefaultLanguage(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultLanguage", reflect.TypeOf((*MockDefaultLanguager)(nil).DefaultLanguage), c, outboxIRI)
+}
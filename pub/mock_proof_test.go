@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: proof.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	ed25519 "crypto/ed25519"
+	gomock "github.com/golang/mock/gomock"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockProofSigner is a mock of ProofSigner interface
+type MockProofSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockProofSignerMockRecorder
+}
+
+// MockProofSignerMockRecorder is the mock recorder for MockProofSigner
+type MockProofSignerMockRecorder struct {
+	mock *MockProofSigner
+}
+
+// NewMockProofSigner creates a new mock instance
+func NewMockProofSigner(ctrl *gomock.Controller) *MockProofSigner {
+	mock := &MockProofSigner{ctrl: ctrl}
+	mock.recorder = &MockProofSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProofSigner) EXPECT() *MockProofSignerMockRecorder {
+	return m.recorder
+}
+
+// ProofKey mocks base method
+func (m *MockProofSigner) ProofKey(c context.Context, outboxIRI *url.URL) (ed25519.PrivateKey, *url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProofKey", c, outboxIRI)
+	ret0, _ := ret[0].(ed25519.PrivateKey)
+	ret1, _ := ret[1].(*url.URL)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ProofKey indicates an expected call of ProofKey
+func (mr *MockProofSignerMockRecorder) ProofKey(c, outboxIRI interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProofKey", reflect.TypeOf((*MockProofSigner)(nil).ProofKey), c, outboxIRI)
+}
@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: seen.go
+
+// Package pub is a generated GoMock package.
+package pub
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	url "net/url"
+	reflect "reflect"
+)
+
+// MockSeenFilter is a mock of SeenFilter interface
+type MockSeenFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeenFilterMockRecorder
+}
+
+// MockSeenFilterMockRecorder is the mock recorder for MockSeenFilter
+type MockSeenFilterMockRecorder struct {
+	mock *MockSeenFilter
+}
+
+// NewMockSeenFilter creates a new mock instance
+func NewMockSeenFilter(ctrl *gomock.Controller) *MockSeenFilter {
+	mock := &MockSeenFilter{ctrl: ctrl}
+	mock.recorder = &MockSeenFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSeenFilter) EXPECT() *MockSeenFilterMockRecorder {
+	return m.recorder
+}
+
+// Seen mocks base method
+func (m *MockSeenFilter) Seen(c context.Context, inboxIRI, id *url.URL) SeenResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seen", c, inboxIRI, id)
+	ret0, _ := ret[0].(SeenResult)
+	return ret0
+}
+
+// Seen indicates an expected call of Seen
+func (mr *MockSeenFilterMockRecorder) Seen(c, inboxIRI, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seen", reflect.TypeOf((*MockSeenFilter)(nil).Seen), c, inboxIRI, id)
+}
+
+// MarkSeen mocks base method
+func (m *MockSeenFilter) MarkSeen(c context.Context, inboxIRI, id *url.URL) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MarkSeen", c, inboxIRI, id)
+}
+
+// MarkSeen indicates an expected call of MarkSeen
+func (mr *MockSeenFilterMockRecorder) MarkSeen(c, inboxIRI, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSeen", reflect.TypeOf((*MockSeenFilter)(nil).MarkSeen), c, inboxIRI, id)
+}
@@ -78,6 +78,44 @@ func (mr *MockTransportMockRecorder) BatchDeliver(c, b, recipients interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDeliver", reflect.TypeOf((*MockTransport)(nil).BatchDeliver), c, b, recipients)
 }
 
+// MockLimitedDereferencer is a mock of LimitedDereferencer interface
+type MockLimitedDereferencer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimitedDereferencerMockRecorder
+}
+
+// MockLimitedDereferencerMockRecorder is the mock recorder for MockLimitedDereferencer
+type MockLimitedDereferencerMockRecorder struct {
+	mock *MockLimitedDereferencer
+}
+
+// NewMockLimitedDereferencer creates a new mock instance
+func NewMockLimitedDereferencer(ctrl *gomock.Controller) *MockLimitedDereferencer {
+	mock := &MockLimitedDereferencer{ctrl: ctrl}
+	mock.recorder = &MockLimitedDereferencerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLimitedDereferencer) EXPECT() *MockLimitedDereferencerMockRecorder {
+	return m.recorder
+}
+
+// DereferenceLimited mocks base method
+func (m *MockLimitedDereferencer) DereferenceLimited(c context.Context, iri *url.URL, limit int64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DereferenceLimited", c, iri, limit)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DereferenceLimited indicates an expected call of DereferenceLimited
+func (mr *MockLimitedDereferencerMockRecorder) DereferenceLimited(c, iri, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DereferenceLimited", reflect.TypeOf((*MockLimitedDereferencer)(nil).DereferenceLimited), c, iri, limit)
+}
+
 // MockHttpClient is a mock of HttpClient interface
 type MockHttpClient struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,206 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"sort"
+)
+
+// Report is a moderation report about actors or objects, built from a Flag
+// activity.
+type Report struct {
+	// Id is the IRI of the Flag activity.
+	Id *url.URL
+	// Reporters are the actors that sent the Flag.
+	Reporters []*url.URL
+	// Objects are the reported actors and objects.
+	Objects []*url.URL
+	// Comment is the reason given by the reporters, if any.
+	Comment string
+	// Federated is true if the Flag was received from a federated peer,
+	// and false if it was sent by a client of this server.
+	Federated bool
+	// Flag is the Flag activity this Report is built from.
+	Flag vocab.ActivityStreamsFlag
+}
+
+// NewReport builds the Report of a Flag activity.
+//
+// Returns ErrObjectRequired if nothing is reported by the Flag.
+func NewReport(f vocab.ActivityStreamsFlag) (*Report, error) {
+	op := f.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return nil, ErrObjectRequired
+	}
+	r := &Report{Flag: f}
+	if id := f.GetJSONLDId(); id != nil {
+		r.Id = id.Get()
+	}
+	if actors := f.GetActivityStreamsActor(); actors != nil {
+		for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return nil, err
+			}
+			r.Reporters = append(r.Reporters, id)
+		}
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		id, err := ToId(iter)
+		if err != nil {
+			return nil, err
+		}
+		r.Objects = append(r.Objects, id)
+	}
+	if content := f.GetActivityStreamsContent(); content != nil {
+		for iter := content.Begin(); iter != content.End(); iter = iter.Next() {
+			if iter.IsXMLSchemaString() {
+				r.Comment = iter.GetXMLSchemaString()
+				break
+			} else if iter.IsRDFLangString() {
+				// Pick a language deterministically.
+				m := iter.GetRDFLangString()
+				langs := make([]string, 0, len(m))
+				for lang := range m {
+					langs = append(langs, lang)
+				}
+				sort.Strings(langs)
+				if len(langs) > 0 {
+					r.Comment = m[langs[0]]
+					break
+				}
+			}
+		}
+	}
+	return r, nil
+}
+
+// SendReport sends a Flag for a Report to the actor of a peer instance, such
+// as the reported actor or the instance's own actor, from the actor owning the
+// outbox.
+//
+// It follows the conventions other servers expect of forwarded reports: only
+// the reported actors and objects hosted on the peer's instance are included,
+// and the Flag is addressed only to the peer actor. The reporters of the
+// original Report are not disclosed.
+//
+// The Flag is sent through the FederatingActor, so it is given a new id, added
+// to the outbox, and delivered. The Social Protocol does not save it as a new
+// Report nor notify the moderators of it again. An EventReportSent is
+// published once it is sent.
+func SendReport(c context.Context, a FederatingActor, db Database, outboxIRI, peerActorIRI *url.URL, r *Report) (Activity, error) {
+	// Get this actor's IRI.
+	if err := db.Lock(c, outboxIRI); err != nil {
+		return nil, err
+	}
+	// WARNING: Unlock not deferred.
+	actorIRI, err := db.ActorForOutbox(c, outboxIRI)
+	if err != nil {
+		db.Unlock(c, outboxIRI)
+		return nil, err
+	}
+	db.Unlock(c, outboxIRI)
+	// Unlock must be called by now and every branch above.
+	flag := streams.NewActivityStreamsFlag()
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(actorIRI)
+	flag.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	for _, obj := range r.Objects {
+		if obj.Host == peerActorIRI.Host {
+			op.AppendIRI(obj)
+		}
+	}
+	if op.Len() == 0 {
+		return nil, fmt.Errorf("report has no objects hosted by %s", peerActorIRI.Host)
+	}
+	flag.SetActivityStreamsObject(op)
+	to := streams.NewActivityStreamsToProperty()
+	to.AppendIRI(peerActorIRI)
+	flag.SetActivityStreamsTo(to)
+	if len(r.Comment) > 0 {
+		content := streams.NewActivityStreamsContentProperty()
+		content.AppendXMLSchemaString(r.Comment)
+		flag.SetActivityStreamsContent(content)
+	}
+	activity, err := a.Send(withSentReport(c), outboxIRI, flag)
+	if err != nil {
+		return nil, err
+	}
+	publishTo(c, a, newEvent(EventReportSent, activity, nil, r.Id))
+	return activity, nil
+}
+
+// sentReportKey is the context key marking a Flag sent by SendReport.
+type sentReportKey struct{}
+
+// withSentReport returns a context marking the Flag being sent as the
+// forwarding of an existing Report.
+func withSentReport(c context.Context) context.Context {
+	return context.WithValue(c, sentReportKey{}, true)
+}
+
+// isSentReport returns true if the context marks the Flag being sent as the
+// forwarding of an existing Report.
+func isSentReport(c context.Context) bool {
+	b, _ := c.Value(sentReportKey{}).(bool)
+	return b
+}
+
+// saveReport saves the Report if the Database is a ReportDatabase, and then
+// notifies the moderators.
+func saveReport(c context.Context, db Database, r *Report, notify func(context.Context, *Report) error) error {
+	if rdb, ok := db.(ReportDatabase); ok {
+		err := func() error {
+			if r.Id != nil {
+				if err := db.Lock(c, r.Id); err != nil {
+					return err
+				}
+				defer db.Unlock(c, r.Id)
+			}
+			return rdb.CreateReport(c, r)
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	if notify != nil {
+		return notify(c, r)
+	}
+	return nil
+}
+
+// removePublicRecipients removes the Public collection from the 'to', 'cc',
+// and 'audience' properties of the activity.
+func removePublicRecipients(activity Activity) {
+	if to := activity.GetActivityStreamsTo(); to != nil {
+		for i := 0; i < to.Len(); /*Conditional*/ {
+			if id, err := ToId(to.At(i)); err == nil && IsPublic(id.String()) {
+				to.Remove(i)
+			} else {
+				i++
+			}
+		}
+	}
+	if cc := activity.GetActivityStreamsCc(); cc != nil {
+		for i := 0; i < cc.Len(); /*Conditional*/ {
+			if id, err := ToId(cc.At(i)); err == nil && IsPublic(id.String()) {
+				cc.Remove(i)
+			} else {
+				i++
+			}
+		}
+	}
+	if aud := activity.GetActivityStreamsAudience(); aud != nil {
+		for i := 0; i < aud.Len(); /*Conditional*/ {
+			if id, err := ToId(aud.At(i)); err == nil && IsPublic(id.String()) {
+				aud.Remove(i)
+			} else {
+				i++
+			}
+		}
+	}
+}
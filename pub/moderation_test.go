@@ -0,0 +1,204 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+// reportDatabase combines the mocks of the Database and the ReportDatabase.
+type reportDatabase struct {
+	*MockDatabase
+	*MockReportDatabase
+}
+
+// newTestFlag creates a Flag of a federated actor and one of its notes, with
+// a comment.
+func newTestFlag() vocab.ActivityStreamsFlag {
+	flag := streams.NewActivityStreamsFlag()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI2))
+	flag.SetJSONLDId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(mustParse(testPersonIRI))
+	flag.SetActivityStreamsActor(actor)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendIRI(mustParse(testFederatedActorIRI))
+	op.AppendIRI(mustParse(testFederatedActivityIRI))
+	op.AppendIRI(mustParse(testNoteId1))
+	flag.SetActivityStreamsObject(op)
+	content := streams.NewActivityStreamsContentProperty()
+	content.AppendXMLSchemaString("spam")
+	flag.SetActivityStreamsContent(content)
+	return flag
+}
+
+// TestNewReport ensures Reports are built from Flags.
+func TestNewReport(t *testing.T) {
+	t.Run("BuildsReport", func(t *testing.T) {
+		r, err := NewReport(newTestFlag())
+		assertEqual(t, err, nil)
+		assertEqual(t, r.Id.String(), testFederatedActivityIRI2)
+		assertEqual(t, len(r.Reporters), 1)
+		assertEqual(t, r.Reporters[0].String(), testPersonIRI)
+		assertEqual(t, len(r.Objects), 3)
+		assertEqual(t, r.Comment, "spam")
+	})
+	t.Run("ErrorIfNoObject", func(t *testing.T) {
+		_, err := NewReport(streams.NewActivityStreamsFlag())
+		assertEqual(t, err, ErrObjectRequired)
+	})
+}
+
+// TestFlag ensures received and sent Flags are turned into Reports.
+func TestFlag(t *testing.T) {
+	ctx := context.Background()
+	t.Run("FederatedFlagIsSavedAndNotified", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		rdb := NewMockReportDatabase(ctl)
+		flagId := mustParse(testFederatedActivityIRI2)
+		var notified *Report
+		w := FederatingWrappedCallbacks{
+			NotifyModerators: func(c context.Context, r *Report) error {
+				notified = r
+				return nil
+			},
+			db: reportDatabase{db, rdb},
+		}
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, flagId),
+			rdb.EXPECT().CreateReport(ctx, gomock.Any()),
+			db.EXPECT().Unlock(ctx, flagId),
+		)
+		// Run
+		err := w.flag(ctx, newTestFlag())
+		// Verify
+		assertEqual(t, err, nil)
+		assertNotEqual(t, notified, (*Report)(nil))
+		assertEqual(t, notified.Federated, true)
+	})
+	t.Run("SocialFlagIsNeverPublic", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		undeliverable := true
+		called := false
+		w := SocialWrappedCallbacks{
+			Flag: func(c context.Context, f vocab.ActivityStreamsFlag) error {
+				called = true
+				return nil
+			},
+			db:            db,
+			undeliverable: &undeliverable,
+		}
+		flag := newTestFlag()
+		to := streams.NewActivityStreamsToProperty()
+		to.AppendIRI(mustParse(PublicActivityPubIRI))
+		to.AppendIRI(mustParse(testFederatedActorIRI))
+		flag.SetActivityStreamsTo(to)
+		// Run
+		err := w.flag(ctx, flag)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, called, true)
+		assertEqual(t, undeliverable, false)
+		assertEqual(t, to.Len(), 1)
+		assertEqual(t, to.At(0).GetIRI().String(), testFederatedActorIRI)
+	})
+	t.Run("SocialFlagOfSentReportIsNotSavedAgain", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := reportDatabase{NewMockDatabase(ctl), NewMockReportDatabase(ctl)}
+		undeliverable := true
+		w := SocialWrappedCallbacks{
+			NotifyModerators: func(c context.Context, r *Report) error {
+				t.Errorf("expected no notification, got %v", r)
+				return nil
+			},
+			db:            db,
+			undeliverable: &undeliverable,
+		}
+		// Run
+		err := w.flag(withSentReport(ctx), newTestFlag())
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, undeliverable, false)
+	})
+	t.Run("SendReportOnlyIncludesPeerObjects", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		d := NewMockDelegateActor(ctl)
+		a := NewCustomActor(d, false, true, NewMockClock(ctl))
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		r, err := NewReport(newTestFlag())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sent Activity
+		var sentCtx context.Context
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+			d.EXPECT().AddNewIds(gomock.Any(), gomock.Any()).Do(func(c context.Context, a Activity) {
+				sent = a
+				sentCtx = c
+			}),
+			d.EXPECT().PostOutbox(gomock.Any(), gomock.Any(), outboxIRI, gomock.Any()).Return(true, nil),
+			d.EXPECT().Deliver(gomock.Any(), outboxIRI, gomock.Any()),
+		)
+		// Run
+		_, err = SendReport(ctx, a, db, outboxIRI, mustParse(testFederatedActorIRI2), r)
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, isSentReport(sentCtx), true)
+		op := sent.GetActivityStreamsObject()
+		assertEqual(t, op.Len(), 2)
+		assertEqual(t, op.At(0).GetIRI().String(), testFederatedActorIRI)
+		assertEqual(t, op.At(1).GetIRI().String(), testFederatedActivityIRI)
+		assertEqual(t, sent.GetActivityStreamsActor().At(0).GetIRI().String(), testMyActorIRI)
+		assertEqual(t, sent.GetActivityStreamsTo().Len(), 1)
+	})
+	t.Run("SendReportPublishesReportSent", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		d := NewMockDelegateActor(ctl)
+		es := NewMockEventSubscriber(ctl)
+		a := NewCustomActor(subscribingDelegate{d, es}, false, true, NewMockClock(ctl))
+		outboxIRI := mustParse(testMyOutboxIRI)
+		actorIRI := mustParse(testMyActorIRI)
+		r, err := NewReport(newTestFlag())
+		if err != nil {
+			t.Fatal(err)
+		}
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, outboxIRI),
+			db.EXPECT().ActorForOutbox(ctx, outboxIRI).Return(actorIRI, nil),
+			db.EXPECT().Unlock(ctx, outboxIRI),
+			d.EXPECT().AddNewIds(gomock.Any(), gomock.Any()),
+			d.EXPECT().PostOutbox(gomock.Any(), gomock.Any(), outboxIRI, gomock.Any()).Return(true, nil),
+			d.EXPECT().Deliver(gomock.Any(), outboxIRI, gomock.Any()),
+			es.EXPECT().Receive(ctx, Event{
+				Type:   EventReportSent,
+				Actor:  actorIRI,
+				Object: r.Id,
+			}),
+		)
+		// Run
+		_, err = SendReport(ctx, a, db, outboxIRI, mustParse(testFederatedActorIRI2), r)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
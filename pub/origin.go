@@ -0,0 +1,206 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// OriginCheckBehavior enumerates the different checks that the go-fed library
+// can do on the origin of the objects of a Create or Update Activity received
+// from a peer.
+type OriginCheckBehavior int
+
+const (
+	// OriginCheckDefault only requires the objects of an Update to be in the
+	// origin of the Update activity.
+	OriginCheckDefault OriginCheckBehavior = iota
+	// OriginCheckStrict requires the activity id, the 'actor', the object
+	// ids, and the objects' 'attributedTo' to all share an origin. The
+	// activity is rejected with an OriginMismatchError otherwise.
+	OriginCheckStrict
+	// OriginCheckRefetch requires the activity id and the 'actor' to share
+	// an origin. Objects that are not in this origin, or that are
+	// attributed to another origin, are dereferenced from their own id
+	// and the fetched value is stored instead of the embedded one. The
+	// activity is rejected with an OriginMismatchError if the fetched
+	// value is still not in the origin of its id.
+	OriginCheckRefetch
+)
+
+// OriginMismatchError indicates that an IRI in a federated activity is not in
+// the expected origin.
+type OriginMismatchError struct {
+	// Origin is the expected host.
+	Origin string
+	// Property is the name of the property with the mismatched IRI, such
+	// as "actor", "id", or "attributedTo".
+	Property string
+	// IRI is the mismatched IRI.
+	IRI *url.URL
+}
+
+// Error returns a description of the mismatch.
+func (e *OriginMismatchError) Error() string {
+	return fmt.Sprintf("%s %q: not in origin %q", e.Property, e.IRI, e.Origin)
+}
+
+// IsOriginMismatchError returns true if the error is an OriginMismatchError.
+func IsOriginMismatchError(err error) bool {
+	_, ok := err.(*OriginMismatchError)
+	return ok
+}
+
+// mustHaveActivityOriginMatchActors ensures that the Host in the activity id
+// IRI matches all of the Hosts in the 'actor' IRIs.
+func mustHaveActivityOriginMatchActors(a Activity) error {
+	originIRI, err := GetId(a)
+	if err != nil {
+		return err
+	}
+	actors := a.GetActivityStreamsActor()
+	if actors == nil {
+		return nil
+	}
+	for iter := actors.Begin(); iter != actors.End(); iter = iter.Next() {
+		iri, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		if originIRI.Host != iri.Host {
+			return &OriginMismatchError{
+				Origin:   originIRI.Host,
+				Property: "actor",
+				IRI:      iri,
+			}
+		}
+	}
+	return nil
+}
+
+// mustHaveObjectInOrigin ensures that the Host of the object id and of all of
+// its 'attributedTo' IRIs are the origin.
+func mustHaveObjectInOrigin(origin string, t vocab.Type) error {
+	id, err := GetId(t)
+	if err != nil {
+		return err
+	}
+	if id.Host != origin {
+		return &OriginMismatchError{
+			Origin:   origin,
+			Property: "id",
+			IRI:      id,
+		}
+	}
+	at, ok := t.(attributedToer)
+	if !ok {
+		return nil
+	}
+	attrs := at.GetActivityStreamsAttributedTo()
+	if attrs == nil {
+		return nil
+	}
+	for iter := attrs.Begin(); iter != attrs.End(); iter = iter.Next() {
+		iri, err := ToId(iter)
+		if err != nil {
+			return err
+		}
+		if iri.Host != origin {
+			return &OriginMismatchError{
+				Origin:   origin,
+				Property: "attributedTo",
+				IRI:      iri,
+			}
+		}
+	}
+	return nil
+}
+
+// verifyActivityOrigin checks the activity of a federated Create or Update
+// according to the OriginCheck behavior.
+func (w FederatingWrappedCallbacks) verifyActivityOrigin(a Activity) error {
+	switch w.OriginCheck {
+	case OriginCheckStrict, OriginCheckRefetch:
+		return mustHaveActivityOriginMatchActors(a)
+	default:
+		return nil
+	}
+}
+
+// verifyObjectOrigin checks an object of a federated Create or Update
+// according to the OriginCheck behavior, and returns the value to store.
+//
+// The fetchedFrom IRI is the IRI the object was dereferenced from, or nil if
+// the object was embedded in the activity.
+func (w FederatingWrappedCallbacks) verifyObjectOrigin(c context.Context, a Activity, t vocab.Type, fetchedFrom *url.URL) (vocab.Type, error) {
+	if w.OriginCheck != OriginCheckStrict && w.OriginCheck != OriginCheckRefetch {
+		return t, nil
+	}
+	activityId, err := GetId(a)
+	if err != nil {
+		return nil, err
+	}
+	if w.OriginCheck == OriginCheckStrict {
+		if err := mustHaveObjectInOrigin(activityId.Host, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	// OriginCheckRefetch: a dereferenced value comes from its origin, so
+	// it only needs to be consistent with it.
+	if fetchedFrom != nil {
+		if err := mustHaveObjectInOrigin(fetchedFrom.Host, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	}
+	if err := mustHaveObjectInOrigin(activityId.Host, t); err == nil {
+		return t, nil
+	} else if !IsOriginMismatchError(err) {
+		return nil, err
+	}
+	id, err := GetId(t)
+	if err != nil {
+		return nil, err
+	}
+	return w.refetch(c, id)
+}
+
+// refetch dereferences the object from its id, and ensures that the fetched
+// value is in the origin of the id.
+func (w FederatingWrappedCallbacks) refetch(c context.Context, id *url.URL) (vocab.Type, error) {
+	tport, err := w.newTransport(c, w.inboxIRI, goFedUserAgent())
+	if err != nil {
+		return nil, err
+	}
+	b, err := tport.Dereference(c, id)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	t, err := streams.ToType(c, m)
+	if err != nil {
+		return nil, err
+	}
+	fetchedId, err := GetId(t)
+	if err != nil {
+		return nil, err
+	}
+	if fetchedId.String() != id.String() {
+		return nil, &OriginMismatchError{
+			Origin:   id.Host,
+			Property: "id",
+			IRI:      fetchedId,
+		}
+	}
+	if err := mustHaveObjectInOrigin(id.Host, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,189 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+const (
+	testFederatedNoteIRI = "https://other.example.com/note/1"
+)
+
+// newOriginNote creates a Note with the given id and attributedTo.
+func newOriginNote(id, attributedTo string) vocab.ActivityStreamsNote {
+	note := streams.NewActivityStreamsNote()
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(mustParse(id))
+	note.SetJSONLDId(idProp)
+	attr := streams.NewActivityStreamsAttributedToProperty()
+	attr.AppendIRI(mustParse(attributedTo))
+	note.SetActivityStreamsAttributedTo(attr)
+	return note
+}
+
+// newOriginCreate creates a Create of the Note by the actor.
+func newOriginCreate(actor string, note vocab.ActivityStreamsNote) vocab.ActivityStreamsCreate {
+	create := streams.NewActivityStreamsCreate()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI))
+	create.SetJSONLDId(id)
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(mustParse(actor))
+	create.SetActivityStreamsActor(actorProp)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	create.SetActivityStreamsObject(op)
+	return create
+}
+
+// newOriginUpdate creates an Update of the Note by the actor.
+func newOriginUpdate(actor string, note vocab.ActivityStreamsNote) vocab.ActivityStreamsUpdate {
+	update := streams.NewActivityStreamsUpdate()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI))
+	update.SetJSONLDId(id)
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(mustParse(actor))
+	update.SetActivityStreamsActor(actorProp)
+	op := streams.NewActivityStreamsObjectProperty()
+	op.AppendActivityStreamsNote(note)
+	update.SetActivityStreamsObject(op)
+	return update
+}
+
+// TestOriginCheck ensures the origin of federated Create and Update objects is
+// verified according to the OriginCheck behavior.
+func TestOriginCheck(t *testing.T) {
+	ctx := context.Background()
+	t.Run("DefaultCreatesObjectInOtherOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		w := FederatingWrappedCallbacks{db: db}
+		note := newOriginNote(testNoteId1, testPersonIRI)
+		noteId := mustParse(testNoteId1)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Create(ctx, note),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.create(ctx, newOriginCreate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("StrictCreatesObjectInOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		w := FederatingWrappedCallbacks{db: db, OriginCheck: OriginCheckStrict}
+		note := newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)
+		noteId := mustParse(testFederatedNoteIRI)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Create(ctx, note),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.create(ctx, newOriginCreate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("StrictRejectsActorInOtherOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		w := FederatingWrappedCallbacks{db: db, OriginCheck: OriginCheckStrict}
+		note := newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)
+		// Run
+		err := w.create(ctx, newOriginCreate(testPersonIRI, note))
+		// Verify
+		assertEqual(t, IsOriginMismatchError(err), true)
+		assertEqual(t, err.(*OriginMismatchError).Property, "actor")
+	})
+	t.Run("StrictRejectsObjectInOtherOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		w := FederatingWrappedCallbacks{db: db, OriginCheck: OriginCheckStrict}
+		note := newOriginNote(testNoteId1, testFederatedActorIRI)
+		// Run
+		err := w.create(ctx, newOriginCreate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, IsOriginMismatchError(err), true)
+		assertEqual(t, err.(*OriginMismatchError).Property, "id")
+	})
+	t.Run("StrictRejectsAttributedToInOtherOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		w := FederatingWrappedCallbacks{db: db, OriginCheck: OriginCheckStrict}
+		note := newOriginNote(testFederatedNoteIRI, testPersonIRI)
+		// Run
+		err := w.update(ctx, newOriginUpdate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, IsOriginMismatchError(err), true)
+		assertEqual(t, err.(*OriginMismatchError).Property, "attributedTo")
+	})
+	t.Run("RefetchStoresValueFromOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		w := FederatingWrappedCallbacks{
+			db:          db,
+			OriginCheck: OriginCheckRefetch,
+			newTransport: func(c context.Context, a *url.URL, s string) (Transport, error) {
+				return tp, nil
+			},
+		}
+		note := newOriginNote(testNoteId1, testFederatedActorIRI)
+		fetched := newOriginNote(testNoteId1, testMyActorIRI)
+		noteId := mustParse(testNoteId1)
+		var updated vocab.Type
+		gomock.InOrder(
+			tp.EXPECT().Dereference(ctx, noteId).Return(mustSerializeToBytes(fetched), nil),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Update(ctx, gomock.Any()).Do(func(c context.Context, v vocab.Type) {
+				updated = v
+			}),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		err := w.update(ctx, newOriginUpdate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(updated), mustSerializeToBytes(fetched))
+	})
+	t.Run("RefetchRejectsValueNotInOrigin", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tp := NewMockTransport(ctl)
+		w := FederatingWrappedCallbacks{
+			db:          db,
+			OriginCheck: OriginCheckRefetch,
+			newTransport: func(c context.Context, a *url.URL, s string) (Transport, error) {
+				return tp, nil
+			},
+		}
+		note := newOriginNote(testNoteId1, testFederatedActorIRI)
+		noteId := mustParse(testNoteId1)
+		tp.EXPECT().Dereference(ctx, noteId).Return(mustSerializeToBytes(newOriginNote(testFederatedNoteIRI, testFederatedActorIRI)), nil)
+		// Run
+		err := w.create(ctx, newOriginCreate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, IsOriginMismatchError(err), true)
+	})
+}
@@ -0,0 +1,241 @@
+package pub
+
+import (
+	"context"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// fetchResult is the outcome of dereferencing an IRI.
+type fetchResult struct {
+	b   []byte
+	err error
+}
+
+// fetchCache holds the outcomes of dereferencing IRIs, keyed by IRI.
+type fetchCache map[string]fetchResult
+
+// newTransport wraps the function creating Transports so that they answer
+// dereferences from the cache, and add the ones that miss it.
+func (f fetchCache) newTransport(newTransport func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error)) func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (t Transport, err error) {
+	return func(c context.Context, actorBoxIRI *url.URL, gofedAgent string) (Transport, error) {
+		t, err := newTransport(c, actorBoxIRI, gofedAgent)
+		if err != nil {
+			return nil, err
+		}
+		return &cachingTransport{Transport: t, f: f}, nil
+	}
+}
+
+// cachingTransport is a Transport that dereferences each IRI at most once.
+type cachingTransport struct {
+	Transport
+	f fetchCache
+}
+
+// Dereference returns the cached outcome for the IRI, or else dereferences it
+// and caches the outcome.
+func (t *cachingTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	if r, ok := t.f[iri.String()]; ok {
+		return r.b, r.err
+	}
+	b, err := t.Transport.Dereference(c, iri)
+	t.f[iri.String()] = fetchResult{b: b, err: err}
+	return b, err
+}
+
+// DereferenceLimited returns the cached outcome for the IRI, or else
+// dereferences it with the limit, if the Transport is a LimitedDereferencer,
+// and caches the outcome.
+func (t *cachingTransport) DereferenceLimited(c context.Context, iri *url.URL, limit int64) ([]byte, error) {
+	if r, ok := t.f[iri.String()]; ok {
+		if r.err == nil && int64(len(r.b)) > limit {
+			return nil, ErrResponseTooLarge
+		}
+		return r.b, r.err
+	}
+	ld, ok := t.Transport.(LimitedDereferencer)
+	if !ok {
+		return t.Dereference(c, iri)
+	}
+	b, err := ld.DereferenceLimited(c, iri, limit)
+	t.f[iri.String()] = fetchResult{b: b, err: err}
+	return b, err
+}
+
+// prefetch dereferences the values that the side effects of the federated
+// activity will need, so that they are not waited on within a transaction.
+//
+// Only the side effects that are not overridden by the other callbacks are
+// considered. Failing to dereference a value is cached as well, and left for
+// the side effect to handle.
+func (w FederatingWrappedCallbacks) prefetch(c context.Context, other []interface{}, activity Activity) (fetchCache, error) {
+	f := make(fetchCache)
+	o, ok := activity.(objecter)
+	if !ok {
+		return f, nil
+	}
+	op := o.GetActivityStreamsObject()
+	if op == nil || op.Len() == 0 {
+		return f, nil
+	}
+	enableCreate := true
+	enableUpdate := true
+	enableAccept := true
+	enableAnnounce := true
+	enableUndo := true
+	for _, i := range other {
+		switch i.(type) {
+		case func(context.Context, vocab.ActivityStreamsCreate) error:
+			enableCreate = false
+		case func(context.Context, vocab.ActivityStreamsUpdate) error:
+			enableUpdate = false
+		case func(context.Context, vocab.ActivityStreamsAccept) error:
+			enableAccept = false
+		case func(context.Context, vocab.ActivityStreamsAnnounce) error:
+			enableAnnounce = false
+		case func(context.Context, vocab.ActivityStreamsUndo) error:
+			enableUndo = false
+		}
+	}
+	var tport Transport
+	transport := func() (Transport, error) {
+		if tport != nil {
+			return tport, nil
+		}
+		var err error
+		tport, err = f.newTransport(w.newTransport)(c, w.inboxIRI, goFedUserAgent())
+		return tport, err
+	}
+	if activity.VocabularyURI() != "https://www.w3.org/ns/activitystreams" {
+		return f, nil
+	}
+	switch activity.GetTypeName() {
+	case "Create":
+		if enableCreate {
+			return f, w.prefetchObjects(c, activity, op, true, transport)
+		}
+	case "Update":
+		if enableUpdate {
+			return f, w.prefetchObjects(c, activity, op, false, transport)
+		}
+	case "Accept":
+		if enableAccept {
+			return f, prefetchIRIs(c, op, transport)
+		}
+	case "Undo":
+		if enableUndo {
+			return f, prefetchIRIs(c, op, transport)
+		}
+	case "Announce":
+		if enableAnnounce && w.FetchAnnounced {
+			return f, w.prefetchAnnounced(c, op, 0, transport)
+		}
+	}
+	return f, nil
+}
+
+// prefetchIRIs dereferences the values of the 'object' property that are only
+// IRIs.
+func prefetchIRIs(c context.Context, op vocab.ActivityStreamsObjectProperty, transport func() (Transport, error)) error {
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if iter.GetType() != nil || !iter.IsIRI() {
+			continue
+		}
+		tport, err := transport()
+		if err != nil {
+			return err
+		}
+		tport.Dereference(c, iter.GetIRI())
+	}
+	return nil
+}
+
+// prefetchObjects dereferences the objects of a Create or Update that are
+// only IRIs, if the IRIs are allowed, and the embedded objects that are
+// refetched from their origin according to the OriginCheck behavior.
+func (w FederatingWrappedCallbacks) prefetchObjects(c context.Context, a Activity, op vocab.ActivityStreamsObjectProperty, allowIRIs bool, transport func() (Transport, error)) error {
+	if allowIRIs {
+		if err := prefetchIRIs(c, op, transport); err != nil {
+			return err
+		}
+	}
+	if w.OriginCheck != OriginCheckRefetch {
+		return nil
+	}
+	activityId, err := GetId(a)
+	if err != nil {
+		return nil
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil {
+			continue
+		}
+		if err := mustHaveObjectInOrigin(activityId.Host, t); !IsOriginMismatchError(err) {
+			continue
+		}
+		id, err := GetId(t)
+		if err != nil {
+			continue
+		}
+		tport, err := transport()
+		if err != nil {
+			return err
+		}
+		tport.Dereference(c, id)
+	}
+	return nil
+}
+
+// prefetchAnnounced dereferences the announced objects that fetchAnnounced
+// would, following announce-of-announce chains up to the MaxAnnounceDepth.
+func (w FederatingWrappedCallbacks) prefetchAnnounced(c context.Context, op vocab.ActivityStreamsObjectProperty, depth int, transport func() (Transport, error)) error {
+	if op == nil {
+		return nil
+	}
+	maxDepth := w.MaxAnnounceDepth
+	if maxDepth == 0 {
+		maxDepth = DefaultMaxAnnounceDepth
+	}
+	if depth >= maxDepth {
+		return nil
+	}
+	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
+		if !iter.IsIRI() {
+			continue
+		}
+		iri := iter.GetIRI()
+		fetch, err := func() (bool, error) {
+			if err := w.db.Lock(c, iri); err != nil {
+				return false, err
+			}
+			defer w.db.Unlock(c, iri)
+			if owns, err := w.db.Owns(c, iri); err != nil || owns {
+				return false, err
+			}
+			exists, err := w.db.Exists(c, iri)
+			return !exists, err
+		}()
+		if err != nil {
+			return err
+		} else if !fetch {
+			continue
+		}
+		tport, err := transport()
+		if err != nil {
+			return err
+		}
+		t, err := w.dereferenceAnnounced(c, tport, iri)
+		if err != nil || t == nil || !streams.IsOrExtendsActivityStreamsAnnounce(t) {
+			continue
+		}
+		if o, ok := t.(objecter); ok {
+			if err = w.prefetchAnnounced(c, o.GetActivityStreamsObject(), depth+1, transport); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"testing"
+)
+
+// TestPrefetch ensures the values dereferenced by the side effects of a
+// federated activity are fetched once, ahead of them.
+func TestPrefetch(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (db *MockDatabase, tp *MockTransport, w FederatingWrappedCallbacks) {
+		db = NewMockDatabase(ctl)
+		tp = NewMockTransport(ctl)
+		w = FederatingWrappedCallbacks{
+			db:          db,
+			OriginCheck: OriginCheckRefetch,
+			newTransport: func(c context.Context, a *url.URL, s string) (Transport, error) {
+				return tp, nil
+			},
+		}
+		return
+	}
+	t.Run("RefetchUsesPrefetchedValue", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db, tp, w := setupFn(ctl)
+		note := newOriginNote(testNoteId1, testFederatedActorIRI)
+		fetched := newOriginNote(testNoteId1, testMyActorIRI)
+		noteId := mustParse(testNoteId1)
+		update := newOriginUpdate(testFederatedActorIRI, note)
+		var updated vocab.Type
+		gomock.InOrder(
+			tp.EXPECT().Dereference(ctx, noteId).Return(mustSerializeToBytes(fetched), nil),
+			db.EXPECT().Lock(ctx, noteId),
+			db.EXPECT().Update(ctx, gomock.Any()).Do(func(c context.Context, v vocab.Type) {
+				updated = v
+			}),
+			db.EXPECT().Unlock(ctx, noteId),
+		)
+		// Run
+		f, err := w.prefetch(ctx, nil, update)
+		assertEqual(t, err, nil)
+		w.newTransport = f.newTransport(w.newTransport)
+		err = w.update(ctx, update)
+		// Verify
+		assertEqual(t, err, nil)
+		assertByteEqual(t, mustSerializeToBytes(updated), mustSerializeToBytes(fetched))
+	})
+	t.Run("CachesDereferenceErrors", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, tp, w := setupFn(ctl)
+		noteId := mustParse(testNoteId1)
+		create := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(noteId)
+		create.SetActivityStreamsObject(op)
+		testErr := errors.New("test error")
+		tp.EXPECT().Dereference(ctx, noteId).Return(nil, testErr)
+		// Run
+		f, err := w.prefetch(ctx, nil, create)
+		assertEqual(t, err, nil)
+		tport, err := f.newTransport(w.newTransport)(ctx, nil, goFedUserAgent())
+		assertEqual(t, err, nil)
+		_, err = tport.Dereference(ctx, noteId)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+	t.Run("SkipsOverriddenCallbacks", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, w := setupFn(ctl)
+		note := newOriginNote(testNoteId1, testFederatedActorIRI)
+		other := []interface{}{
+			func(c context.Context, a vocab.ActivityStreamsUpdate) error {
+				return nil
+			},
+		}
+		// Run
+		f, err := w.prefetch(ctx, other, newOriginUpdate(testFederatedActorIRI, note))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, len(f), 0)
+	})
+}
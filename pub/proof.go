@@ -0,0 +1,90 @@
+package pub
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"github.com/go-fed/activity/pub/integrity"
+	"net/url"
+)
+
+// ProofSigner provides the Ed25519 keys with which Data Integrity proofs are
+// created on the activities an actor delivers.
+//
+// It is optionally implemented by the CommonBehavior. When it is, every
+// activity delivered to peers carries an eddsa-jcs-2022 proof made by the key
+// of the actor of the outbox, so that peers can verify it even when it is
+// forwarded by another server.
+type ProofSigner interface {
+	// ProofKey returns the private key of the actor of the outbox, and the
+	// IRI of its public key, such as the id of the Multikey in the
+	// assertionMethod of the actor.
+	//
+	// Returning a nil key delivers the activity without a proof.
+	ProofKey(c context.Context, outboxIRI *url.URL) (key ed25519.PrivateKey, verificationMethod *url.URL, err error)
+}
+
+// ProofError indicates that the Data Integrity proofs of an activity posted to
+// an inbox are not made with the keys of the actors of the activity.
+type ProofError struct {
+	// Controller is the controller of the key of the proof, if the proof
+	// is valid.
+	Controller *url.URL
+}
+
+// Error returns a description of the mismatch.
+func (e *ProofError) Error() string {
+	return fmt.Sprintf("data integrity proof controller %q is not an actor of the activity", e.Controller)
+}
+
+// IsProofError returns true if the error is a ProofError.
+func IsProofError(err error) bool {
+	_, ok := err.(*ProofError)
+	return ok
+}
+
+// signWithProof returns a copy of the serialized activity delivered by the
+// outbox with a Data Integrity proof, if the CommonBehavior is a ProofSigner
+// with a key for the outbox, and the serialized activity unchanged otherwise.
+func (a *sideEffectActor) signWithProof(c context.Context, outboxIRI *url.URL, m map[string]interface{}) (map[string]interface{}, error) {
+	ps, ok := a.common.(ProofSigner)
+	if !ok {
+		return m, nil
+	}
+	key, verificationMethod, err := ps.ProofKey(c, outboxIRI)
+	if err != nil {
+		return nil, err
+	} else if key == nil {
+		return m, nil
+	}
+	return integrity.Sign(c, m, key, verificationMethod, a.clock.Now())
+}
+
+// verifyProof verifies the Data Integrity proofs of the serialized activity,
+// which must be made with the keys of the actors of the activity. Activities
+// without proofs are not verified.
+func verifyProof(c context.Context, r integrity.KeyResolver, m map[string]interface{}, activity Activity) error {
+	if _, ok := m["proof"]; !ok {
+		return nil
+	}
+	controllers, err := integrity.Verify(c, m, r)
+	if err != nil {
+		return err
+	}
+	actors := make(map[string]bool)
+	if prop := activity.GetActivityStreamsActor(); prop != nil {
+		for iter := prop.Begin(); iter != prop.End(); iter = iter.Next() {
+			id, err := ToId(iter)
+			if err != nil {
+				return err
+			}
+			actors[id.String()] = true
+		}
+	}
+	for _, controller := range controllers {
+		if controller == nil || !actors[controller.String()] {
+			return &ProofError{Controller: controller}
+		}
+	}
+	return nil
+}
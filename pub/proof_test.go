@@ -0,0 +1,247 @@
+package pub
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/pub/integrity"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const testVerificationMethodIRI = "https://other.example.com/dakota#ed25519-key"
+
+// signingBehavior is a CommonBehavior that also is a ProofSigner.
+type signingBehavior struct {
+	*MockCommonBehavior
+	*MockProofSigner
+}
+
+// resolvingDelegate is a DelegateActor that also is an integrity.KeyResolver.
+type resolvingDelegate struct {
+	*MockDelegateActor
+	integrity.KeyResolver
+}
+
+// testKeyResolver resolves testVerificationMethodIRI to its key, controlled by
+// its controller.
+type testKeyResolver struct {
+	key        ed25519.PublicKey
+	controller *url.URL
+	err        error
+}
+
+func (r testKeyResolver) ResolveKey(c context.Context, verificationMethod *url.URL) (ed25519.PublicKey, *url.URL, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	} else if verificationMethod.String() != testVerificationMethodIRI {
+		return nil, nil, fmt.Errorf("unknown verification method %s", verificationMethod)
+	}
+	return r.key, r.controller, nil
+}
+
+func mustEd25519Key(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pub, priv
+}
+
+// toSignedPostInboxRequest creates a new POST HTTP request with the given type
+// as the payload, with a Data Integrity proof made by the key.
+func toSignedPostInboxRequest(t *testing.T, v vocab.Type, key ed25519.PrivateKey, modify func(m map[string]interface{})) *http.Request {
+	m, err := streams.Serialize(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err = integrity.Sign(context.Background(), m, key, mustParse(testVerificationMethodIRI), now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if modify != nil {
+		modify(m)
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return toAPRequest(httptest.NewRequest("POST", testMyInboxIRI, bytes.NewBuffer(b)))
+}
+
+func TestSignWithProof(t *testing.T) {
+	ctx := context.Background()
+	pub, priv := mustEd25519Key(t)
+	t.Run("SignsWithKeyOfOutbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		ps := NewMockProofSigner(ctl)
+		cl := NewMockClock(ctl)
+		a := &sideEffectActor{
+			common: signingBehavior{NewMockCommonBehavior(ctl), ps},
+			clock:  cl,
+		}
+		m, err := streams.Serialize(testMyCreate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ps.EXPECT().ProofKey(ctx, mustParse(testMyOutboxIRI)).Return(priv, mustParse(testVerificationMethodIRI), nil)
+		cl.EXPECT().Now().Return(now())
+		// Run
+		signed, err := a.signWithProof(ctx, mustParse(testMyOutboxIRI), m)
+		// Verify
+		assertEqual(t, err, nil)
+		controllers, err := integrity.Verify(ctx, signed, testKeyResolver{key: pub, controller: mustParse(testMyActorIRI)})
+		assertEqual(t, err, nil)
+		assertEqual(t, len(controllers), 1)
+	})
+	t.Run("DoesNotSignWithoutKey", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		ps := NewMockProofSigner(ctl)
+		a := &sideEffectActor{
+			common: signingBehavior{NewMockCommonBehavior(ctl), ps},
+			clock:  NewMockClock(ctl),
+		}
+		m, err := streams.Serialize(testMyCreate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ps.EXPECT().ProofKey(ctx, mustParse(testMyOutboxIRI)).Return(nil, nil, nil)
+		// Run
+		signed, err := a.signWithProof(ctx, mustParse(testMyOutboxIRI), m)
+		// Verify
+		assertEqual(t, err, nil)
+		_, ok := signed["proof"]
+		assertEqual(t, ok, false)
+	})
+	t.Run("DoesNotSignWithoutProofSigner", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		setupData()
+		a := &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			clock:  NewMockClock(ctl),
+		}
+		m, err := streams.Serialize(testMyCreate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Run
+		signed, err := a.signWithProof(ctx, mustParse(testMyOutboxIRI), m)
+		// Verify
+		assertEqual(t, err, nil)
+		_, ok := signed["proof"]
+		assertEqual(t, ok, false)
+	})
+}
+
+func TestPostInboxVerifiesProof(t *testing.T) {
+	ctx := context.Background()
+	pub, priv := mustEd25519Key(t)
+	setupFn := func(ctl *gomock.Controller, r integrity.KeyResolver) (delegate *MockDelegateActor, a Actor) {
+		setupData()
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(
+			resolvingDelegate{delegate, r},
+			/*enableSocialProtocol=*/ true,
+			/*enableFederatedProtocol=*/ true,
+			NewMockClock(ctl))
+		return
+	}
+	t.Run("AcceptsProofOfActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, testKeyResolver{key: pub, controller: mustParse(testFederatedActorIRI)})
+		resp := httptest.NewRecorder()
+		req := toSignedPostInboxRequest(t, testCreate, priv, nil)
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, gomock.Any()).Return(false, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("AcceptsActivityWithoutProof", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, testKeyResolver{err: errors.New("unexpected resolution")})
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(testCreate))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		delegate.EXPECT().PostInboxRequestBodyHook(ctx, req, gomock.Any()).Return(ctx, nil)
+		delegate.EXPECT().AuthorizePostInbox(ctx, resp, gomock.Any()).Return(false, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusOK)
+	})
+	t.Run("RejectsProofOfOtherActor", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, testKeyResolver{key: pub, controller: mustParse(testFederatedActorIRI2)})
+		resp := httptest.NewRecorder()
+		req := toSignedPostInboxRequest(t, testCreate, priv, nil)
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("RejectsModifiedActivity", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl, testKeyResolver{key: pub, controller: mustParse(testFederatedActorIRI)})
+		resp := httptest.NewRecorder()
+		req := toSignedPostInboxRequest(t, testCreate, priv, func(m map[string]interface{}) {
+			m["actor"] = testFederatedActorIRI2
+		})
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusForbidden)
+	})
+	t.Run("ReturnsResolverError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		expectErr := errors.New("no cached key")
+		delegate, a := setupFn(ctl, testKeyResolver{err: expectErr})
+		resp := httptest.NewRecorder()
+		req := toSignedPostInboxRequest(t, testCreate, priv, nil)
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, expectErr)
+		assertEqual(t, handled, true)
+	})
+}
@@ -5,26 +5,11 @@ import (
 	"net/url"
 )
 
-// inReplyToer is an ActivityStreams type with an 'inReplyTo' property
-type inReplyToer interface {
-	GetActivityStreamsInReplyTo() vocab.ActivityStreamsInReplyToProperty
-}
-
 // objecter is an ActivityStreams type with an 'object' property
 type objecter interface {
 	GetActivityStreamsObject() vocab.ActivityStreamsObjectProperty
 }
 
-// targeter is an ActivityStreams type with a 'target' property
-type targeter interface {
-	GetActivityStreamsTarget() vocab.ActivityStreamsTargetProperty
-}
-
-// tagger is an ActivityStreams type with a 'tag' property
-type tagger interface {
-	GetActivityStreamsTag() vocab.ActivityStreamsTagProperty
-}
-
 // hrefer is an ActivityStreams type with a 'href' property
 type hrefer interface {
 	GetActivityStreamsHref() vocab.ActivityStreamsHrefProperty
@@ -42,6 +27,11 @@ type orderedItemser interface {
 	SetActivityStreamsOrderedItems(vocab.ActivityStreamsOrderedItemsProperty)
 }
 
+// firster is an ActivityStreams type with a 'first' property
+type firster interface {
+	GetActivityStreamsFirst() vocab.ActivityStreamsFirstProperty
+}
+
 // publisheder is an ActivityStreams type with a 'published' property
 type publisheder interface {
 	GetActivityStreamsPublished() vocab.ActivityStreamsPublishedProperty
@@ -87,12 +77,22 @@ type inboxer interface {
 	GetActivityStreamsInbox() vocab.ActivityStreamsInboxProperty
 }
 
+// outboxer is an ActivityStreams type with an 'outbox' property
+type outboxer interface {
+	GetActivityStreamsOutbox() vocab.ActivityStreamsOutboxProperty
+}
+
 // attributedToer is an ActivityStreams type with an 'attributedTo' property
 type attributedToer interface {
 	GetActivityStreamsAttributedTo() vocab.ActivityStreamsAttributedToProperty
 	SetActivityStreamsAttributedTo(i vocab.ActivityStreamsAttributedToProperty)
 }
 
+// replieser is an ActivityStreams type with a 'replies' property
+type replieser interface {
+	GetActivityStreamsReplies() vocab.ActivityStreamsRepliesProperty
+}
+
 // likeser is an ActivityStreams type with a 'likes' property
 type likeser interface {
 	GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty
@@ -115,3 +115,18 @@ type actorer interface {
 type appendIRIer interface {
 	AppendIRI(v *url.URL)
 }
+
+// contenter is an ActivityStreams type with a 'content' property
+type contenter interface {
+	GetActivityStreamsContent() vocab.ActivityStreamsContentProperty
+}
+
+// namer is an ActivityStreams type with a 'name' property
+type namer interface {
+	GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+}
+
+// summaryer is an ActivityStreams type with a 'summary' property
+type summaryer interface {
+	GetActivityStreamsSummary() vocab.ActivityStreamsSummaryProperty
+}
@@ -0,0 +1,243 @@
+package pub
+
+import (
+	"container/list"
+	"context"
+	"hash/fnv"
+	"math"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// SeenResult is the answer of a SeenFilter to whether an activity was already
+// added to an inbox.
+type SeenResult int
+
+const (
+	// SeenMaybe means the filter does not know, and the Database is asked.
+	SeenMaybe SeenResult = iota
+	// SeenYes means the activity is already in the inbox.
+	SeenYes
+	// SeenNo means the activity is not in the inbox.
+	SeenNo
+)
+
+// SeenFilter answers whether an activity was already added to an inbox
+// without a round trip to the Database.
+//
+// It is optionally implemented by the FederatingProtocol, such as by embedding
+// an LRUSeenFilter or a BloomSeenFilter. When it is, the library asks it
+// before calling InboxContains, or ContainsItem of a CollectionDatabase, for
+// each activity posted to an inbox, and only calls the Database when the
+// answer is SeenMaybe.
+//
+// Answering SeenYes for an activity not in the inbox drops it, and answering
+// SeenNo for an activity in the inbox adds it twice, so a SeenFilter should
+// answer SeenMaybe whenever it is unsure.
+type SeenFilter interface {
+	// Seen returns whether the activity with the id was already added to
+	// the inbox.
+	Seen(c context.Context, inboxIRI, id *url.URL) SeenResult
+	// MarkSeen records that the activity with the id is in the inbox.
+	//
+	// When the Database is a TransactionDatabase, it is called only once
+	// the transaction adding the activity commits.
+	MarkSeen(c context.Context, inboxIRI, id *url.URL)
+}
+
+// seenKey is the key of an activity in an inbox.
+func seenKey(inboxIRI, id *url.URL) string {
+	return inboxIRI.String() + " " + id.String()
+}
+
+// LRUSeenFilter is a SeenFilter remembering the most recently seen activities
+// of all inboxes.
+//
+// It answers SeenYes for the activities it remembers and SeenMaybe otherwise,
+// including the ones it forgot to make room for others, so it short-circuits
+// duplicate deliveries but never drops a new activity nor adds one twice.
+type LRUSeenFilter struct {
+	size  int
+	mu    sync.Mutex
+	order *list.List
+	keys  map[string]*list.Element
+}
+
+var _ SeenFilter = &LRUSeenFilter{}
+
+// NewLRUSeenFilter creates a LRUSeenFilter remembering at most 'size'
+// activities.
+func NewLRUSeenFilter(size int) *LRUSeenFilter {
+	return &LRUSeenFilter{
+		size:  size,
+		order: list.New(),
+		keys:  make(map[string]*list.Element, size),
+	}
+}
+
+// Seen returns SeenYes if the activity is remembered, and SeenMaybe
+// otherwise.
+func (f *LRUSeenFilter) Seen(c context.Context, inboxIRI, id *url.URL) SeenResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e, ok := f.keys[seenKey(inboxIRI, id)]
+	if !ok {
+		return SeenMaybe
+	}
+	f.order.MoveToFront(e)
+	return SeenYes
+}
+
+// MarkSeen remembers the activity, forgetting the least recently seen one if
+// the filter is full.
+func (f *LRUSeenFilter) MarkSeen(c context.Context, inboxIRI, id *url.URL) {
+	if f.size <= 0 {
+		return
+	}
+	k := seenKey(inboxIRI, id)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if e, ok := f.keys[k]; ok {
+		f.order.MoveToFront(e)
+		return
+	}
+	f.keys[k] = f.order.PushFront(k)
+	if f.order.Len() > f.size {
+		oldest := f.order.Back()
+		f.order.Remove(oldest)
+		delete(f.keys, oldest.Value.(string))
+	}
+}
+
+// BloomSeenFilter is a SeenFilter remembering the activities seen within a
+// time window in bloom filters, using a fixed amount of memory regardless of
+// the number of inboxes.
+//
+// It answers SeenMaybe for the activities it may have seen within the window,
+// letting the Database decide, since a bloom filter mistakes some new
+// activities for seen ones. It answers SeenNo for the ones it has not seen
+// within the window, so that new activities are added without asking the
+// Database. It must only be used when a delivery repeated after the window,
+// or from before the application started, is not expected, since such a
+// duplicate is added to the inbox again. Use an LRUSeenFilter otherwise.
+type BloomSeenFilter struct {
+	window time.Duration
+	m      uint64
+	k      uint64
+	clock  Clock
+	mu     sync.Mutex
+	// start is when the current generation started.
+	start time.Time
+	// current and previous are the bits of the generations. An activity
+	// seen in the current or previous generation is remembered.
+	current  []uint64
+	previous []uint64
+}
+
+var _ SeenFilter = &BloomSeenFilter{}
+
+// NewBloomSeenFilter creates a BloomSeenFilter remembering the activities seen
+// within at least the window.
+//
+// The filter is sized so that about 'capacity' activities seen within a window
+// are mistaken for seen ones at most at the false positive rate, such as
+// 0.01. Mistakes only cost a call to the Database.
+func NewBloomSeenFilter(window time.Duration, capacity int, falsePositiveRate float64, clock Clock) *BloomSeenFilter {
+	if capacity < 1 {
+		capacity = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+	// Optimal number of bits and of hashes for the capacity and rate.
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	m = (m + 63) / 64 * 64
+	k := uint64(math.Round(float64(m) / float64(capacity) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomSeenFilter{
+		window:   window,
+		m:        m,
+		k:        k,
+		clock:    clock,
+		start:    clock.Now(),
+		current:  make([]uint64, m/64),
+		previous: make([]uint64, m/64),
+	}
+}
+
+// Seen returns SeenMaybe if the activity may have been seen within the window,
+// and SeenNo otherwise.
+func (f *BloomSeenFilter) Seen(c context.Context, inboxIRI, id *url.URL) SeenResult {
+	h1, h2 := bloomHashes(seenKey(inboxIRI, id))
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rotate()
+	if f.contains(f.current, h1, h2) || f.contains(f.previous, h1, h2) {
+		return SeenMaybe
+	}
+	return SeenNo
+}
+
+// MarkSeen remembers the activity for at least the window.
+func (f *BloomSeenFilter) MarkSeen(c context.Context, inboxIRI, id *url.URL) {
+	h1, h2 := bloomHashes(seenKey(inboxIRI, id))
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.rotate()
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		f.current[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// rotate starts a new generation once the current one is a window old,
+// forgetting the previous one. Both are forgotten if two windows passed.
+//
+// Must be called with the lock held.
+func (f *BloomSeenFilter) rotate() {
+	now := f.clock.Now()
+	elapsed := now.Sub(f.start)
+	if elapsed < f.window {
+		return
+	} else if elapsed >= 2*f.window {
+		clearBits(f.previous)
+		clearBits(f.current)
+		f.start = now
+		return
+	}
+	f.previous, f.current = f.current, f.previous
+	clearBits(f.current)
+	f.start = f.start.Add(f.window)
+}
+
+// clearBits unsets all the bits.
+func clearBits(bits []uint64) {
+	for i := range bits {
+		bits[i] = 0
+	}
+}
+
+// contains returns true if all bits of the hashes are set.
+func (f *BloomSeenFilter) contains(bits []uint64, h1, h2 uint64) bool {
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % f.m
+		if bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHashes returns the two hashes of the key that the bits of a bloom
+// filter are derived from.
+func bloomHashes(key string) (h1, h2 uint64) {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	h1 = h.Sum64()
+	h.Write([]byte{0})
+	h2 = h.Sum64() | 1
+	return
+}
@@ -0,0 +1,336 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// filteringProtocol is a FederatingProtocol that also is a SeenFilter.
+type filteringProtocol struct {
+	*MockFederatingProtocol
+	*MockSeenFilter
+}
+
+func TestLRUSeenFilter(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	first := mustParse(testFederatedActivityIRI)
+	second := mustParse(testFederatedActivityIRI2)
+	third := mustParse(testNewActivityIRI)
+	t.Run("RemembersSeen", func(t *testing.T) {
+		f := NewLRUSeenFilter(2)
+		assertEqual(t, f.Seen(ctx, inboxIRI, first), SeenMaybe)
+		f.MarkSeen(ctx, inboxIRI, first)
+		assertEqual(t, f.Seen(ctx, inboxIRI, first), SeenYes)
+		assertEqual(t, f.Seen(ctx, mustParse(testMyOutboxIRI), first), SeenMaybe)
+	})
+	t.Run("ForgetsLeastRecentlySeen", func(t *testing.T) {
+		f := NewLRUSeenFilter(2)
+		f.MarkSeen(ctx, inboxIRI, first)
+		f.MarkSeen(ctx, inboxIRI, second)
+		// Seeing the first makes the second the least recently seen.
+		assertEqual(t, f.Seen(ctx, inboxIRI, first), SeenYes)
+		f.MarkSeen(ctx, inboxIRI, third)
+		assertEqual(t, f.Seen(ctx, inboxIRI, first), SeenYes)
+		// The forgotten second is left for the Database to answer.
+		assertEqual(t, f.Seen(ctx, inboxIRI, second), SeenMaybe)
+		assertEqual(t, f.Seen(ctx, inboxIRI, third), SeenYes)
+	})
+}
+
+func TestBloomSeenFilter(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	id := mustParse(testFederatedActivityIRI)
+	setupFn := func(ctl *gomock.Controller) (f *BloomSeenFilter, now *time.Time) {
+		cl := NewMockClock(ctl)
+		t := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		now = &t
+		cl.EXPECT().Now().DoAndReturn(func() time.Time { return *now }).AnyTimes()
+		f = NewBloomSeenFilter(time.Hour, 1000, 0.0001, cl)
+		return
+	}
+	t.Run("AnswersNoIfNotSeen", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		f, _ := setupFn(ctl)
+		// Run & Verify
+		assertEqual(t, f.Seen(ctx, inboxIRI, id), SeenNo)
+		f.MarkSeen(ctx, inboxIRI, id)
+		// A bloom filter may be mistaken, so the Database decides.
+		assertEqual(t, f.Seen(ctx, inboxIRI, id), SeenMaybe)
+		assertEqual(t, f.Seen(ctx, mustParse(testMyOutboxIRI), id), SeenNo)
+	})
+	t.Run("RemembersForAtLeastTheWindow", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		f, now := setupFn(ctl)
+		f.MarkSeen(ctx, inboxIRI, id)
+		// Run & Verify
+		*now = now.Add(90 * time.Minute)
+		assertEqual(t, f.Seen(ctx, inboxIRI, id), SeenMaybe)
+		*now = now.Add(time.Hour)
+		assertEqual(t, f.Seen(ctx, inboxIRI, id), SeenNo)
+	})
+	t.Run("ForgetsAfterTwoWindows", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		f, now := setupFn(ctl)
+		f.MarkSeen(ctx, inboxIRI, id)
+		// Run & Verify
+		*now = now.Add(3 * time.Hour)
+		assertEqual(t, f.Seen(ctx, inboxIRI, id), SeenNo)
+	})
+}
+
+// TestSeenFilterInbox tests adding activities to an inbox with a SeenFilter.
+func TestSeenFilterInbox(t *testing.T) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	activityIRI := mustParse(testFederatedActivityIRI)
+	setupFn := func(ctl *gomock.Controller, db Database) (fp *MockFederatingProtocol, sf *MockSeenFilter, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		sf = NewMockSeenFilter(ctl)
+		a = &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    filteringProtocol{fp, sf},
+			db:     db,
+			clock:  NewMockClock(ctl),
+		}
+		return
+	}
+	t.Run("SkipsDatabaseIfSeen", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		_, sf, a := setupFn(ctl, db)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			sf.EXPECT().Seen(ctx, inboxIRI, activityIRI).Return(SeenYes),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("SkipsInboxContainsIfNotSeen", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		fp, sf, a := setupFn(ctl, db)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			sf.EXPECT().Seen(ctx, inboxIRI, activityIRI).Return(SeenNo),
+			db.EXPECT().GetInbox(ctx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(ctx, testOrderedCollectionWithFederatedId).Return(nil),
+			sf.EXPECT().MarkSeen(ctx, inboxIRI, activityIRI),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(ctx, testListen).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("AsksDatabaseIfMaybe", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		_, sf, a := setupFn(ctl, db)
+		gomock.InOrder(
+			db.EXPECT().Lock(ctx, inboxIRI),
+			sf.EXPECT().Seen(ctx, inboxIRI, activityIRI).Return(SeenMaybe),
+			db.EXPECT().InboxContains(ctx, inboxIRI, activityIRI).Return(true, nil),
+			sf.EXPECT().MarkSeen(ctx, inboxIRI, activityIRI),
+			db.EXPECT().Unlock(ctx, inboxIRI),
+		)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("MarksSeenAfterCommit", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		db := NewMockDatabase(ctl)
+		tdb := NewMockTransactionDatabase(ctl)
+		fp, sf, a := setupFn(ctl, transactionDatabase{db, tdb})
+		tx := context.WithValue(ctx, txKey{}, "tx")
+		gomock.InOrder(
+			tdb.EXPECT().Begin(gomock.Any()).Return(tx, nil),
+			db.EXPECT().Lock(gomock.Any(), inboxIRI),
+			sf.EXPECT().Seen(gomock.Any(), inboxIRI, activityIRI).Return(SeenMaybe),
+			db.EXPECT().InboxContains(gomock.Any(), inboxIRI, activityIRI).Return(false, nil),
+			db.EXPECT().GetInbox(gomock.Any(), inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(gomock.Any(), testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(gomock.Any(), inboxIRI),
+			tdb.EXPECT().Commit(gomock.Any()),
+			sf.EXPECT().MarkSeen(ctx, inboxIRI, activityIRI),
+		)
+		fp.EXPECT().Callbacks(gomock.Any()).Return(FederatingWrappedCallbacks{}, nil, nil)
+		fp.EXPECT().DefaultCallback(gomock.Any(), testListen).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+}
+
+// benchDatabase is a CollectionDatabase holding inbox items in memory, which
+// waits for the latency of a database server on each call to read or write an
+// item, and counts those calls.
+//
+// It spins instead of sleeping, since sleeps are too coarse.
+type benchDatabase struct {
+	Database
+	CollectionDatabase
+	latency time.Duration
+	calls   int
+	mu      sync.Mutex
+	items   map[string]bool
+}
+
+func (b *benchDatabase) Lock(c context.Context, id *url.URL) error {
+	return nil
+}
+
+func (b *benchDatabase) Unlock(c context.Context, id *url.URL) error {
+	return nil
+}
+
+func (b *benchDatabase) ContainsItem(c context.Context, collection, item *url.URL) (bool, error) {
+	b.roundTrip()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.items[seenKey(collection, item)], nil
+}
+
+func (b *benchDatabase) PrependItem(c context.Context, collection, item *url.URL) error {
+	b.roundTrip()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.items[seenKey(collection, item)] = true
+	return nil
+}
+
+// roundTrip counts a call and waits for the latency.
+func (b *benchDatabase) roundTrip() {
+	b.mu.Lock()
+	b.calls++
+	b.mu.Unlock()
+	for start := time.Now(); time.Since(start) < b.latency; {
+	}
+}
+
+// benchClock is a Clock returning the current time.
+type benchClock struct{}
+
+func (benchClock) Now() time.Time {
+	return time.Now()
+}
+
+// seenFilterProtocol is a FederatingProtocol that also is a SeenFilter.
+type seenFilterProtocol struct {
+	FederatingProtocol
+	SeenFilter
+}
+
+// BenchmarkAddToInboxIfNew measures adding activities to 1000 inboxes, where
+// one in five deliveries is a duplicate of a recent one, with and without a
+// SeenFilter in front of the Database. The Database answers with the latency
+// of a server on the same host and on the same network, and the calls made to
+// it are reported as db-calls/op.
+func BenchmarkAddToInboxIfNew(b *testing.B) {
+	ctx := context.Background()
+	const inboxes = 1000
+	inboxIRIs := make([]*url.URL, inboxes)
+	for i := range inboxIRIs {
+		inboxIRIs[i] = mustParse(fmt.Sprintf("https://example.com/users/%d/inbox", i))
+	}
+	filters := []struct {
+		name   string
+		filter func() SeenFilter
+	}{
+		{"Database", nil},
+		{"LRUSeenFilter", func() SeenFilter {
+			return NewLRUSeenFilter(100000)
+		}},
+		{"BloomSeenFilter", func() SeenFilter {
+			return NewBloomSeenFilter(time.Hour, 1000000, 0.01, benchClock{})
+		}},
+	}
+	latencies := []struct {
+		name    string
+		latency time.Duration
+	}{
+		{"Local", 100 * time.Microsecond},
+		{"Network", time.Millisecond},
+	}
+	for _, l := range latencies {
+		for _, test := range filters {
+			b.Run(l.name+"/"+test.name, func(b *testing.B) {
+				db := &benchDatabase{latency: l.latency, items: make(map[string]bool)}
+				a := &sideEffectActor{db: db}
+				if test.filter != nil {
+					a.s2s = seenFilterProtocol{SeenFilter: test.filter()}
+				}
+				activities := make([]Activity, b.N)
+				boxes := make([]*url.URL, b.N)
+				for i := range activities {
+					n := i
+					if i%5 == 4 {
+						n = i - 4
+					}
+					activities[i] = newActivityWithId(fmt.Sprintf("%s/activities/%d", testFederatedActorIRI, n))
+					boxes[i] = inboxIRIs[n%inboxes]
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if _, err := a.addToInboxIfNew(ctx, boxes[i], activities[i]); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.ReportMetric(float64(db.calls)/float64(b.N), "db-calls/op")
+			})
+		}
+	}
+}
+
+func BenchmarkLRUSeenFilter(b *testing.B) {
+	benchmarkSeenFilter(b, NewLRUSeenFilter(100000))
+}
+
+func BenchmarkBloomSeenFilter(b *testing.B) {
+	benchmarkSeenFilter(b, NewBloomSeenFilter(time.Hour, 1000000, 0.01, benchClock{}))
+}
+
+// benchmarkSeenFilter measures asking the filter and then marking the
+// activity as seen.
+func benchmarkSeenFilter(b *testing.B, f SeenFilter) {
+	ctx := context.Background()
+	inboxIRI := mustParse(testMyInboxIRI)
+	ids := make([]*url.URL, b.N)
+	for i := range ids {
+		ids[i] = mustParse(fmt.Sprintf("%s/activities/%d", testFederatedActorIRI, i))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Seen(ctx, inboxIRI, ids[i])
+		f.MarkSeen(ctx, inboxIRI, ids[i])
+	}
+}
@@ -104,22 +104,69 @@ func (a *sideEffectActor) AuthorizePostInbox(c context.Context, w http.ResponseW
 // PostInbox handles the side effects of determining whether to block the peer's
 // request, adding the activity to the actor's inbox, and triggering side
 // effects based on the activity's type.
+//
+// When the database is a TransactionDatabase, it is done in a single
+// transaction. The values the side effects dereference are fetched before it
+// begins, and the activities they deliver are sent once it commits.
 func (a *sideEffectActor) PostInbox(c context.Context, inboxIRI *url.URL, activity Activity) error {
+	if _, ok := a.db.(TransactionDatabase); !ok {
+		return a.postInbox(c, inboxIRI, activity, nil)
+	}
+	wrapped, other, err := a.s2s.Callbacks(c)
+	if err != nil {
+		return err
+	}
+	wrapped.db = a.db
+	wrapped.inboxIRI = inboxIRI
+	wrapped.newTransport = a.common.NewTransport
+	// Dereference what the side effects need before the transaction
+	// begins, so that it is not held open while waiting on peers.
+	f, err := wrapped.prefetch(c, other, activity)
+	if err != nil {
+		return err
+	}
+	wrapped.newTransport = f.newTransport(a.common.NewTransport)
+	p := &inboxCallbacks{wrapped: wrapped, other: other}
+	return a.inTransaction(c, func(c context.Context) error {
+		return a.postInbox(c, inboxIRI, activity, p)
+	})
+}
+
+// inboxCallbacks are the callbacks of a federated activity obtained before
+// its transaction begins.
+type inboxCallbacks struct {
+	wrapped FederatingWrappedCallbacks
+	other   []interface{}
+}
+
+// postInbox adds the activity to the actor's inbox and triggers its side
+// effects, with the callbacks if they are not nil.
+func (a *sideEffectActor) postInbox(c context.Context, inboxIRI *url.URL, activity Activity, callbacks *inboxCallbacks) error {
 	isNew, err := a.addToInboxIfNew(c, inboxIRI, activity)
 	if err != nil {
 		return err
 	}
 	if isNew {
-		wrapped, other, err := a.s2s.Callbacks(c)
-		if err != nil {
-			return err
+		if callbacks == nil {
+			wrapped, other, err := a.s2s.Callbacks(c)
+			if err != nil {
+				return err
+			}
+			wrapped.db = a.db
+			wrapped.inboxIRI = inboxIRI
+			wrapped.newTransport = a.common.NewTransport
+			callbacks = &inboxCallbacks{wrapped: wrapped, other: other}
 		}
+		wrapped, other := callbacks.wrapped, callbacks.other
 		// Populate side channels.
-		wrapped.db = a.db
-		wrapped.inboxIRI = inboxIRI
-		wrapped.newTransport = a.common.NewTransport
-		wrapped.deliver = a.Deliver
+		wrapped.deliver = func(c context.Context, outboxIRI *url.URL, activity Activity) error {
+			return onCommit(c, func(c context.Context) error {
+				return a.Deliver(c, outboxIRI, activity)
+			})
+		}
 		wrapped.addNewIds = a.AddNewIds
+		wrapped.clock = a.clock
+		wrapped.publish = a.publish
 		res, err := streams.NewTypeResolver(wrapped.callbacks(other)...)
 		if err != nil {
 			return err
@@ -168,8 +215,14 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 		a.db.Unlock(c, id.Get())
 		return err
 	}
+	err = setFetched(c, a.db, a.clock, id.Get())
+	if err != nil {
+		a.db.Unlock(c, id.Get())
+		return err
+	}
 	a.db.Unlock(c, id.Get())
 	// Unlock by this point and in every branch above.
+	a.publish(c, newEvent(EventObjectCreated, activity, nil, id.Get()))
 	//
 	// 2. The values of 'to', 'cc', or 'audience' are Collections owned by
 	//    this server.
@@ -321,7 +374,20 @@ func (a *sideEffectActor) InboxForwarding(c context.Context, inboxIRI *url.URL,
 //
 // This implementation assumes all types are meant to be delivered except for
 // the ActivityStreams Block type.
+//
+// When the database is a TransactionDatabase, it is done in a single
+// transaction.
 func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
+	err = a.inTransaction(c, func(c context.Context) (err error) {
+		deliverable, err = a.postOutbox(c, activity, outboxIRI, rawJSON)
+		return
+	})
+	return
+}
+
+// postOutbox triggers the side effects of the activity and adds it to the
+// actor's outbox.
+func (a *sideEffectActor) postOutbox(c context.Context, activity Activity, outboxIRI *url.URL, rawJSON map[string]interface{}) (deliverable bool, err error) {
 	// TODO: Determine this if c2s is nil
 	deliverable = true
 	if a.c2s != nil {
@@ -337,6 +403,7 @@ func (a *sideEffectActor) PostOutbox(c context.Context, activity Activity, outbo
 		wrapped.rawActivity = rawJSON
 		wrapped.clock = a.clock
 		wrapped.newTransport = a.common.NewTransport
+		wrapped.publish = a.publish
 		undeliverable := false
 		wrapped.undeliverable = &undeliverable
 		var res *streams.TypeResolver
@@ -403,7 +470,17 @@ func (a *sideEffectActor) Deliver(c context.Context, outboxIRI *url.URL, activit
 	if err != nil {
 		return err
 	}
-	return a.deliverToRecipients(c, outboxIRI, activity, recipients)
+	m, err := streams.Serialize(activity)
+	if err != nil {
+		return err
+	}
+	// Forwarded activities keep the proofs of their authors, so only the
+	// activities of the outbox are signed.
+	m, err = a.signWithProof(c, outboxIRI, m)
+	if err != nil {
+		return err
+	}
+	return a.deliverSerialized(c, outboxIRI, m, recipients)
 }
 
 // WrapInCreate wraps an object with a Create activity.
@@ -430,6 +507,12 @@ func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL
 	if err != nil {
 		return err
 	}
+	return a.deliverSerialized(c, boxIRI, m, recipients)
+}
+
+// deliverSerialized sends a serialized Activity to specific recipients on
+// behalf of an actor.
+func (a *sideEffectActor) deliverSerialized(c context.Context, boxIRI *url.URL, m map[string]interface{}, recipients []*url.URL) error {
 	b, err := json.Marshal(m)
 	if err != nil {
 		return err
@@ -441,6 +524,83 @@ func (a *sideEffectActor) deliverToRecipients(c context.Context, boxIRI *url.URL
 	return tp.BatchDeliver(c, b, recipients)
 }
 
+// inTransaction calls the function with a context carrying a transaction of
+// the database, committing it if no error is returned and rolling it back
+// otherwise. The function is called with the context unchanged if the
+// database is not a TransactionDatabase.
+//
+// The Events published, the activities marked as seen, and the activities
+// delivered within the transaction are only received by the EventSubscriber,
+// SeenFilter, and peers once it commits. The first error of delivering them
+// is returned.
+func (a *sideEffectActor) inTransaction(c context.Context, fn func(c context.Context) error) error {
+	tdb, ok := a.db.(TransactionDatabase)
+	if !ok {
+		return fn(c)
+	}
+	tx, err := tdb.Begin(c)
+	if err != nil {
+		return err
+	}
+	q := &afterCommit{}
+	if err = fn(withAfterCommit(tx, q)); err != nil {
+		tdb.Rollback(tx)
+		return err
+	}
+	if err = tdb.Commit(tx); err != nil {
+		return err
+	}
+	for _, fn := range q.fns {
+		if ferr := fn(c); ferr != nil && err == nil {
+			err = ferr
+		}
+	}
+	return err
+}
+
+// afterCommitKey is the context key of the afterCommit of a transaction.
+type afterCommitKey struct{}
+
+// afterCommit holds the functions to call once a transaction commits.
+type afterCommit struct {
+	fns []func(c context.Context) error
+}
+
+// withAfterCommit returns a context holding the afterCommit.
+func withAfterCommit(c context.Context, q *afterCommit) context.Context {
+	return context.WithValue(c, afterCommitKey{}, q)
+}
+
+// afterCommitFrom returns the afterCommit held by the context, or nil.
+func afterCommitFrom(c context.Context) *afterCommit {
+	q, _ := c.Value(afterCommitKey{}).(*afterCommit)
+	return q
+}
+
+// onCommit calls the function once the transaction carried by the context
+// commits, or right away if there is none, in which case its error is
+// returned.
+func onCommit(c context.Context, fn func(c context.Context) error) error {
+	if q := afterCommitFrom(c); q != nil {
+		q.fns = append(q.fns, fn)
+		return nil
+	}
+	return fn(c)
+}
+
+// publish sends the Event to the CommonBehavior if it is an EventSubscriber,
+// once the transaction carried by the context commits.
+func (a *sideEffectActor) publish(c context.Context, e Event) {
+	s, ok := a.common.(EventSubscriber)
+	if !ok {
+		return
+	}
+	onCommit(c, func(c context.Context) error {
+		s.Receive(c, e)
+		return nil
+	})
+}
+
 // addToOutbox adds the activity to the outbox and creates the activity in the
 // internal database as its own entry.
 func (a *sideEffectActor) addToOutbox(c context.Context, outboxIRI *url.URL, activity Activity) error {
@@ -459,27 +619,37 @@ func (a *sideEffectActor) addToOutbox(c context.Context, outboxIRI *url.URL, act
 	a.db.Unlock(c, id.Get())
 	// WARNING: Unlock(c, id) should be called by this point and in every
 	// return before here.
-	//
+	a.publish(c, newEvent(EventObjectCreated, activity, nil, id.Get()))
 	// Acquire a lock to read the outbox. Defer release.
 	err = a.db.Lock(c, outboxIRI)
 	if err != nil {
 		return err
 	}
 	defer a.db.Unlock(c, outboxIRI)
-	outbox, err := a.db.GetOutbox(c, outboxIRI)
+	// Prepend only the activity when the database supports it.
+	if cdb, ok := a.db.(CollectionDatabase); ok {
+		err = cdb.PrependItem(c, outboxIRI, id.Get())
+	} else {
+		var outbox vocab.ActivityStreamsOrderedCollectionPage
+		outbox, err = a.db.GetOutbox(c, outboxIRI)
+		if err != nil {
+			return err
+		}
+		// Prepend the activity to the list of 'orderedItems'.
+		oi := outbox.GetActivityStreamsOrderedItems()
+		if oi == nil {
+			oi = streams.NewActivityStreamsOrderedItemsProperty()
+		}
+		oi.PrependIRI(id.Get())
+		outbox.SetActivityStreamsOrderedItems(oi)
+		// Save in the database.
+		err = a.db.SetOutbox(c, outbox)
+	}
 	if err != nil {
 		return err
 	}
-	// Prepend the activity to the list of 'orderedItems'.
-	oi := outbox.GetActivityStreamsOrderedItems()
-	if oi == nil {
-		oi = streams.NewActivityStreamsOrderedItemsProperty()
-	}
-	oi.PrependIRI(id.Get())
-	outbox.SetActivityStreamsOrderedItems(oi)
-	// Save in the database.
-	err = a.db.SetOutbox(c, outbox)
-	return err
+	a.publish(c, newEvent(EventOutboxItemAdded, activity, outboxIRI, id.Get()))
+	return nil
 }
 
 // addToInboxIfNew will add the activity to the inbox at the specified IRI if
@@ -487,6 +657,10 @@ func (a *sideEffectActor) addToOutbox(c context.Context, outboxIRI *url.URL, act
 //
 // It does not add the activity to this database's know federated data.
 //
+// When the FederatingProtocol is a SeenFilter, it is asked first, so the
+// database is only asked whether the inbox contains the activity when the
+// filter is unsure.
+//
 // Returns true when the activity is novel.
 func (a *sideEffectActor) addToInboxIfNew(c context.Context, inboxIRI *url.URL, activity Activity) (isNew bool, err error) {
 	// Acquire a lock to read the inbox. Defer release.
@@ -497,117 +671,173 @@ func (a *sideEffectActor) addToInboxIfNew(c context.Context, inboxIRI *url.URL,
 	defer a.db.Unlock(c, inboxIRI)
 	// Obtain the id of the activity
 	id := activity.GetJSONLDId()
-	// If the inbox already contains the URL, early exit.
-	contains, err := a.db.InboxContains(c, inboxIRI, id.Get())
-	if err != nil {
-		return
-	} else if contains {
+	filter, isFiltered := a.s2s.(SeenFilter)
+	seen := SeenMaybe
+	if isFiltered {
+		seen = filter.Seen(c, inboxIRI, id.Get())
+	}
+	if seen == SeenYes {
 		return
 	}
-	// It is a new id, acquire the inbox.
+	cdb, isCollectionDB := a.db.(CollectionDatabase)
+	// If the inbox already contains the URL, early exit.
+	if seen == SeenMaybe {
+		var contains bool
+		if isCollectionDB {
+			contains, err = cdb.ContainsItem(c, inboxIRI, id.Get())
+		} else {
+			contains, err = a.db.InboxContains(c, inboxIRI, id.Get())
+		}
+		if err != nil {
+			return
+		} else if contains {
+			if isFiltered {
+				filter.MarkSeen(c, inboxIRI, id.Get())
+			}
+			return
+		}
+	}
 	isNew = true
-	inbox, err := a.db.GetInbox(c, inboxIRI)
+	// Prepend only the activity when the database supports it.
+	if isCollectionDB {
+		err = cdb.PrependItem(c, inboxIRI, id.Get())
+	} else {
+		// It is a new id, acquire the inbox.
+		var inbox vocab.ActivityStreamsOrderedCollectionPage
+		inbox, err = a.db.GetInbox(c, inboxIRI)
+		if err != nil {
+			return
+		}
+		// Prepend the activity to the list of 'orderedItems'.
+		oi := inbox.GetActivityStreamsOrderedItems()
+		if oi == nil {
+			oi = streams.NewActivityStreamsOrderedItemsProperty()
+		}
+		oi.PrependIRI(id.Get())
+		inbox.SetActivityStreamsOrderedItems(oi)
+		// Save in the database.
+		err = a.db.SetInbox(c, inbox)
+	}
 	if err != nil {
 		return
 	}
-	// Prepend the activity to the list of 'orderedItems'.
-	oi := inbox.GetActivityStreamsOrderedItems()
-	if oi == nil {
-		oi = streams.NewActivityStreamsOrderedItemsProperty()
+	if isFiltered {
+		onCommit(c, func(c context.Context) error {
+			filter.MarkSeen(c, inboxIRI, id.Get())
+			return nil
+		})
 	}
-	oi.PrependIRI(id.Get())
-	inbox.SetActivityStreamsOrderedItems(oi)
-	// Save in the database.
-	err = a.db.SetInbox(c, inbox)
+	a.publish(c, newEvent(EventInboxItemAdded, activity, inboxIRI, id.Get()))
 	return
 }
 
 // Given an ActivityStreams value, recursively examines ownership of the id or
 // href and the ones on properties applicable to inbox forwarding.
 //
+// The embedded values are walked, and the values of IRIs are dereferenced and
+// recurred into once the embedded values next to them have been examined.
+//
 // Recursion may be limited by providing a 'maxDepth' greater than zero. A
 // value of zero or a negative number will result in infinite recursion.
 func (a *sideEffectActor) hasInboxForwardingValues(c context.Context, inboxIRI *url.URL, val vocab.Type, maxDepth, currDepth int) (bool, error) {
-	// Stop recurring if we are exceeding the maximum depth and the maximum
-	// is a positive number.
-	if maxDepth > 0 && currDepth >= maxDepth {
-		return false, nil
-	}
-	// Determine if we own the 'id' of any values on the properties we care
-	// about.
-	types, iris := getInboxForwardingValues(val)
-	// For IRIs, simply check if we own them.
-	for _, iri := range iris {
-		err := a.db.Lock(c, iri)
-		if err != nil {
-			return false, err
-		}
-		// WARNING: Unlock is not deferred
-		if owns, err := a.db.Owns(c, iri); err != nil {
-			a.db.Unlock(c, iri)
-			return false, err
-		} else if owns {
-			a.db.Unlock(c, iri)
-			return true, nil
-		}
-		a.db.Unlock(c, iri)
-		// Unlock by this point and in every branch above
-	}
-	// For embedded literals, check the id.
-	for _, val := range types {
-		id, err := GetId(val)
-		if err != nil {
-			return false, err
-		}
-		err = a.db.Lock(c, id)
-		if err != nil {
-			return false, err
-		}
-		// WARNING: Unlock is not deferred
-		if owns, err := a.db.Owns(c, id); err != nil {
-			a.db.Unlock(c, id)
-			return false, err
-		} else if owns {
-			a.db.Unlock(c, id)
-			return true, nil
-		}
-		a.db.Unlock(c, id)
-		// Unlock by this point and in every branch above
-	}
-	// Recur Preparation: Try fetching the IRIs so we can recur into them.
-	for _, iri := range iris {
-		// Dereferencing the IRI.
-		tport, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
-		if err != nil {
-			return false, err
-		}
-		b, err := tport.Dereference(c, iri)
-		if err != nil {
-			// Do not fail the entire process if the data is
-			// missing.
-			continue
-		}
-		var m map[string]interface{}
-		if err = json.Unmarshal(b, &m); err != nil {
-			return false, err
-		}
-		t, err := streams.ToType(c, m)
-		if err != nil {
-			// Do not fail the entire process if we cannot handle
-			// the type.
-			continue
-		}
-		types = append(types, t)
+	var has bool
+	// The dereferenced values of the types being walked, to recur into
+	// after their embedded values.
+	var fetched [][]vocab.Type
+	err := streams.Walk(val, streams.Walker{
+		Pre: func(p streams.Path, t vocab.Type) error {
+			if e, ok := p.Last(); ok && !isInboxForwardingProperty(e.Property) {
+				return streams.SkipChildren
+			}
+			// Stop recurring if we are exceeding the maximum depth
+			// and the maximum is a positive number.
+			if maxDepth > 0 && currDepth+len(p) >= maxDepth {
+				return streams.SkipChildren
+			}
+			// Determine if we own the 'id' of any values on the
+			// properties we care about.
+			types, iris := getInboxForwardingValues(t)
+			// For IRIs, simply check if we own them.
+			for _, iri := range iris {
+				if owns, err := a.ownsLocked(c, iri); err != nil {
+					return err
+				} else if owns {
+					has = true
+					return errInboxForwardingValueFound
+				}
+			}
+			// For embedded literals, check the id.
+			for _, val := range types {
+				id, err := GetId(val)
+				if err != nil {
+					return err
+				}
+				if owns, err := a.ownsLocked(c, id); err != nil {
+					return err
+				} else if owns {
+					has = true
+					return errInboxForwardingValueFound
+				}
+			}
+			// Recur Preparation: Try fetching the IRIs so we can
+			// recur into them.
+			var next []vocab.Type
+			for _, iri := range iris {
+				// Dereferencing the IRI.
+				tport, err := a.common.NewTransport(c, inboxIRI, goFedUserAgent())
+				if err != nil {
+					return err
+				}
+				b, err := tport.Dereference(c, iri)
+				if err != nil {
+					// Do not fail the entire process if the
+					// data is missing.
+					continue
+				}
+				var m map[string]interface{}
+				if err = json.Unmarshal(b, &m); err != nil {
+					return err
+				}
+				t, err := streams.ToType(c, m)
+				if err != nil {
+					// Do not fail the entire process if we
+					// cannot handle the type.
+					continue
+				}
+				next = append(next, t)
+			}
+			fetched = append(fetched, next)
+			return nil
+		},
+		Post: func(p streams.Path, t vocab.Type) error {
+			next := fetched[len(fetched)-1]
+			fetched = fetched[:len(fetched)-1]
+			// Recur into the dereferenced values.
+			for _, nextVal := range next {
+				if ok, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+len(p)+1); err != nil {
+					return err
+				} else if ok {
+					has = true
+					return errInboxForwardingValueFound
+				}
+			}
+			return nil
+		},
+	})
+	if has {
+		return true, nil
 	}
-	// Recur.
-	for _, nextVal := range types {
-		if has, err := a.hasInboxForwardingValues(c, inboxIRI, nextVal, maxDepth, currDepth+1); err != nil {
-			return false, err
-		} else if has {
-			return true, nil
-		}
+	return false, err
+}
+
+// ownsLocked determines whether the database owns the IRI, while holding its
+// lock.
+func (a *sideEffectActor) ownsLocked(c context.Context, iri *url.URL) (bool, error) {
+	if err := a.db.Lock(c, iri); err != nil {
+		return false, err
 	}
-	return false, nil
+	defer a.db.Unlock(c, iri)
+	return a.db.Owns(c, iri)
 }
 
 // prepare takes a deliverableObject and returns a list of the proper recipient
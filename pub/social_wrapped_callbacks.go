@@ -83,6 +83,23 @@ type SocialWrappedCallbacks struct {
 	// Note that go-fed does not federate 'Block' activities received in the
 	// Social Protocol.
 	Block func(context.Context, vocab.ActivityStreamsBlock) error
+	// Flag handles additional side effects for the Flag ActivityStreams
+	// type.
+	//
+	// The wrapping callback removes any public addressing, as reports are
+	// never public. It then builds a Report of the Flag, which is saved if
+	// the Database is a ReportDatabase, and is then passed to
+	// NotifyModerators. Flags sent by SendReport are not saved nor
+	// notified again.
+	//
+	// To send a report to the instance of a federated peer, use
+	// SendReport.
+	//
+	// Flags are not passed to DefaultCallback, even if this is nil.
+	Flag func(context.Context, vocab.ActivityStreamsFlag) error
+	// NotifyModerators is called with the Report of every Flag sent by a
+	// client, after it has been saved.
+	NotifyModerators func(context.Context, *Report) error
 
 	// Sidechannel data -- this is set at request handling time. These must
 	// be set before the callbacks are used.
@@ -105,6 +122,8 @@ type SocialWrappedCallbacks struct {
 	// Its provided default value will always be used when a custom function
 	// is called.
 	undeliverable *bool
+	// publish sends an Event to the EventSubscriber, if any.
+	publish func(c context.Context, e Event)
 }
 
 // callbacks returns the WrappedCallbacks members into a single interface slice
@@ -122,6 +141,7 @@ func (w SocialWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	enableLike := true
 	enableUndo := true
 	enableBlock := true
+	enableFlag := true
 	for _, fn := range fns {
 		switch fn.(type) {
 		default:
@@ -144,6 +164,8 @@ func (w SocialWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 			enableUndo = false
 		case func(context.Context, vocab.ActivityStreamsBlock) error:
 			enableBlock = false
+		case func(context.Context, vocab.ActivityStreamsFlag) error:
+			enableFlag = false
 		}
 	}
 	if enableCreate {
@@ -173,6 +195,9 @@ func (w SocialWrappedCallbacks) callbacks(fns []interface{}) []interface{} {
 	if enableBlock {
 		fns = append(fns, w.block)
 	}
+	if enableFlag {
+		fns = append(fns, w.flag)
+	}
 	return fns
 }
 
@@ -266,6 +291,7 @@ func (w SocialWrappedCallbacks) create(c context.Context, a vocab.ActivityStream
 		if err := w.db.Create(c, obj); err != nil {
 			return err
 		}
+		w.emit(c, EventObjectCreated, a, nil, id)
 		return nil
 	}
 	// Persist all objects we've created, which will include sensitive
@@ -338,6 +364,7 @@ func (w SocialWrappedCallbacks) update(c context.Context, a vocab.ActivityStream
 		if err = w.db.Update(c, newT); err != nil {
 			return err
 		}
+		w.emit(c, EventObjectUpdated, a, nil, loopId)
 		return nil
 	}
 	for i, id := range objIds {
@@ -383,6 +410,7 @@ func (w SocialWrappedCallbacks) deleteFn(c context.Context, a vocab.ActivityStre
 		if err := w.db.Update(c, tomb); err != nil {
 			return err
 		}
+		w.emit(c, EventObjectTombstoned, a, nil, loopId)
 		return nil
 	}
 	for i, id := range objIds {
@@ -420,7 +448,10 @@ func (w SocialWrappedCallbacks) add(c context.Context, a vocab.ActivityStreamsAd
 	if target == nil || target.Len() == 0 {
 		return ErrTargetRequired
 	}
-	if err := add(c, op, target, w.db); err != nil {
+	added := func(target, object *url.URL) {
+		w.emit(c, EventCollectionItemAdded, a, target, object)
+	}
+	if err := add(c, op, target, w.db, added); err != nil {
 		return err
 	}
 	if w.Add != nil {
@@ -440,7 +471,10 @@ func (w SocialWrappedCallbacks) remove(c context.Context, a vocab.ActivityStream
 	if target == nil || target.Len() == 0 {
 		return ErrTargetRequired
 	}
-	if err := remove(c, op, target, w.db); err != nil {
+	removed := func(target, object *url.URL) {
+		w.emit(c, EventCollectionItemRemoved, a, target, object)
+	}
+	if err := remove(c, op, target, w.db, removed); err != nil {
 		return err
 	}
 	if w.Remove != nil {
@@ -483,17 +517,26 @@ func (w SocialWrappedCallbacks) like(c context.Context, a vocab.ActivityStreamsL
 		likedItems = streams.NewActivityStreamsItemsProperty()
 		liked.SetActivityStreamsItems(likedItems)
 	}
+	var objIds []*url.URL
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		objId, err := ToId(iter)
 		if err != nil {
 			return err
 		}
 		likedItems.PrependIRI(objId)
+		objIds = append(objIds, objId)
 	}
 	err = w.db.Update(c, liked)
 	if err != nil {
 		return err
 	}
+	likedId, err := GetId(liked)
+	if err != nil {
+		return err
+	}
+	for _, objId := range objIds {
+		w.emit(c, EventLikedAdded, a, likedId, objId)
+	}
 	if w.Like != nil {
 		return w.Like(c, a)
 	}
@@ -529,3 +572,31 @@ func (w SocialWrappedCallbacks) block(c context.Context, a vocab.ActivityStreams
 	}
 	return nil
 }
+
+// flag implements the social Flag activity side effects.
+func (w SocialWrappedCallbacks) flag(c context.Context, a vocab.ActivityStreamsFlag) error {
+	*w.undeliverable = false
+	r, err := NewReport(a)
+	if err != nil {
+		return err
+	}
+	removePublicRecipients(a)
+	// A report forwarded by SendReport was already saved and notified.
+	if !isSentReport(c) {
+		if err := saveReport(c, w.db, r, w.NotifyModerators); err != nil {
+			return err
+		}
+	}
+	if w.Flag != nil {
+		return w.Flag(c, a)
+	}
+	return nil
+}
+
+// emit publishes the Event of a change to the database caused by the
+// activity.
+func (w SocialWrappedCallbacks) emit(c context.Context, t EventType, a Activity, collection, object *url.URL) {
+	if w.publish != nil {
+		w.publish(c, newEvent(t, a, collection, object))
+	}
+}
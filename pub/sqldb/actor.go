@@ -0,0 +1,108 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// CreatePerson creates a local Person with the preferred username, such as
+// "https://example.com/users/alex", and its empty inbox, outbox, followers,
+// following, liked, and pending Follow requests collections.
+func (d *Database) CreatePerson(c context.Context, username string) (vocab.ActivityStreamsPerson, error) {
+	actorIRI := &url.URL{
+		Scheme: d.scheme,
+		Host:   d.host,
+		Path:   "/users/" + username,
+	}
+	boxIRI := func(name string) *url.URL {
+		u := *actorIRI
+		u.Path += "/" + name
+		return &u
+	}
+	inboxIRI := boxIRI("inbox")
+	outboxIRI := boxIRI("outbox")
+	followersIRI := boxIRI("followers")
+	followingIRI := boxIRI("following")
+	likedIRI := boxIRI("liked")
+	requestsIRI := boxIRI("requests")
+	// Create the actor.
+	person := streams.NewActivityStreamsPerson()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(actorIRI)
+	person.SetJSONLDId(id)
+	name := streams.NewActivityStreamsPreferredUsernameProperty()
+	name.SetXMLSchemaString(username)
+	person.SetActivityStreamsPreferredUsername(name)
+	inbox := streams.NewActivityStreamsInboxProperty()
+	inbox.SetIRI(inboxIRI)
+	person.SetActivityStreamsInbox(inbox)
+	outbox := streams.NewActivityStreamsOutboxProperty()
+	outbox.SetIRI(outboxIRI)
+	person.SetActivityStreamsOutbox(outbox)
+	followers := streams.NewActivityStreamsFollowersProperty()
+	followers.SetIRI(followersIRI)
+	person.SetActivityStreamsFollowers(followers)
+	following := streams.NewActivityStreamsFollowingProperty()
+	following.SetIRI(followingIRI)
+	person.SetActivityStreamsFollowing(following)
+	liked := streams.NewActivityStreamsLikedProperty()
+	liked.SetIRI(likedIRI)
+	person.SetActivityStreamsLiked(liked)
+	// Create its collections. The items of the inbox and outbox are kept
+	// in the collection_items table instead.
+	values := []vocab.Type{
+		person,
+		newOrderedCollection(inboxIRI),
+		newOrderedCollection(outboxIRI),
+		newCollection(followersIRI),
+		newCollection(followingIRI),
+		newCollection(likedIRI),
+		newOrderedCollection(requestsIRI),
+	}
+	err := d.withTx(c, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO actors (iri, inbox, outbox, followers, following, liked, requests) VALUES (?, ?, ?, ?, ?, ?, ?)`),
+			actorIRI.String(),
+			inboxIRI.String(),
+			outboxIRI.String(),
+			followersIRI.String(),
+			followingIRI.String(),
+			likedIRI.String(),
+			requestsIRI.String())
+		if err != nil {
+			return err
+		}
+		for _, v := range values {
+			if err := d.set(c, tx, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return person, nil
+}
+
+// newCollection creates an empty Collection with the id.
+func newCollection(iri *url.URL) vocab.ActivityStreamsCollection {
+	col := streams.NewActivityStreamsCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(iri)
+	col.SetJSONLDId(id)
+	col.SetActivityStreamsItems(streams.NewActivityStreamsItemsProperty())
+	return col
+}
+
+// newOrderedCollection creates an empty OrderedCollection with the id.
+func newOrderedCollection(iri *url.URL) vocab.ActivityStreamsOrderedCollection {
+	oc := streams.NewActivityStreamsOrderedCollection()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(iri)
+	oc.SetJSONLDId(id)
+	oc.SetActivityStreamsOrderedItems(streams.NewActivityStreamsOrderedItemsProperty())
+	return oc
+}
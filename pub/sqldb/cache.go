@@ -0,0 +1,89 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"time"
+)
+
+// SetFetched records that the federated entry was fetched at the time.
+func (d *Database) SetFetched(c context.Context, id *url.URL, t time.Time) error {
+	return d.setTime(c, id, `fetched`, t)
+}
+
+// SetAccessed records that the federated entry was read at the time.
+func (d *Database) SetAccessed(c context.Context, id *url.URL, t time.Time) error {
+	return d.setTime(c, id, `accessed`, t)
+}
+
+// StaleEntries returns at most 'limit' federated entries neither fetched nor
+// accessed since the time, least recently used first. Entries never recorded
+// as fetched or accessed are the least recently used.
+func (d *Database) StaleEntries(c context.Context, since time.Time, limit int) (ids []*url.URL, err error) {
+	rows, err := d.conn(c).QueryContext(c, d.dialect.Rebind(`SELECT e.iri FROM entries e LEFT JOIN cache u ON u.iri = e.iri
+		WHERE e.host <> ? AND COALESCE(u.used, 0) < ?
+		ORDER BY COALESCE(u.used, 0), e.iri LIMIT ?`), d.host, since.UnixNano(), limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var s string
+		if err = rows.Scan(&s); err != nil {
+			return
+		}
+		var u *url.URL
+		if u, err = url.Parse(s); err != nil {
+			return
+		}
+		ids = append(ids, u)
+	}
+	err = rows.Err()
+	return
+}
+
+// IsReferenced returns true if an owned entry or the item of a local inbox or
+// outbox mentions the federated entry, or if the 'attributedTo' or 'actor' of
+// the entry is in the following collection of a local actor.
+func (d *Database) IsReferenced(c context.Context, id *url.URL) (referenced bool, err error) {
+	var n int
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM collection_items WHERE item = ?`), id.String()).Scan(&n)
+	if err != nil || n > 0 {
+		return n > 0, err
+	}
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM entry_references WHERE ref = ?`), id.String()).Scan(&n)
+	if err != nil || n > 0 {
+		return n > 0, err
+	}
+	var owner sql.NullString
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT owner FROM entries WHERE iri = ?`), id.String()).Scan(&owner)
+	if err == sql.ErrNoRows || (err == nil && !owner.Valid) {
+		return false, nil
+	} else if err != nil {
+		return
+	}
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM actors a JOIN entry_references r ON r.iri = a.following WHERE r.ref = ?`), owner.String).Scan(&n)
+	return n > 0, err
+}
+
+// setTime records the time the entry was last fetched or accessed in the
+// column, keeping the latest, and the latest of both as the time it was used.
+func (d *Database) setTime(c context.Context, id *url.URL, column string, t time.Time) error {
+	return d.withTx(c, func(tx *sql.Tx) error {
+		var used int64
+		var last sql.NullInt64
+		err := tx.QueryRowContext(c, d.dialect.Rebind(`SELECT used, `+column+` FROM cache WHERE iri = ?`), id.String()).Scan(&used, &last)
+		if err == sql.ErrNoRows {
+			_, err = tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO cache (iri, used, `+column+`) VALUES (?, ?, ?)`), id.String(), t.UnixNano(), t.UnixNano())
+			return err
+		} else if err != nil || (last.Valid && last.Int64 >= t.UnixNano()) {
+			return err
+		}
+		if used < t.UnixNano() {
+			used = t.UnixNano()
+		}
+		_, err = tx.ExecContext(c, d.dialect.Rebind(`UPDATE cache SET used = ?, `+column+` = ? WHERE iri = ?`), used, t.UnixNano(), id.String())
+		return err
+	})
+}
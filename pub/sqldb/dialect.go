@@ -0,0 +1,81 @@
+package sqldb
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// Dialect adapts the queries of the Database to a particular SQL database.
+type Dialect interface {
+	// Rebind replaces the '?' placeholders of the query with the ones
+	// used by the database.
+	Rebind(query string) string
+}
+
+// Locker is implemented by the Dialects of databases with advisory locks. The
+// Database takes the advisory lock of an IRI with them, so the processes
+// sharing the database exclude each other. Without a Locker, the Database only
+// excludes the goroutines of its own process.
+type Locker interface {
+	// LockQuery returns the query, and its arguments, blocking until the
+	// session holds the advisory lock of the key.
+	LockQuery(key string) (query string, args []interface{})
+	// UnlockQuery returns the query, and its arguments, releasing the
+	// advisory lock of the key held by the session.
+	UnlockQuery(key string) (query string, args []interface{})
+}
+
+// QuestionDialect is the Dialect of databases using '?' placeholders, such as
+// SQLite.
+type QuestionDialect struct{}
+
+// Rebind returns the query unchanged.
+func (QuestionDialect) Rebind(query string) string {
+	return query
+}
+
+// DollarDialect is the Dialect of databases using numbered '$1' placeholders,
+// such as PostgreSQL.
+type DollarDialect struct{}
+
+// Rebind replaces each '?' with '$1', '$2', and so on.
+func (DollarDialect) Rebind(query string) string {
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// PostgresDialect is the Dialect of PostgreSQL, which takes the session-level
+// advisory locks of IRIs.
+type PostgresDialect struct {
+	DollarDialect
+}
+
+// LockQuery returns the query taking the advisory lock of the key.
+func (PostgresDialect) LockQuery(key string) (string, []interface{}) {
+	return `SELECT pg_advisory_lock(?)`, []interface{}{lockId(key)}
+}
+
+// UnlockQuery returns the query releasing the advisory lock of the key.
+func (PostgresDialect) UnlockQuery(key string) (string, []interface{}) {
+	return `SELECT pg_advisory_unlock(?)`, []interface{}{lockId(key)}
+}
+
+// lockId returns the 64-bit advisory lock id of the key. Keys sharing an id
+// only wait on each other needlessly.
+func lockId(key string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return int64(binary.BigEndian.Uint64(h.Sum(nil)))
+}
@@ -0,0 +1,129 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"net/url"
+)
+
+// Lock takes a lock for the object at the specified id. It blocks until the
+// lock is taken or the context is done.
+//
+// The lock is first taken in this process. If the Dialect is a Locker, it is
+// then taken as an advisory lock of the database, on a connection kept until
+// it is released, so other processes sharing the database are excluded too.
+//
+// A lock taken with the context of a transaction from Begin is held until the
+// transaction ends. If the transaction holds other locks, the lock is waited
+// on for at most the LockTimeout, after which ErrLockTimeout is returned.
+func (d *Database) Lock(c context.Context, id *url.URL) error {
+	key := id.String()
+	t, inTx := transactionOf(c)
+	if inTx {
+		t.mu.Lock()
+		held, holding := t.held[key], len(t.held) > 0
+		t.mu.Unlock()
+		if held {
+			return nil
+		}
+		if holding {
+			return d.lockWithTimeout(c, t, key)
+		}
+	}
+	if err := d.acquire(c, key); err != nil {
+		return err
+	}
+	if inTx {
+		t.mu.Lock()
+		t.held[key] = true
+		t.mu.Unlock()
+	}
+	return nil
+}
+
+// lockWithTimeout takes the lock of the key for the transaction holding other
+// locks, waiting for at most the LockTimeout so that transactions waiting on
+// each other's locks do not wait forever.
+func (d *Database) lockWithTimeout(c context.Context, t *transaction, key string) error {
+	timeout := d.LockTimeout
+	if timeout == 0 {
+		timeout = DefaultLockTimeout
+	}
+	tc, cancel := context.WithTimeout(c, timeout)
+	defer cancel()
+	if err := d.acquire(tc, key); err != nil {
+		if c.Err() == nil && tc.Err() == context.DeadlineExceeded {
+			return ErrLockTimeout
+		}
+		return err
+	}
+	t.mu.Lock()
+	t.held[key] = true
+	t.mu.Unlock()
+	return nil
+}
+
+// Unlock makes the lock for the object at the specified id available. It does
+// nothing with the context of a transaction from Begin, whose locks are
+// released once it is committed or rolled back.
+func (d *Database) Unlock(c context.Context, id *url.URL) error {
+	if _, inTx := transactionOf(c); inTx {
+		return nil
+	}
+	return d.release(c, id.String())
+}
+
+// acquire takes the lock of the key in this process, and then its advisory
+// lock if the Dialect is a Locker.
+func (d *Database) acquire(c context.Context, key string) error {
+	if err := d.locks.Lock(c, key); err != nil {
+		return err
+	}
+	l, ok := d.dialect.(Locker)
+	if !ok {
+		return nil
+	}
+	var conn *sql.Conn
+	err := func() (err error) {
+		if conn, err = d.db.Conn(c); err != nil {
+			return
+		}
+		query, args := l.LockQuery(key)
+		if _, err = conn.ExecContext(c, d.dialect.Rebind(query), args...); err != nil {
+			conn.Close()
+		}
+		return
+	}()
+	if err != nil {
+		d.locks.Unlock(key)
+		return err
+	}
+	d.connsMu.Lock()
+	d.conns[key] = conn
+	d.connsMu.Unlock()
+	return nil
+}
+
+// release frees the advisory lock of the key, if any, and then its lock in
+// this process. The lock in this process is freed even if the advisory lock
+// cannot be released, in which case the connection holding it is discarded.
+func (d *Database) release(c context.Context, key string) error {
+	defer d.locks.Unlock(key)
+	d.connsMu.Lock()
+	conn, ok := d.conns[key]
+	delete(d.conns, key)
+	d.connsMu.Unlock()
+	if !ok {
+		return nil
+	}
+	query, args := d.dialect.(Locker).UnlockQuery(key)
+	_, err := conn.ExecContext(c, d.dialect.Rebind(query), args...)
+	if err != nil {
+		// Discard the connection rather than returning it to the pool
+		// with the lock still held.
+		conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+	}
+	conn.Close()
+	return err
+}
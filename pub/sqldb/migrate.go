@@ -0,0 +1,133 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+)
+
+// migration is a versioned change to the schema.
+type migration struct {
+	version    int
+	statements []string
+	// backfill, if not nil, fills in the data that the statements cannot,
+	// once they are applied.
+	backfill func(c context.Context, d *Database, tx *sql.Tx) error
+}
+
+// migrations are the changes to the schema, in order. Released migrations must
+// never be modified; append a new one instead.
+var migrations = []migration{
+	{
+		version: 1,
+		statements: []string{
+			`CREATE TABLE entries (
+				iri VARCHAR(2048) PRIMARY KEY,
+				host VARCHAR(255) NOT NULL,
+				owner VARCHAR(2048),
+				data TEXT NOT NULL
+			)`,
+			`CREATE INDEX entries_host ON entries (host)`,
+			`CREATE INDEX entries_owner ON entries (owner)`,
+			`CREATE TABLE entry_references (
+				iri VARCHAR(2048) NOT NULL,
+				ref VARCHAR(2048) NOT NULL,
+				PRIMARY KEY (iri, ref)
+			)`,
+			`CREATE INDEX entry_references_ref ON entry_references (ref)`,
+			`CREATE TABLE actors (
+				iri VARCHAR(2048) PRIMARY KEY,
+				inbox VARCHAR(2048) NOT NULL UNIQUE,
+				outbox VARCHAR(2048) NOT NULL UNIQUE,
+				followers VARCHAR(2048) NOT NULL,
+				following VARCHAR(2048) NOT NULL,
+				liked VARCHAR(2048) NOT NULL,
+				requests VARCHAR(2048) NOT NULL
+			)`,
+			`CREATE TABLE collection_items (
+				collection VARCHAR(2048) NOT NULL,
+				position INTEGER NOT NULL,
+				item VARCHAR(2048) NOT NULL,
+				PRIMARY KEY (collection, position)
+			)`,
+			`CREATE INDEX collection_items_item ON collection_items (collection, item)`,
+			`CREATE TABLE collection_sequences (
+				collection VARCHAR(2048) PRIMARY KEY,
+				last BIGINT NOT NULL
+			)`,
+			`CREATE TABLE reports (
+				iri VARCHAR(2048) PRIMARY KEY,
+				federated BOOLEAN NOT NULL,
+				data TEXT NOT NULL
+			)`,
+			// The used time is the later of the fetched and accessed
+			// ones, to order the stale entries by.
+			`CREATE TABLE cache (
+				iri VARCHAR(2048) PRIMARY KEY,
+				used BIGINT NOT NULL,
+				fetched BIGINT,
+				accessed BIGINT
+			)`,
+			`CREATE INDEX cache_used ON cache (used)`,
+		},
+	},
+}
+
+// Migrate applies the migrations of the schema that have not been applied
+// yet, each in its own transaction. It is safe to call on every start.
+func (d *Database) Migrate(c context.Context) error {
+	if _, err := d.db.ExecContext(c, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY
+	)`); err != nil {
+		return err
+	}
+	current, err := d.SchemaVersion(c)
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if err := d.migrate(c, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SchemaVersion returns the version of the last applied migration, or zero if
+// none is applied.
+func (d *Database) SchemaVersion(c context.Context) (version int, err error) {
+	var v sql.NullInt64
+	err = d.db.QueryRowContext(c, `SELECT MAX(version) FROM schema_migrations`).Scan(&v)
+	if err != nil {
+		return
+	}
+	version = int(v.Int64)
+	return
+}
+
+// migrate applies a single migration in a transaction.
+func (d *Database) migrate(c context.Context, m migration) error {
+	tx, err := d.db.BeginTx(c, nil)
+	if err != nil {
+		return err
+	}
+	for _, s := range m.statements {
+		if _, err = tx.ExecContext(c, s); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if m.backfill != nil {
+		if err = m.backfill(c, d, tx); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if _, err = tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO schema_migrations (version) VALUES (?)`), m.version); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,694 @@
+// Package sqldb implements a pub.Database on a database/sql database.
+//
+// Values are stored as serialized JSON. The items of inboxes and outboxes are
+// kept in an indexed table in the order they are prepended. Locks are taken as
+// advisory locks of the database when the Dialect is a Locker, such as
+// PostgresDialect, so multiple processes can share the same database.
+//
+// The application opens the *sql.DB with the driver of its choice, and calls
+// Migrate before use to create or update the schema.
+package sqldb
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/internal/locks"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the number of items in the pages returned by
+	// GetInbox and GetOutbox when PageSize is not set.
+	DefaultPageSize = 20
+	// DefaultLockTimeout is how long Lock waits within a transaction
+	// holding other locks when LockTimeout is not set.
+	DefaultLockTimeout = 10 * time.Second
+	// maxIRILength is the length of the longest IRI the schema stores.
+	maxIRILength = 2048
+)
+
+var (
+	// ErrNotFound indicates that there is no entry for an IRI.
+	ErrNotFound = errors.New("sqldb: not found")
+)
+
+// Database must implement pub.Database and its optional extensions.
+var _ pub.Database = &Database{}
+var _ pub.FollowRequestDatabase = &Database{}
+var _ pub.ReportDatabase = &Database{}
+var _ pub.ActorPurgeDatabase = &Database{}
+var _ pub.CollectionDatabase = &Database{}
+var _ pub.TransactionDatabase = &Database{}
+var _ pub.CacheDatabase = &Database{}
+
+// Database is a pub.Database for the actors of a single host, stored in a SQL
+// database.
+//
+// Entries whose IRI is on this host are owned by it, and all other entries are
+// federated data. New ids are generated on this host.
+type Database struct {
+	// PageSize is the number of items in the pages returned by GetInbox
+	// and GetOutbox. DefaultPageSize is used if zero.
+	PageSize int
+	// LockTimeout is how long Lock waits for a lock within a transaction
+	// already holding other locks, before failing with ErrLockTimeout.
+	// DefaultLockTimeout is used if zero.
+	LockTimeout time.Duration
+
+	db      *sql.DB
+	dialect Dialect
+	scheme  string
+	host    string
+	locks   *locks.Set
+	// connsMu protects conns.
+	connsMu sync.Mutex
+	// conns are the connections holding the advisory locks of IRIs.
+	conns map[string]*sql.Conn
+}
+
+// New creates a Database owning the IRIs of the host, such as "example.com",
+// using the "https" scheme.
+//
+// If the Dialect is a Locker, each lock held uses a connection of the *sql.DB,
+// so its limit of open connections must exceed the locks held at once.
+func New(db *sql.DB, dialect Dialect, host string) *Database {
+	return &Database{
+		db:      db,
+		dialect: dialect,
+		scheme:  "https",
+		host:    host,
+		locks:   locks.New(),
+		conns:   make(map[string]*sql.Conn),
+	}
+}
+
+// Host returns the host owned by the Database.
+func (d *Database) Host() string {
+	return d.host
+}
+
+// InboxContains returns true if the inbox contains the specified 'id'.
+func (d *Database) InboxContains(c context.Context, inbox, id *url.URL) (contains bool, err error) {
+	return d.ContainsItem(c, inbox, id)
+}
+
+// GetInbox returns the first page of the inbox at the specified IRI, with its
+// newest items first.
+func (d *Database) GetInbox(c context.Context, inboxIRI *url.URL) (inbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	if err = d.mustBeBox(c, `inbox`, inboxIRI); err != nil {
+		return
+	}
+	return d.firstPage(c, inboxIRI)
+}
+
+// SetInbox saves the items prepended to the page given from GetInbox.
+func (d *Database) SetInbox(c context.Context, inbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return d.setFirstPage(c, inbox)
+}
+
+// Owns returns true if the IRI is on the host of the Database.
+func (d *Database) Owns(c context.Context, id *url.URL) (owns bool, err error) {
+	return id.Host == d.host, nil
+}
+
+// ActorForOutbox fetches the actor's IRI for the given outbox IRI.
+func (d *Database) ActorForOutbox(c context.Context, outboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	return d.queryIRI(c, `SELECT iri FROM actors WHERE outbox = ?`, outboxIRI)
+}
+
+// ActorForInbox fetches the actor's IRI for the given inbox IRI.
+func (d *Database) ActorForInbox(c context.Context, inboxIRI *url.URL) (actorIRI *url.URL, err error) {
+	return d.queryIRI(c, `SELECT iri FROM actors WHERE inbox = ?`, inboxIRI)
+}
+
+// OutboxForInbox fetches the corresponding actor's outbox IRI for the actor's
+// inbox IRI.
+func (d *Database) OutboxForInbox(c context.Context, inboxIRI *url.URL) (outboxIRI *url.URL, err error) {
+	return d.queryIRI(c, `SELECT outbox FROM actors WHERE inbox = ?`, inboxIRI)
+}
+
+// Exists returns true if the database has an entry for the specified id.
+func (d *Database) Exists(c context.Context, id *url.URL) (exists bool, err error) {
+	var n int
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM entries WHERE iri = ?`), id.String()).Scan(&n)
+	exists = n > 0
+	return
+}
+
+// Get returns the database entry for the specified id.
+//
+// Returns ErrNotFound if there is no such entry.
+func (d *Database) Get(c context.Context, id *url.URL) (value vocab.Type, err error) {
+	var data string
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT data FROM entries WHERE iri = ?`), id.String()).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal([]byte(data), &m); err != nil {
+		return
+	}
+	return streams.ToType(c, m)
+}
+
+// Create adds a new entry to the database, keyed by its id.
+func (d *Database) Create(c context.Context, asType vocab.Type) error {
+	return d.withTx(c, func(tx *sql.Tx) error {
+		return d.set(c, tx, asType)
+	})
+}
+
+// Update sets an existing entry to the database based on the value's id.
+func (d *Database) Update(c context.Context, asType vocab.Type) error {
+	return d.withTx(c, func(tx *sql.Tx) error {
+		return d.set(c, tx, asType)
+	})
+}
+
+// Delete removes the entry with the given id.
+func (d *Database) Delete(c context.Context, id *url.URL) error {
+	return d.withTx(c, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(c, d.dialect.Rebind(`DELETE FROM entries WHERE iri = ?`), id.String()); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(c, d.dialect.Rebind(`DELETE FROM entry_references WHERE iri = ?`), id.String()); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(c, d.dialect.Rebind(`DELETE FROM cache WHERE iri = ?`), id.String())
+		return err
+	})
+}
+
+// GetOutbox returns the first page of the outbox at the specified IRI, with
+// its newest items first.
+func (d *Database) GetOutbox(c context.Context, outboxIRI *url.URL) (outbox vocab.ActivityStreamsOrderedCollectionPage, err error) {
+	if err = d.mustBeBox(c, `outbox`, outboxIRI); err != nil {
+		return
+	}
+	return d.firstPage(c, outboxIRI)
+}
+
+// SetOutbox saves the items prepended to the page given from GetOutbox.
+func (d *Database) SetOutbox(c context.Context, outbox vocab.ActivityStreamsOrderedCollectionPage) error {
+	return d.setFirstPage(c, outbox)
+}
+
+// NewId creates a new random IRI on the host of the Database, such as
+// "https://example.com/note/9f86d081884c7d65".
+func (d *Database) NewId(c context.Context, t vocab.Type) (id *url.URL, err error) {
+	b := make([]byte, 8)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	id = &url.URL{
+		Scheme: d.scheme,
+		Host:   d.host,
+		Path:   fmt.Sprintf("/%s/%s", strings.ToLower(t.GetTypeName()), hex.EncodeToString(b)),
+	}
+	return
+}
+
+// PrependItem adds the item to the front of the OrderedCollection at the
+// specified IRI, as a single row.
+func (d *Database) PrependItem(c context.Context, collectionIRI, item *url.URL) error {
+	return d.withTx(c, func(tx *sql.Tx) error {
+		return d.insertItems(c, tx, collectionIRI.String(), []string{item.String()})
+	})
+}
+
+// GetItems returns at most 'limit' items of the OrderedCollection at the
+// specified IRI, newest first, skipping the 'offset' newest ones.
+func (d *Database) GetItems(c context.Context, collectionIRI *url.URL, offset, limit int) (items []*url.URL, err error) {
+	rows, err := d.conn(c).QueryContext(c, d.dialect.Rebind(`SELECT item FROM collection_items WHERE collection = ? ORDER BY position DESC LIMIT ? OFFSET ?`), collectionIRI.String(), limit, offset)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var s string
+		if err = rows.Scan(&s); err != nil {
+			return
+		}
+		var u *url.URL
+		if u, err = url.Parse(s); err != nil {
+			return
+		}
+		items = append(items, u)
+	}
+	err = rows.Err()
+	return
+}
+
+// CountItems returns the number of items of the OrderedCollection at the
+// specified IRI.
+func (d *Database) CountItems(c context.Context, collectionIRI *url.URL) (n int, err error) {
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM collection_items WHERE collection = ?`), collectionIRI.String()).Scan(&n)
+	return
+}
+
+// ContainsItem returns true if the OrderedCollection at the specified IRI
+// contains the item.
+func (d *Database) ContainsItem(c context.Context, collectionIRI, item *url.URL) (contains bool, err error) {
+	var n int
+	err = d.conn(c).QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM collection_items WHERE collection = ? AND item = ?`), collectionIRI.String(), item.String()).Scan(&n)
+	contains = n > 0
+	return
+}
+
+// Followers obtains the Followers Collection of a local actor.
+func (d *Database) Followers(c context.Context, actorIRI *url.URL) (followers vocab.ActivityStreamsCollection, err error) {
+	return d.actorCollection(c, `followers`, actorIRI)
+}
+
+// Following obtains the Following Collection of a local actor.
+func (d *Database) Following(c context.Context, actorIRI *url.URL) (following vocab.ActivityStreamsCollection, err error) {
+	return d.actorCollection(c, `following`, actorIRI)
+}
+
+// Liked obtains the Liked Collection of a local actor.
+func (d *Database) Liked(c context.Context, actorIRI *url.URL) (liked vocab.ActivityStreamsCollection, err error) {
+	return d.actorCollection(c, `liked`, actorIRI)
+}
+
+// FollowRequests obtains the OrderedCollection of pending Follow requests of a
+// local actor.
+func (d *Database) FollowRequests(c context.Context, actorIRI *url.URL) (requests vocab.ActivityStreamsOrderedCollection, err error) {
+	iri, err := d.queryIRI(c, `SELECT requests FROM actors WHERE iri = ?`, actorIRI)
+	if err != nil {
+		return
+	}
+	t, err := d.Get(c, iri)
+	if err != nil {
+		return
+	}
+	requests, ok := t.(vocab.ActivityStreamsOrderedCollection)
+	if !ok {
+		err = fmt.Errorf("sqldb: %s is not an OrderedCollection: %T", iri, t)
+	}
+	return
+}
+
+// CreateReport saves a new Report. A Report with the same id as an existing one
+// replaces it.
+func (d *Database) CreateReport(c context.Context, r *pub.Report) error {
+	if r.Id == nil {
+		return fmt.Errorf("sqldb: report has no id")
+	} else if r.Flag == nil {
+		return fmt.Errorf("sqldb: report has no Flag")
+	}
+	m, err := streams.Serialize(r.Flag)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return d.withTx(c, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(c, d.dialect.Rebind(`UPDATE reports SET federated = ?, data = ? WHERE iri = ?`), r.Federated, string(b), r.Id.String())
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n > 0 {
+			return nil
+		}
+		_, err = tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO reports (iri, federated, data) VALUES (?, ?, ?)`), r.Id.String(), r.Federated, string(b))
+		return err
+	})
+}
+
+// Reports returns the saved Reports, ordered by id.
+func (d *Database) Reports(c context.Context) (reports []*pub.Report, err error) {
+	rows, err := d.conn(c).QueryContext(c, `SELECT federated, data FROM reports ORDER BY iri`)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var federated bool
+		var data string
+		if err = rows.Scan(&federated, &data); err != nil {
+			return
+		}
+		var m map[string]interface{}
+		if err = json.Unmarshal([]byte(data), &m); err != nil {
+			return
+		}
+		var t vocab.Type
+		t, err = streams.ToType(c, m)
+		if err != nil {
+			return
+		}
+		flag, ok := t.(vocab.ActivityStreamsFlag)
+		if !ok {
+			err = fmt.Errorf("sqldb: report is not a Flag: %T", t)
+			return
+		}
+		var r *pub.Report
+		r, err = pub.NewReport(flag)
+		if err != nil {
+			return
+		}
+		r.Federated = federated
+		reports = append(reports, r)
+	}
+	err = rows.Err()
+	return
+}
+
+// PurgeActor deletes the federated entries of the actor: the ones under the
+// actor's IRI, and the ones whose 'actor' or 'attributedTo' is the actor.
+// Owned entries are left, even if attributed to the actor.
+func (d *Database) PurgeActor(c context.Context, actorIRI *url.URL) error {
+	if actorIRI.Host == d.host {
+		return fmt.Errorf("sqldb: cannot purge local actor %s", actorIRI)
+	}
+	actor := actorIRI.String()
+	prefix := strings.TrimSuffix(actor, "/") + "/"
+	return d.withTx(c, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(c, d.dialect.Rebind(`DELETE FROM entries WHERE host <> ? AND (iri = ? OR owner = ? OR iri LIKE ? ESCAPE '\')`), d.host, actor, actor, escapeLike(prefix)+"%"); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(c, `DELETE FROM entry_references WHERE iri NOT IN (SELECT iri FROM entries)`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(c, `DELETE FROM cache WHERE iri NOT IN (SELECT iri FROM entries)`)
+		return err
+	})
+}
+
+// withTx calls the function in a transaction, committing it if no error is
+// returned and rolling it back otherwise. The function is called in the
+// transaction carried by the context instead, if any, which is left to the
+// caller of Begin to end.
+func (d *Database) withTx(c context.Context, fn func(tx *sql.Tx) error) error {
+	if t, ok := transactionOf(c); ok {
+		return fn(t.tx)
+	}
+	tx, err := d.db.BeginTx(c, nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// set serializes and stores the value, keyed by its id.
+func (d *Database) set(c context.Context, tx *sql.Tx, t vocab.Type) error {
+	id, err := pub.GetId(t)
+	if err != nil {
+		return err
+	}
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	var owner sql.NullString
+	if o := ownerOf(m); len(o) > 0 {
+		owner = sql.NullString{String: o, Valid: true}
+	}
+	res, err := tx.ExecContext(c, d.dialect.Rebind(`UPDATE entries SET owner = ?, data = ? WHERE iri = ?`), owner, string(b), id.String())
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		_, err = tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO entries (iri, host, owner, data) VALUES (?, ?, ?, ?)`), id.String(), id.Host, owner, string(b))
+		if err != nil {
+			return err
+		}
+	}
+	return d.setReferences(c, tx, id, m)
+}
+
+// setReferences replaces the IRIs referenced by the entry with the ones of its
+// serialized value, if the entry is owned.
+func (d *Database) setReferences(c context.Context, tx *sql.Tx, id *url.URL, m map[string]interface{}) error {
+	if _, err := tx.ExecContext(c, d.dialect.Rebind(`DELETE FROM entry_references WHERE iri = ?`), id.String()); err != nil {
+		return err
+	}
+	if id.Host != d.host {
+		return nil
+	}
+	refs := make(map[string]bool)
+	references(m, refs)
+	for ref := range refs {
+		if _, err := tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO entry_references (iri, ref) VALUES (?, ?)`), id.String(), ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryIRI runs a query selecting a single IRI by another.
+//
+// Returns ErrNotFound if there is no such row.
+func (d *Database) queryIRI(c context.Context, query string, arg *url.URL) (*url.URL, error) {
+	var s string
+	err := d.conn(c).QueryRowContext(c, d.dialect.Rebind(query), arg.String()).Scan(&s)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	return url.Parse(s)
+}
+
+// mustBeBox returns ErrNotFound if the IRI is not the box of a local actor.
+func (d *Database) mustBeBox(c context.Context, column string, iri *url.URL) error {
+	_, err := d.queryIRI(c, `SELECT iri FROM actors WHERE `+column+` = ?`, iri)
+	return err
+}
+
+// actorCollection gets a Collection of a local actor.
+func (d *Database) actorCollection(c context.Context, column string, actorIRI *url.URL) (vocab.ActivityStreamsCollection, error) {
+	iri, err := d.queryIRI(c, `SELECT `+column+` FROM actors WHERE iri = ?`, actorIRI)
+	if err != nil {
+		return nil, err
+	}
+	t, err := d.Get(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	col, ok := t.(vocab.ActivityStreamsCollection)
+	if !ok {
+		return nil, fmt.Errorf("sqldb: %s is not a Collection: %T", iri, t)
+	}
+	return col, nil
+}
+
+// pageSize returns the PageSize or its default.
+func (d *Database) pageSize() int {
+	if d.PageSize == 0 {
+		return DefaultPageSize
+	}
+	return d.PageSize
+}
+
+// firstItems returns the newest items of a collection.
+func (d *Database) firstItems(c context.Context, q querier, collection string) (items []string, err error) {
+	rows, err := q.QueryContext(c, d.dialect.Rebind(`SELECT item FROM collection_items WHERE collection = ? ORDER BY position DESC LIMIT ?`), collection, d.pageSize())
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var item string
+		if err = rows.Scan(&item); err != nil {
+			return
+		}
+		items = append(items, item)
+	}
+	err = rows.Err()
+	return
+}
+
+// firstPage returns the page of the newest items of the box.
+func (d *Database) firstPage(c context.Context, iri *url.URL) (vocab.ActivityStreamsOrderedCollectionPage, error) {
+	items, err := d.firstItems(c, d.conn(c), iri.String())
+	if err != nil {
+		return nil, err
+	}
+	page := streams.NewActivityStreamsOrderedCollectionPage()
+	id := streams.NewJSONLDIdProperty()
+	pageIRI := *iri
+	pageIRI.RawQuery = "page=true"
+	id.Set(&pageIRI)
+	page.SetJSONLDId(id)
+	partOf := streams.NewActivityStreamsPartOfProperty()
+	partOf.SetIRI(iri)
+	page.SetActivityStreamsPartOf(partOf)
+	oi := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, item := range items {
+		u, err := url.Parse(item)
+		if err != nil {
+			return nil, err
+		}
+		oi.AppendIRI(u)
+	}
+	page.SetActivityStreamsOrderedItems(oi)
+	return page, nil
+}
+
+// setFirstPage saves the items prepended to a page from firstPage, that is
+// the ones not in the collection yet. Items appearing more than once are only
+// saved once.
+func (d *Database) setFirstPage(c context.Context, page vocab.ActivityStreamsOrderedCollectionPage) error {
+	partOf := page.GetActivityStreamsPartOf()
+	if partOf == nil {
+		return fmt.Errorf("sqldb: page has no partOf")
+	}
+	iri, err := pub.ToId(partOf)
+	if err != nil {
+		return err
+	}
+	collection := iri.String()
+	var pageItems []string
+	if oi := page.GetActivityStreamsOrderedItems(); oi != nil {
+		for iter := oi.Begin(); iter != oi.End(); iter = iter.Next() {
+			id, err := pub.ToId(iter)
+			if err != nil {
+				return err
+			}
+			pageItems = append(pageItems, id.String())
+		}
+	}
+	return d.withTx(c, func(tx *sql.Tx) error {
+		var prepended []string
+		seen := make(map[string]bool, len(pageItems))
+		for _, item := range pageItems {
+			if seen[item] {
+				continue
+			}
+			seen[item] = true
+			var n int
+			if err := tx.QueryRowContext(c, d.dialect.Rebind(`SELECT COUNT(*) FROM collection_items WHERE collection = ? AND item = ?`), collection, item).Scan(&n); err != nil {
+				return err
+			} else if n == 0 {
+				prepended = append(prepended, item)
+			}
+		}
+		return d.insertItems(c, tx, collection, prepended)
+	})
+}
+
+// insertItems inserts the items before the newest item of the collection,
+// keeping their order.
+func (d *Database) insertItems(c context.Context, tx *sql.Tx, collection string, items []string) error {
+	if len(items) == 0 {
+		return nil
+	}
+	last, err := d.reservePositions(c, tx, collection, len(items))
+	if err != nil {
+		return err
+	}
+	position := last - int64(len(items))
+	// Insert the oldest first, so the newest has the highest position.
+	for i := len(items) - 1; i >= 0; i-- {
+		position++
+		if _, err := tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO collection_items (collection, position, item) VALUES (?, ?, ?)`), collection, position, items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reservePositions advances the sequence of positions of the collection by n,
+// returning the last position reserved.
+//
+// The update locks the row of the sequence until the transaction ends, so
+// concurrent transactions never reserve the same positions.
+func (d *Database) reservePositions(c context.Context, tx *sql.Tx, collection string, n int) (last int64, err error) {
+	res, err := tx.ExecContext(c, d.dialect.Rebind(`UPDATE collection_sequences SET last = last + ? WHERE collection = ?`), n, collection)
+	if err != nil {
+		return
+	}
+	if updated, err := res.RowsAffected(); err != nil {
+		return 0, err
+	} else if updated == 0 {
+		// The primary key rejects a concurrent first insert.
+		if _, err = tx.ExecContext(c, d.dialect.Rebind(`INSERT INTO collection_sequences (collection, last) VALUES (?, ?)`), collection, n); err != nil {
+			return 0, err
+		}
+	}
+	err = tx.QueryRowContext(c, d.dialect.Rebind(`SELECT last FROM collection_sequences WHERE collection = ?`), collection).Scan(&last)
+	return
+}
+
+// ownerOf returns the first 'attributedTo' or 'actor' IRI of a serialized
+// value, or an empty string.
+func ownerOf(m map[string]interface{}) string {
+	if o := firstIRI(m["attributedTo"]); len(o) > 0 {
+		return o
+	}
+	return firstIRI(m["actor"])
+}
+
+// firstIRI returns the first IRI of a serialized property value.
+func firstIRI(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case map[string]interface{}:
+		return firstIRI(t["id"])
+	case []interface{}:
+		for _, e := range t {
+			if s := firstIRI(e); len(s) > 0 {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// references adds the IRIs anywhere in the serialized value, other than its
+// JSON-LD context, to the set.
+func references(v interface{}, refs map[string]bool) {
+	switch t := v.(type) {
+	case string:
+		if len(t) > maxIRILength {
+			return
+		}
+		if u, err := url.Parse(t); err == nil && u.IsAbs() && len(u.Host) > 0 {
+			refs[t] = true
+		}
+	case map[string]interface{}:
+		for k, e := range t {
+			if k != "@context" {
+				references(e, refs)
+			}
+		}
+	case []interface{}:
+		for _, e := range t {
+			references(e, refs)
+		}
+	}
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern with '\'.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
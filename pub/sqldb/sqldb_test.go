@@ -0,0 +1,435 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"github.com/go-fed/activity/pub"
+	"github.com/go-fed/activity/pub/dbtest"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	_ "github.com/mattn/go-sqlite3"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func mustParse(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("expected equal: %v != %v", a, b)
+	}
+}
+
+// newTestDB opens a migrated Database on a new in-memory SQLite database.
+func newTestDB(t *testing.T) *Database {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Each connection to ":memory:" is a different database.
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+	d := New(db, QuestionDialect{}, "example.com")
+	if err := d.Migrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// recordingLocker is a Locker for SQLite recording the advisory locks taken
+// and released.
+type recordingLocker struct {
+	QuestionDialect
+	queries []string
+}
+
+func (r *recordingLocker) LockQuery(key string) (string, []interface{}) {
+	r.queries = append(r.queries, "lock "+key)
+	return `SELECT ?`, []interface{}{key}
+}
+
+func (r *recordingLocker) UnlockQuery(key string) (string, []interface{}) {
+	r.queries = append(r.queries, "unlock "+key)
+	return `SELECT ?`, []interface{}{key}
+}
+
+func TestDollarDialect(t *testing.T) {
+	assertEqual(t, DollarDialect{}.Rebind(`SELECT a FROM b WHERE c = ? AND d = ?`), `SELECT a FROM b WHERE c = $1 AND d = $2`)
+}
+
+func TestLockerDialects(t *testing.T) {
+	key := "https://example.com/note/1"
+	q, args := PostgresDialect{}.LockQuery(key)
+	assertEqual(t, PostgresDialect{}.Rebind(q), `SELECT pg_advisory_lock($1)`)
+	assertEqual(t, args[0], lockId(key))
+	_, args = PostgresDialect{}.UnlockQuery(key)
+	assertEqual(t, args[0], lockId(key))
+	assertEqual(t, lockId(key) == lockId(key+"/"), false)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDB(t)
+	v, err := d.SchemaVersion(ctx)
+	assertEqual(t, err, nil)
+	assertEqual(t, v, len(migrations))
+	// Migrating again applies nothing.
+	assertEqual(t, d.Migrate(ctx), nil)
+	v, err = d.SchemaVersion(ctx)
+	assertEqual(t, err, nil)
+	assertEqual(t, v, len(migrations))
+}
+
+// TestMigrateBackfills tests that a migration is applied with its backfill in
+// the same transaction.
+func TestMigrateBackfills(t *testing.T) {
+	// Setup
+	ctx := context.Background()
+	d := newTestDB(t)
+	m := migration{
+		version: len(migrations) + 1,
+		statements: []string{
+			`CREATE TABLE notes (iri VARCHAR(2048) PRIMARY KEY)`,
+		},
+		backfill: func(c context.Context, d *Database, tx *sql.Tx) error {
+			_, err := tx.ExecContext(c, `INSERT INTO notes (iri) SELECT iri FROM entries`)
+			return err
+		},
+	}
+	note := streams.NewActivityStreamsNote()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse("https://example.com/note/1"))
+	note.SetJSONLDId(id)
+	assertEqual(t, d.Create(ctx, note), nil)
+	// Run
+	assertEqual(t, d.migrate(ctx, m), nil)
+	// Verify
+	v, err := d.SchemaVersion(ctx)
+	assertEqual(t, err, nil)
+	assertEqual(t, v, m.version)
+	var n int
+	err = d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM notes`).Scan(&n)
+	assertEqual(t, err, nil)
+	assertEqual(t, n, 1)
+}
+
+// TestDatabase tests the pub.Database contracts of the SQL Database.
+func TestDatabase(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OwnsByHost", func(t *testing.T) {
+		d := newTestDB(t)
+		owns, err := d.Owns(ctx, mustParse("https://example.com/note/1"))
+		assertEqual(t, err, nil)
+		assertEqual(t, owns, true)
+		owns, err = d.Owns(ctx, mustParse("https://other.example.com/note/1"))
+		assertEqual(t, err, nil)
+		assertEqual(t, owns, false)
+	})
+	t.Run("CreateUpdateGetDelete", func(t *testing.T) {
+		d := newTestDB(t)
+		id := mustParse("https://other.example.com/note/1")
+		note := streams.NewActivityStreamsNote()
+		idProp := streams.NewJSONLDIdProperty()
+		idProp.Set(id)
+		note.SetJSONLDId(idProp)
+		assertEqual(t, d.Create(ctx, note), nil)
+		name := streams.NewActivityStreamsNameProperty()
+		name.AppendXMLSchemaString("Updated")
+		note.SetActivityStreamsName(name)
+		assertEqual(t, d.Update(ctx, note), nil)
+		got, err := d.Get(ctx, id)
+		assertEqual(t, err, nil)
+		gotNote, ok := got.(interface {
+			GetActivityStreamsName() vocab.ActivityStreamsNameProperty
+		})
+		assertEqual(t, ok, true)
+		assertEqual(t, gotNote.GetActivityStreamsName().At(0).GetXMLSchemaString(), "Updated")
+		assertEqual(t, d.Delete(ctx, id), nil)
+		exists, err := d.Exists(ctx, id)
+		assertEqual(t, err, nil)
+		assertEqual(t, exists, false)
+		_, err = d.Get(ctx, id)
+		assertEqual(t, err, ErrNotFound)
+	})
+	t.Run("InboxKeepsNewestFirst", func(t *testing.T) {
+		d := newTestDB(t)
+		p, err := d.CreatePerson(ctx, "alex")
+		assertEqual(t, err, nil)
+		inboxIRI := p.GetActivityStreamsInbox().GetIRI()
+		for _, id := range []string{"https://other.example.com/1", "https://other.example.com/2"} {
+			page, err := d.GetInbox(ctx, inboxIRI)
+			assertEqual(t, err, nil)
+			oi := page.GetActivityStreamsOrderedItems()
+			oi.PrependIRI(mustParse(id))
+			assertEqual(t, d.SetInbox(ctx, page), nil)
+		}
+		page, err := d.GetInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		oi := page.GetActivityStreamsOrderedItems()
+		assertEqual(t, oi.Len(), 2)
+		assertEqual(t, oi.At(0).GetIRI().String(), "https://other.example.com/2")
+		assertEqual(t, oi.At(1).GetIRI().String(), "https://other.example.com/1")
+		contains, err := d.InboxContains(ctx, inboxIRI, mustParse("https://other.example.com/1"))
+		assertEqual(t, err, nil)
+		assertEqual(t, contains, true)
+		contains, err = d.InboxContains(ctx, inboxIRI, mustParse("https://other.example.com/3"))
+		assertEqual(t, err, nil)
+		assertEqual(t, contains, false)
+	})
+	t.Run("SetInboxSavesOnlyNewItems", func(t *testing.T) {
+		d := newTestDB(t)
+		p, err := d.CreatePerson(ctx, "alex")
+		assertEqual(t, err, nil)
+		inboxIRI := p.GetActivityStreamsInbox().GetIRI()
+		for _, id := range []string{"https://other.example.com/1", "https://other.example.com/2"} {
+			assertEqual(t, d.PrependItem(ctx, inboxIRI, mustParse(id)), nil)
+		}
+		// The newest item saved is not on the page.
+		page, err := d.GetInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		oi := page.GetActivityStreamsOrderedItems()
+		oi.Remove(0)
+		oi.PrependIRI(mustParse("https://other.example.com/3"))
+		oi.PrependIRI(mustParse("https://other.example.com/3"))
+		assertEqual(t, d.SetInbox(ctx, page), nil)
+		n, err := d.CountItems(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, n, 3)
+		items, err := d.GetItems(ctx, inboxIRI, 0, 3)
+		assertEqual(t, err, nil)
+		assertEqual(t, items[0].String(), "https://other.example.com/3")
+		assertEqual(t, items[1].String(), "https://other.example.com/2")
+		assertEqual(t, items[2].String(), "https://other.example.com/1")
+	})
+	t.Run("OutboxPageIsLimited", func(t *testing.T) {
+		d := newTestDB(t)
+		d.PageSize = 2
+		p, err := d.CreatePerson(ctx, "alex")
+		assertEqual(t, err, nil)
+		outboxIRI := p.GetActivityStreamsOutbox().GetIRI()
+		for _, id := range []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"} {
+			page, err := d.GetOutbox(ctx, outboxIRI)
+			assertEqual(t, err, nil)
+			page.GetActivityStreamsOrderedItems().PrependIRI(mustParse(id))
+			assertEqual(t, d.SetOutbox(ctx, page), nil)
+		}
+		page, err := d.GetOutbox(ctx, outboxIRI)
+		assertEqual(t, err, nil)
+		oi := page.GetActivityStreamsOrderedItems()
+		assertEqual(t, oi.Len(), 2)
+		assertEqual(t, oi.At(0).GetIRI().String(), "https://example.com/3")
+		assertEqual(t, oi.At(1).GetIRI().String(), "https://example.com/2")
+	})
+	t.Run("GetInboxErrorIfNotAnInbox", func(t *testing.T) {
+		d := newTestDB(t)
+		_, err := d.GetInbox(ctx, mustParse("https://example.com/users/nobody/inbox"))
+		assertEqual(t, err, ErrNotFound)
+	})
+	t.Run("ActorForBoxes", func(t *testing.T) {
+		d := newTestDB(t)
+		p, err := d.CreatePerson(ctx, "alex")
+		assertEqual(t, err, nil)
+		inboxIRI := p.GetActivityStreamsInbox().GetIRI()
+		outboxIRI := p.GetActivityStreamsOutbox().GetIRI()
+		actor, err := d.ActorForInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), "https://example.com/users/alex")
+		actor, err = d.ActorForOutbox(ctx, outboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, actor.String(), "https://example.com/users/alex")
+		outbox, err := d.OutboxForInbox(ctx, inboxIRI)
+		assertEqual(t, err, nil)
+		assertEqual(t, outbox.String(), outboxIRI.String())
+		_, err = d.Followers(ctx, actor)
+		assertEqual(t, err, nil)
+		_, err = d.Following(ctx, actor)
+		assertEqual(t, err, nil)
+		_, err = d.Liked(ctx, actor)
+		assertEqual(t, err, nil)
+		_, err = d.FollowRequests(ctx, actor)
+		assertEqual(t, err, nil)
+	})
+	t.Run("LockIsExclusivePerIRI", func(t *testing.T) {
+		d := newTestDB(t)
+		id := mustParse("https://example.com/note/1")
+		counter := 0
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := d.Lock(ctx, id); err != nil {
+					t.Error(err)
+					return
+				}
+				counter++
+				d.Unlock(ctx, id)
+			}()
+		}
+		wg.Wait()
+		assertEqual(t, counter, 20)
+		assertEqual(t, d.locks.Len(), 0)
+	})
+	t.Run("LockTakesAdvisoryLock", func(t *testing.T) {
+		d := newTestDB(t)
+		l := &recordingLocker{}
+		d.dialect = l
+		id := mustParse("https://example.com/note/1")
+		assertEqual(t, d.Lock(ctx, id), nil)
+		assertEqual(t, len(d.conns), 1)
+		assertEqual(t, d.Unlock(ctx, id), nil)
+		assertEqual(t, len(d.conns), 0)
+		assertEqual(t, len(l.queries), 2)
+		assertEqual(t, l.queries[0], "lock https://example.com/note/1")
+		assertEqual(t, l.queries[1], "unlock https://example.com/note/1")
+		// Unlocking again releases nothing.
+		assertEqual(t, d.Unlock(ctx, id), nil)
+		assertEqual(t, len(l.queries), 2)
+	})
+	t.Run("TransactionHoldsLocksUntilItEnds", func(t *testing.T) {
+		d := newTestDB(t)
+		id := mustParse("https://example.com/note/1")
+		for _, end := range []func(context.Context) error{d.Commit, d.Rollback} {
+			tx, err := d.Begin(ctx)
+			assertEqual(t, err, nil)
+			assertEqual(t, d.Lock(tx, id), nil)
+			assertEqual(t, d.Unlock(tx, id), nil)
+			// Locking again within the transaction does not block.
+			assertEqual(t, d.Lock(tx, id), nil)
+			assertEqual(t, d.Unlock(tx, id), nil)
+			c, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			assertEqual(t, d.Lock(c, id), context.DeadlineExceeded)
+			cancel()
+			assertEqual(t, end(tx), nil)
+			assertEqual(t, d.Lock(ctx, id), nil)
+			assertEqual(t, d.Unlock(ctx, id), nil)
+			assertEqual(t, d.locks.Len(), 0)
+		}
+	})
+	t.Run("TransactionsTakingLocksInAnotherOrderDoNotWaitForever", func(t *testing.T) {
+		// Two transactions need two connections to the same database.
+		db, err := sql.Open("sqlite3", "file:locks?mode=memory&cache=shared")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		d := New(db, QuestionDialect{}, "example.com")
+		assertEqual(t, d.Migrate(ctx), nil)
+		d.LockTimeout = 20 * time.Millisecond
+		id1 := mustParse("https://example.com/note/1")
+		id2 := mustParse("https://example.com/note/2")
+		tx1, err := d.Begin(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, d.Lock(tx1, id1), nil)
+		tx2, err := d.Begin(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, d.Lock(tx2, id2), nil)
+		// The second transaction waits on the first while holding the
+		// lock the first needs.
+		assertEqual(t, d.Lock(tx2, id1), ErrLockTimeout)
+		assertEqual(t, d.Rollback(tx2), nil)
+		assertEqual(t, d.Lock(tx1, id2), nil)
+		assertEqual(t, d.Commit(tx1), nil)
+		assertEqual(t, d.locks.Len(), 0)
+	})
+	t.Run("CommitErrorWithoutTransaction", func(t *testing.T) {
+		d := newTestDB(t)
+		assertEqual(t, d.Commit(ctx), ErrNoTransaction)
+		assertEqual(t, d.Rollback(ctx), ErrNoTransaction)
+	})
+	t.Run("PurgeActorDeletesItsEntries", func(t *testing.T) {
+		d := newTestDB(t)
+		actor := mustParse("https://other.example.com/users/sam")
+		note := streams.NewActivityStreamsNote()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse("https://other.example.com/note/1"))
+		note.SetJSONLDId(id)
+		attr := streams.NewActivityStreamsAttributedToProperty()
+		attr.AppendIRI(actor)
+		note.SetActivityStreamsAttributedTo(attr)
+		under := streams.NewActivityStreamsNote()
+		underId := streams.NewJSONLDIdProperty()
+		underId.Set(mustParse("https://other.example.com/users/sam/statuses/1"))
+		under.SetJSONLDId(underId)
+		other := streams.NewActivityStreamsNote()
+		otherId := streams.NewJSONLDIdProperty()
+		otherId.Set(mustParse("https://other.example.com/users/sammy/statuses/1"))
+		other.SetJSONLDId(otherId)
+		assertEqual(t, d.Create(ctx, note), nil)
+		assertEqual(t, d.Create(ctx, under), nil)
+		assertEqual(t, d.Create(ctx, other), nil)
+		assertEqual(t, d.PurgeActor(ctx, actor), nil)
+		exists, _ := d.Exists(ctx, id.Get())
+		assertEqual(t, exists, false)
+		exists, _ = d.Exists(ctx, underId.Get())
+		assertEqual(t, exists, false)
+		exists, _ = d.Exists(ctx, otherId.Get())
+		assertEqual(t, exists, true)
+		var orphans int
+		err := d.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM entry_references WHERE iri NOT IN (SELECT iri FROM entries)`).Scan(&orphans)
+		assertEqual(t, err, nil)
+		assertEqual(t, orphans, 0)
+	})
+	t.Run("CreateReportReplacesSameId", func(t *testing.T) {
+		d := newTestDB(t)
+		flag := streams.NewActivityStreamsFlag()
+		id := streams.NewJSONLDIdProperty()
+		id.Set(mustParse("https://other.example.com/flag/1"))
+		flag.SetJSONLDId(id)
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendIRI(mustParse("https://example.com/users/alex"))
+		flag.SetActivityStreamsObject(op)
+		r, err := pub.NewReport(flag)
+		assertEqual(t, err, nil)
+		assertEqual(t, d.CreateReport(ctx, r), nil)
+		r.Federated = true
+		assertEqual(t, d.CreateReport(ctx, r), nil)
+		reports, err := d.Reports(ctx)
+		assertEqual(t, err, nil)
+		assertEqual(t, len(reports), 1)
+		assertEqual(t, reports[0].Id.String(), "https://other.example.com/flag/1")
+		assertEqual(t, reports[0].Federated, true)
+	})
+}
+
+// TestCacheTimes tests that the times an entry was fetched and accessed are
+// kept apart.
+func TestCacheTimes(t *testing.T) {
+	ctx := context.Background()
+	d := newTestDB(t)
+	id := mustParse("https://other.example.com/note/1")
+	assertEqual(t, d.SetFetched(ctx, id, time.Unix(0, 2)), nil)
+	assertEqual(t, d.SetAccessed(ctx, id, time.Unix(0, 3)), nil)
+	assertEqual(t, d.SetFetched(ctx, id, time.Unix(0, 1)), nil)
+	var used, fetched, accessed int64
+	err := d.db.QueryRowContext(ctx, `SELECT used, fetched, accessed FROM cache WHERE iri = ?`, id.String()).Scan(&used, &fetched, &accessed)
+	assertEqual(t, err, nil)
+	assertEqual(t, used, int64(3))
+	assertEqual(t, fetched, int64(2))
+	assertEqual(t, accessed, int64(3))
+}
+
+// TestConformance runs the dbtest suite against the SQL Database.
+func TestConformance(t *testing.T) {
+	dbtest.Run(t, func(t *testing.T) dbtest.Fixture {
+		d := newTestDB(t)
+		p, err := d.CreatePerson(context.Background(), "alex")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return dbtest.Fixture{
+			DB:     d,
+			Actor:  p.GetJSONLDId().Get(),
+			Inbox:  p.GetActivityStreamsInbox().GetIRI(),
+			Outbox: p.GetActivityStreamsOutbox().GetIRI(),
+		}
+	})
+}
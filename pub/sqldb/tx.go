@@ -0,0 +1,113 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrNoTransaction indicates that Commit or Rollback was called with a
+	// context not carrying a transaction from Begin.
+	ErrNoTransaction = errors.New("sqldb: no transaction")
+	// ErrLockTimeout indicates that a transaction holding locks waited for
+	// another one longer than the LockTimeout. The transaction must be
+	// rolled back, releasing its locks for the transaction it may be
+	// waiting on.
+	ErrLockTimeout = errors.New("sqldb: lock wait timed out")
+)
+
+// txKey is the context key of the transaction started by Begin.
+type txKey struct{}
+
+// transaction is a transaction started by Begin and the locks taken with its
+// context, which are held until it ends.
+type transaction struct {
+	tx *sql.Tx
+	// mu protects held.
+	mu   sync.Mutex
+	held map[string]bool
+}
+
+// querier is the part of *sql.DB and *sql.Tx used to run queries.
+type querier interface {
+	ExecContext(c context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(c context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(c context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Begin starts a transaction, returning a context carrying it. Calls to the
+// Database with the returned context are made in the transaction.
+//
+// Locks taken with the returned context are held until Commit or Rollback,
+// even once Unlock is called, so no other process or goroutine sees the
+// objects before the changes made to them are committed. Taking such a lock
+// again within the transaction does not block.
+//
+// Since two transactions may take the same locks in a different order, a lock
+// taken while holding others is waited on for at most the LockTimeout, after
+// which Lock fails with ErrLockTimeout and the transaction must be rolled
+// back.
+func (d *Database) Begin(c context.Context) (tx context.Context, err error) {
+	t, err := d.db.BeginTx(c, nil)
+	if err != nil {
+		return
+	}
+	tx = context.WithValue(c, txKey{}, &transaction{
+		tx:   t,
+		held: make(map[string]bool),
+	})
+	return
+}
+
+// Commit commits the transaction carried by the context, and then releases
+// the locks taken within it.
+func (d *Database) Commit(tx context.Context) error {
+	t, ok := tx.Value(txKey{}).(*transaction)
+	if !ok {
+		return ErrNoTransaction
+	}
+	err := t.tx.Commit()
+	d.releaseAll(t)
+	return err
+}
+
+// Rollback aborts the transaction carried by the context, and then releases
+// the locks taken within it.
+func (d *Database) Rollback(tx context.Context) error {
+	t, ok := tx.Value(txKey{}).(*transaction)
+	if !ok {
+		return ErrNoTransaction
+	}
+	err := t.tx.Rollback()
+	d.releaseAll(t)
+	return err
+}
+
+// releaseAll releases the locks taken within the ended transaction. They are
+// released without its context, which may be done once it ended.
+func (d *Database) releaseAll(t *transaction) {
+	t.mu.Lock()
+	held := t.held
+	t.held = make(map[string]bool)
+	t.mu.Unlock()
+	for key := range held {
+		d.release(context.Background(), key)
+	}
+}
+
+// transactionOf returns the transaction carried by the context, if any.
+func transactionOf(c context.Context) (*transaction, bool) {
+	t, ok := c.Value(txKey{}).(*transaction)
+	return t, ok
+}
+
+// conn returns the transaction carried by the context, or the database if
+// there is none.
+func (d *Database) conn(c context.Context) querier {
+	if t, ok := transactionOf(c); ok {
+		return t.tx
+	}
+	return d.db
+}
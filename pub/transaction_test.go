@@ -0,0 +1,189 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"testing"
+)
+
+// transactionDatabase is a Database that also is a TransactionDatabase.
+type transactionDatabase struct {
+	*MockDatabase
+	*MockTransactionDatabase
+}
+
+// txKey is the context key of the test transaction.
+type txKey struct{}
+
+// txMatcher matches the contexts carrying the test transaction.
+type txMatcher struct{}
+
+// Matches returns true if the context carries the test transaction.
+func (txMatcher) Matches(x interface{}) bool {
+	c, ok := x.(context.Context)
+	return ok && c.Value(txKey{}) == "tx"
+}
+
+// String describes the matcher.
+func (txMatcher) String() string {
+	return "carries the test transaction"
+}
+
+// TestTransactionDatabase tests processing activities in a transaction with a
+// TransactionDatabase.
+func TestTransactionDatabase(t *testing.T) {
+	ctx := context.Background()
+	tx := context.WithValue(ctx, txKey{}, "tx")
+	inTx := txMatcher{}
+	setupFn := func(ctl *gomock.Controller) (fp *MockFederatingProtocol, sp *MockSocialProtocol, db *MockDatabase, tdb *MockTransactionDatabase, a DelegateActor) {
+		setupData()
+		fp = NewMockFederatingProtocol(ctl)
+		sp = NewMockSocialProtocol(ctl)
+		db = NewMockDatabase(ctl)
+		tdb = NewMockTransactionDatabase(ctl)
+		a = &sideEffectActor{
+			common: NewMockCommonBehavior(ctl),
+			s2s:    fp,
+			c2s:    sp,
+			db:     transactionDatabase{db, tdb},
+			clock:  NewMockClock(ctl),
+		}
+		return
+	}
+	t.Run("CommitsPostInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, _, db, tdb, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		gomock.InOrder(
+			fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil),
+			tdb.EXPECT().Begin(ctx).Return(tx, nil),
+			db.EXPECT().Lock(inTx, inboxIRI),
+			db.EXPECT().InboxContains(inTx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(inTx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(inTx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(inTx, inboxIRI),
+			tdb.EXPECT().Commit(inTx),
+		)
+		fp.EXPECT().DefaultCallback(inTx, testListen).Return(nil)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, nil)
+	})
+	t.Run("RollsBackPostInboxOnError", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, _, db, tdb, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		testErr := errors.New("test error")
+		gomock.InOrder(
+			fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil),
+			tdb.EXPECT().Begin(ctx).Return(tx, nil),
+			db.EXPECT().Lock(inTx, inboxIRI),
+			db.EXPECT().InboxContains(inTx, inboxIRI, mustParse(testFederatedActivityIRI)).Return(false, nil),
+			db.EXPECT().GetInbox(inTx, inboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetInbox(inTx, testOrderedCollectionWithFederatedId).Return(nil),
+			db.EXPECT().Unlock(inTx, inboxIRI),
+			tdb.EXPECT().Rollback(inTx),
+		)
+		fp.EXPECT().DefaultCallback(inTx, testListen).Return(testErr)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+	t.Run("DoesNotProcessIfBeginFails", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		fp, _, _, tdb, a := setupFn(ctl)
+		inboxIRI := mustParse(testMyInboxIRI)
+		testErr := errors.New("test error")
+		gomock.InOrder(
+			fp.EXPECT().Callbacks(ctx).Return(FederatingWrappedCallbacks{}, nil, nil),
+			tdb.EXPECT().Begin(ctx).Return(nil, testErr),
+		)
+		// Run
+		err := a.PostInbox(ctx, inboxIRI, testListen)
+		// Verify
+		assertEqual(t, err, testErr)
+	})
+	t.Run("CommitsPostOutbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, sp, db, tdb, a := setupFn(ctl)
+		outboxIRI := mustParse(testMyOutboxIRI)
+		gomock.InOrder(
+			tdb.EXPECT().Begin(ctx).Return(tx, nil),
+			db.EXPECT().Lock(inTx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Create(inTx, testMyListen),
+			db.EXPECT().Unlock(inTx, mustParse(testNewActivityIRI)),
+			db.EXPECT().Lock(inTx, outboxIRI),
+			db.EXPECT().GetOutbox(inTx, outboxIRI).Return(testEmptyOrderedCollection, nil),
+			db.EXPECT().SetOutbox(inTx, testOrderedCollectionWithNewId).Return(nil),
+			db.EXPECT().Unlock(inTx, outboxIRI),
+			tdb.EXPECT().Commit(inTx),
+		)
+		sp.EXPECT().Callbacks(inTx).Return(SocialWrappedCallbacks{}, nil, nil)
+		sp.EXPECT().DefaultCallback(inTx, testMyListen).Return(nil)
+		// Run
+		deliverable, err := a.PostOutbox(ctx, testMyListen, outboxIRI, mustSerialize(testMyListen))
+		// Verify
+		assertEqual(t, err, nil)
+		assertEqual(t, deliverable, true)
+	})
+	t.Run("RunsQueuedFunctionsAfterCommit", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, tdb, a := setupFn(ctl)
+		testErr := errors.New("test error")
+		committed := false
+		delivered := false
+		gomock.InOrder(
+			tdb.EXPECT().Begin(ctx).Return(tx, nil),
+			tdb.EXPECT().Commit(tx).Do(func(c context.Context) {
+				committed = true
+			}),
+		)
+		// Run
+		err := a.(*sideEffectActor).inTransaction(ctx, func(c context.Context) error {
+			return onCommit(c, func(c context.Context) error {
+				assertEqual(t, committed, true)
+				delivered = true
+				return testErr
+			})
+		})
+		// Verify
+		assertEqual(t, err, testErr)
+		assertEqual(t, delivered, true)
+	})
+	t.Run("DropsQueuedFunctionsOnRollback", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		_, _, _, tdb, a := setupFn(ctl)
+		testErr := errors.New("test error")
+		delivered := false
+		gomock.InOrder(
+			tdb.EXPECT().Begin(ctx).Return(tx, nil),
+			tdb.EXPECT().Rollback(tx),
+		)
+		// Run
+		err := a.(*sideEffectActor).inTransaction(ctx, func(c context.Context) error {
+			onCommit(c, func(c context.Context) error {
+				delivered = true
+				return nil
+			})
+			return testErr
+		})
+		// Verify
+		assertEqual(t, err, testErr)
+		assertEqual(t, delivered, false)
+	})
+}
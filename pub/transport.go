@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"crypto"
+	"errors"
 	"fmt"
 	"github.com/go-fed/httpsig"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -48,9 +50,26 @@ type Transport interface {
 	BatchDeliver(c context.Context, b []byte, recipients []*url.URL) error
 }
 
+// ErrResponseTooLarge is returned by a LimitedDereferencer when a response is
+// larger than the limit.
+var ErrResponseTooLarge = errors.New("response is larger than the limit")
+
+// LimitedDereferencer is optionally implemented by a Transport to bound the
+// memory used to dereference untrusted objects.
+type LimitedDereferencer interface {
+	// DereferenceLimited fetches the ActivityStreams object located at
+	// this IRI with a GET request, as Dereference does, but returns
+	// ErrResponseTooLarge without reading further once the response is
+	// larger than the limit, in bytes.
+	DereferenceLimited(c context.Context, iri *url.URL, limit int64) ([]byte, error)
+}
+
 // Transport must be implemented by HttpSigTransport.
 var _ Transport = &HttpSigTransport{}
 
+// LimitedDereferencer must be implemented by HttpSigTransport.
+var _ LimitedDereferencer = &HttpSigTransport{}
+
 // HttpSigTransport makes a dereference call using HTTP signatures to
 // authenticate the request on behalf of a particular actor.
 //
@@ -109,6 +128,18 @@ func NewHttpSigTransport(
 // Dereference sends a GET request signed with an HTTP Signature to obtain an
 // ActivityStreams value.
 func (h HttpSigTransport) Dereference(c context.Context, iri *url.URL) ([]byte, error) {
+	return h.dereference(c, iri, -1)
+}
+
+// DereferenceLimited sends a GET request signed with an HTTP Signature to
+// obtain an ActivityStreams value, reading no more than the limit.
+func (h HttpSigTransport) DereferenceLimited(c context.Context, iri *url.URL, limit int64) ([]byte, error) {
+	return h.dereference(c, iri, limit)
+}
+
+// dereference sends a GET request signed with an HTTP Signature, and reads a
+// response of no more than the limit, in bytes, unless it is negative.
+func (h HttpSigTransport) dereference(c context.Context, iri *url.URL, limit int64) ([]byte, error) {
 	req, err := http.NewRequest("GET", iri.String(), nil)
 	if err != nil {
 		return nil, err
@@ -132,7 +163,18 @@ func (h HttpSigTransport) Dereference(c context.Context, iri *url.URL) ([]byte,
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("GET request to %s failed (%d): %s", iri.String(), resp.StatusCode, resp.Status)
 	}
-	return ioutil.ReadAll(resp.Body)
+	if limit < 0 {
+		return ioutil.ReadAll(resp.Body)
+	} else if resp.ContentLength > limit {
+		return nil, ErrResponseTooLarge
+	}
+	b, err := ioutil.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(b)) > limit {
+		return nil, ErrResponseTooLarge
+	}
+	return b, nil
 }
 
 // Deliver sends a POST request with an HTTP Signature.
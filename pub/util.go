@@ -127,6 +127,9 @@ const (
 const (
 	// The Location header
 	locationHeader = "Location"
+	// The Idempotency-Key header of C2S requests, identifying retries of
+	// the same POST to an outbox.
+	idempotencyKeyHeader = "Idempotency-Key"
 	// Contains the ActivityStreams Content-Type value.
 	contentTypeHeaderValue = "application/ld+json; profile=\"https://www.w3.org/ns/activitystreams\""
 	// The Date header.
@@ -193,56 +196,50 @@ func GetId(t vocab.Type) (*url.URL, error) {
 	return nil, fmt.Errorf("cannot determine id of activitystreams value")
 }
 
+// inboxForwardingProperties are the properties whose values are examined
+// when determining whether to forward an activity, in the order their values
+// are looked up.
+var inboxForwardingProperties = []string{"inReplyTo", "tag", "object", "target"}
+
+// errInboxForwardingValueFound stops walking an ActivityStreams value once an
+// owned value applicable to inbox forwarding is found.
+var errInboxForwardingValueFound = errors.New("found a value applicable to inbox forwarding")
+
+// isInboxForwardingProperty returns true if the property is one of the
+// inboxForwardingProperties.
+func isInboxForwardingProperty(name string) bool {
+	for _, p := range inboxForwardingProperties {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
 // getInboxForwardingValues obtains the 'inReplyTo', 'object', 'target', and
 // 'tag' values on an ActivityStreams value.
 func getInboxForwardingValues(o vocab.Type) (t []vocab.Type, iri []*url.URL) {
-	// 'inReplyTo'
-	if i, ok := o.(inReplyToer); ok {
-		if irt := i.GetActivityStreamsInReplyTo(); irt != nil {
-			for iter := irt.Begin(); iter != irt.End(); iter = iter.Next() {
-				if tv := iter.GetType(); tv != nil {
-					t = append(t, tv)
-				} else {
-					iri = append(iri, iter.GetIRI())
-				}
+	types := make(map[string][]vocab.Type)
+	iris := make(map[string][]*url.URL)
+	streams.Walk(o, streams.Walker{
+		Pre: func(p streams.Path, v vocab.Type) error {
+			if e, ok := p.Last(); ok {
+				types[e.Property] = append(types[e.Property], v)
+				return streams.SkipChildren
 			}
-		}
-	}
-	// 'tag'
-	if i, ok := o.(tagger); ok {
-		if tag := i.GetActivityStreamsTag(); tag != nil {
-			for iter := tag.Begin(); iter != tag.End(); iter = iter.Next() {
-				if tv := iter.GetType(); tv != nil {
-					t = append(t, tv)
-				} else {
-					iri = append(iri, iter.GetIRI())
-				}
-			}
-		}
-	}
-	// 'object'
-	if i, ok := o.(objecter); ok {
-		if obj := i.GetActivityStreamsObject(); obj != nil {
-			for iter := obj.Begin(); iter != obj.End(); iter = iter.Next() {
-				if tv := iter.GetType(); tv != nil {
-					t = append(t, tv)
-				} else {
-					iri = append(iri, iter.GetIRI())
-				}
-			}
-		}
-	}
-	// 'target'
-	if i, ok := o.(targeter); ok {
-		if tar := i.GetActivityStreamsTarget(); tar != nil {
-			for iter := tar.Begin(); iter != tar.End(); iter = iter.Next() {
-				if tv := iter.GetType(); tv != nil {
-					t = append(t, tv)
-				} else {
-					iri = append(iri, iter.GetIRI())
-				}
+			return nil
+		},
+		IRI: func(p streams.Path, u *url.URL) error {
+			if len(p) == 1 {
+				iris[p[0].Property] = append(iris[p[0].Property], u)
 			}
-		}
+			return nil
+		},
+	})
+	// Keep the order of the properties, in which the values are looked up.
+	for _, name := range inboxForwardingProperties {
+		t = append(t, types[name]...)
+		iri = append(iri, iris[name]...)
 	}
 	return
 }
@@ -444,7 +441,11 @@ func mustHaveActivityOriginMatchObjects(a Activity) error {
 			return err
 		}
 		if originHost != iri.Host {
-			return fmt.Errorf("object %q: not in activity origin", iri)
+			return &OriginMismatchError{
+				Origin:   originHost,
+				Property: "object",
+				IRI:      iri,
+			}
 		}
 	}
 	return nil
@@ -801,10 +802,14 @@ func mustHaveActivityActorsMatchObjectActors(c context.Context,
 
 // add implements the logic of adding object ids to a target Collection or
 // OrderedCollection. This logic is shared by both the C2S and S2S protocols.
+//
+// The added function is called with each object id added to a target, once
+// the target is updated.
 func add(c context.Context,
 	op vocab.ActivityStreamsObjectProperty,
 	target vocab.ActivityStreamsTargetProperty,
-	db Database) error {
+	db Database,
+	added func(target, object *url.URL)) error {
 	opIds := make([]*url.URL, 0, op.Len())
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		id, err := ToId(iter)
@@ -870,6 +875,9 @@ func add(c context.Context,
 		if err != nil {
 			return err
 		}
+		for _, objId := range opIds {
+			added(t, objId)
+		}
 		return nil
 	}
 	for _, t := range targetIds {
@@ -882,10 +890,14 @@ func add(c context.Context,
 
 // remove implements the logic of removing object ids to a target Collection or
 // OrderedCollection. This logic is shared by both the C2S and S2S protocols.
+//
+// The removed function is called with each object id removed from a target,
+// once the target is updated.
 func remove(c context.Context,
 	op vocab.ActivityStreamsObjectProperty,
 	target vocab.ActivityStreamsTargetProperty,
-	db Database) error {
+	db Database,
+	removed func(target, object *url.URL)) error {
 	opIds := make(map[string]bool, op.Len())
 	for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
 		id, err := ToId(iter)
@@ -918,6 +930,7 @@ func remove(c context.Context,
 		if err != nil {
 			return err
 		}
+		var removedIds []*url.URL
 		if streams.IsOrExtendsActivityStreamsOrderedCollection(tp) {
 			oi, ok := tp.(orderedItemser)
 			if !ok {
@@ -932,6 +945,7 @@ func remove(c context.Context,
 					}
 					if opIds[id.String()] {
 						oiProp.Remove(i)
+						removedIds = append(removedIds, id)
 					} else {
 						i++
 					}
@@ -951,6 +965,7 @@ func remove(c context.Context,
 					}
 					if opIds[id.String()] {
 						iProp.Remove(i)
+						removedIds = append(removedIds, id)
 					} else {
 						i++
 					}
@@ -963,6 +978,9 @@ func remove(c context.Context,
 		if err != nil {
 			return err
 		}
+		for _, objId := range removedIds {
+			removed(t, objId)
+		}
 		return nil
 	}
 	for _, t := range targetIds {
@@ -976,30 +994,38 @@ func remove(c context.Context,
 // clearSensitiveFields removes the 'bto' and 'bcc' entries on the given value
 // and recursively on every 'object' property value.
 func clearSensitiveFields(obj vocab.Type) {
-	if t, ok := obj.(btoer); ok {
-		bto := t.GetActivityStreamsBto()
-		if bto != nil {
-			for bto.Len() > 0 {
-				bto.Remove(0)
+	walkObjects(obj, func(obj vocab.Type) {
+		if t, ok := obj.(btoer); ok {
+			bto := t.GetActivityStreamsBto()
+			if bto != nil {
+				for bto.Len() > 0 {
+					bto.Remove(0)
+				}
 			}
 		}
-	}
-	if t, ok := obj.(bccer); ok {
-		bcc := t.GetActivityStreamsBcc()
-		if bcc != nil {
-			for bcc.Len() > 0 {
-				bcc.Remove(0)
+		if t, ok := obj.(bccer); ok {
+			bcc := t.GetActivityStreamsBcc()
+			if bcc != nil {
+				for bcc.Len() > 0 {
+					bcc.Remove(0)
+				}
 			}
 		}
-	}
-	if t, ok := obj.(objecter); ok {
-		op := t.GetActivityStreamsObject()
-		if op != nil {
-			for iter := op.Begin(); iter != op.End(); iter = iter.Next() {
-				clearSensitiveFields(iter.GetType())
+	})
+}
+
+// walkObjects calls the function with the value and, recursively, with every
+// value of its 'object' property.
+func walkObjects(obj vocab.Type, fn func(t vocab.Type)) {
+	streams.Walk(obj, streams.Walker{
+		Pre: func(p streams.Path, t vocab.Type) error {
+			if e, ok := p.Last(); ok && e.Property != "object" {
+				return streams.SkipChildren
 			}
-		}
-	}
+			fn(t)
+			return nil
+		},
+	})
 }
 
 // requestId forms an ActivityPub id based on the HTTP request. Always assumes
@@ -0,0 +1,105 @@
+package pub
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+)
+
+// Validator checks the ActivityStreams payloads posted to an inbox or an
+// outbox before they are processed.
+//
+// It is optionally implemented by the FederatingProtocol for inboxes, by the
+// SocialProtocol for outboxes, such as by embedding a RuleSet, or by the
+// DelegateActor of NewCustomActor for both. When it is, a payload it returns a
+// ValidationError for is answered with a 400 Bad Request, before any hook,
+// authorization or side effect is run. Any other error is returned by the
+// PostInbox or PostOutbox call.
+type Validator interface {
+	// Validate returns a ValidationError if the value is invalid.
+	Validate(c context.Context, t vocab.Type) error
+}
+
+// ValidationError indicates that an ActivityStreams payload is invalid.
+type ValidationError struct {
+	// Err describes why the payload is invalid.
+	Err error
+}
+
+// Error returns the reason the payload is invalid.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid activity streams payload: %v", e.Err)
+}
+
+// IsValidationError returns true if the error is a ValidationError.
+func IsValidationError(err error) bool {
+	_, ok := err.(*ValidationError)
+	return ok
+}
+
+// ValidationRule is a single check of a RuleSet. It returns a ValidationError
+// if the value breaks the rule, and any other error if the rule cannot be
+// checked.
+type ValidationRule func(c context.Context, t vocab.Type) error
+
+// VocabularyRule applies the Validate method generated for each type: values
+// must be in the range of their properties, IRIs must be absolute, and the
+// properties required by the specification, such as the 'object' of a Create,
+// must be set.
+func VocabularyRule(c context.Context, t vocab.Type) error {
+	if err := t.Validate(); err != nil {
+		return &ValidationError{Err: err}
+	}
+	return nil
+}
+
+// RequireActorRule requires each Activity to have an 'actor'.
+//
+// Peers must set it for the inbox side effects to apply, while the library
+// does not require clients to, so it is not part of DefaultRuleSet.
+func RequireActorRule(c context.Context, t vocab.Type) error {
+	a, ok := t.(Activity)
+	if !ok {
+		return nil
+	}
+	if actors := a.GetActivityStreamsActor(); actors == nil || actors.Len() == 0 {
+		return &ValidationError{Err: fmt.Errorf("actor: required by %s", t.GetTypeName())}
+	}
+	return nil
+}
+
+// RuleSet is a Validator applying a configurable list of rules in order. The
+// first rule returning an error stops the validation.
+//
+// The zero value has no rules and accepts everything. It is not safe to add
+// rules while payloads are being validated.
+type RuleSet struct {
+	rules []ValidationRule
+}
+
+var _ Validator = &RuleSet{}
+
+// NewRuleSet returns a RuleSet applying the rules in order.
+func NewRuleSet(rules ...ValidationRule) *RuleSet {
+	return &RuleSet{rules: rules}
+}
+
+// DefaultRuleSet returns a RuleSet applying the VocabularyRule.
+func DefaultRuleSet() *RuleSet {
+	return NewRuleSet(VocabularyRule)
+}
+
+// Add appends rules to apply after the existing ones.
+func (r *RuleSet) Add(rules ...ValidationRule) {
+	r.rules = append(r.rules, rules...)
+}
+
+// Validate applies the rules in order, returning the first error.
+func (r *RuleSet) Validate(c context.Context, t vocab.Type) error {
+	for _, rule := range r.rules {
+		if err := rule(c, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
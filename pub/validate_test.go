@@ -0,0 +1,117 @@
+package pub
+
+import (
+	"context"
+	"errors"
+	"github.com/go-fed/activity/streams"
+	"github.com/go-fed/activity/streams/vocab"
+	"github.com/golang/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// validatingDelegate is a DelegateActor that also is a Validator.
+type validatingDelegate struct {
+	*MockDelegateActor
+	*RuleSet
+}
+
+// newCreateWithoutObject returns a Create with an id and an actor, but missing
+// its required 'object'.
+func newCreateWithoutObject() vocab.ActivityStreamsCreate {
+	c := streams.NewActivityStreamsCreate()
+	id := streams.NewJSONLDIdProperty()
+	id.Set(mustParse(testFederatedActivityIRI))
+	c.SetJSONLDId(id)
+	actor := streams.NewActivityStreamsActorProperty()
+	actor.AppendIRI(mustParse(testFederatedActorIRI))
+	c.SetActivityStreamsActor(actor)
+	return c
+}
+
+func TestRuleSet(t *testing.T) {
+	ctx := context.Background()
+	t.Run("ZeroValueAcceptsEverything", func(t *testing.T) {
+		var r RuleSet
+		err := r.Validate(ctx, newCreateWithoutObject())
+		assertEqual(t, err, nil)
+	})
+	t.Run("DefaultAcceptsValidActivity", func(t *testing.T) {
+		setupData()
+		err := DefaultRuleSet().Validate(ctx, testCreate)
+		assertEqual(t, err, nil)
+	})
+	t.Run("DefaultRejectsMissingObject", func(t *testing.T) {
+		err := DefaultRuleSet().Validate(ctx, newCreateWithoutObject())
+		assertEqual(t, IsValidationError(err), true)
+	})
+	t.Run("RequireActorRejectsMissingActor", func(t *testing.T) {
+		setupData()
+		c := streams.NewActivityStreamsCreate()
+		op := streams.NewActivityStreamsObjectProperty()
+		op.AppendActivityStreamsNote(testFederatedNote)
+		c.SetActivityStreamsObject(op)
+		r := DefaultRuleSet()
+		r.Add(RequireActorRule)
+		err := r.Validate(ctx, c)
+		assertEqual(t, IsValidationError(err), true)
+	})
+	t.Run("StopsAtFirstError", func(t *testing.T) {
+		expectErr := errors.New("cannot check")
+		called := false
+		r := NewRuleSet(
+			func(c context.Context, t vocab.Type) error { return expectErr },
+			func(c context.Context, t vocab.Type) error {
+				called = true
+				return nil
+			})
+		err := r.Validate(ctx, newCreateWithoutObject())
+		assertEqual(t, err, expectErr)
+		assertEqual(t, IsValidationError(err), false)
+		assertEqual(t, called, false)
+	})
+}
+
+func TestValidatorRejectsInvalidPayloads(t *testing.T) {
+	ctx := context.Background()
+	setupFn := func(ctl *gomock.Controller) (delegate *MockDelegateActor, a Actor) {
+		delegate = NewMockDelegateActor(ctl)
+		a = NewCustomActor(
+			validatingDelegate{delegate, DefaultRuleSet()},
+			/*enableSocialProtocol=*/ true,
+			/*enableFederatedProtocol=*/ true,
+			NewMockClock(ctl))
+		return
+	}
+	t.Run("PostInbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostInboxRequest(newCreateWithoutObject()))
+		delegate.EXPECT().AuthenticatePostInbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostInbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+	t.Run("PostOutbox", func(t *testing.T) {
+		// Setup
+		ctl := gomock.NewController(t)
+		defer ctl.Finish()
+		delegate, a := setupFn(ctl)
+		resp := httptest.NewRecorder()
+		req := toAPRequest(toPostOutboxRequest(newCreateWithoutObject()))
+		delegate.EXPECT().AuthenticatePostOutbox(ctx, resp, req).Return(ctx, true, nil)
+		// Run the test
+		handled, err := a.PostOutbox(ctx, resp, req)
+		// Verify results
+		assertEqual(t, err, nil)
+		assertEqual(t, handled, true)
+		assertEqual(t, resp.Code, http.StatusBadRequest)
+	})
+}
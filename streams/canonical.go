@@ -0,0 +1,203 @@
+package streams
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// SerializeCanonical serializes the value as with Serialize, and encodes it
+// with Canonicalize. The same value always results in the same bytes, which
+// is suitable for content hashes, digests, detecting duplicates, and signing.
+func SerializeCanonical(a vocab.Type) ([]byte, error) {
+	m, err := Serialize(a)
+	if err != nil {
+		return nil, err
+	}
+	return Canonicalize(m)
+}
+
+// Canonicalize encodes a JSON value following the JSON Canonicalization Scheme
+// (JCS) of RFC 8785: object members are sorted by the UTF-16 code units of
+// their names, there is no whitespace, strings use the minimal escaping, and
+// numbers are formatted as ECMAScript does.
+//
+// The value is typically one decoded by encoding/json or returned by
+// Serialize. Numbers, including json.Number, are encoded as IEEE 754 doubles
+// as the scheme requires, so integers beyond 2^53 lose precision. Values of
+// other Go types are first converted with encoding/json. Strings must be valid
+// UTF-8, and numbers must be finite.
+func Canonicalize(v interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := canonicalizeValue(&b, v); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// canonicalizeValue writes the canonical encoding of a JSON value.
+func canonicalizeValue(b *bytes.Buffer, v interface{}) error {
+	switch conc := v.(type) {
+	case nil:
+		b.WriteString("null")
+	case bool:
+		b.WriteString(strconv.FormatBool(conc))
+	case string:
+		return canonicalizeString(b, conc)
+	case json.Number:
+		f, err := conc.Float64()
+		if err != nil {
+			return err
+		}
+		return canonicalizeNumber(b, f)
+	case map[string]interface{}:
+		return canonicalizeObject(b, conc)
+	case []interface{}:
+		b.WriteByte('[')
+		for i, elem := range conc {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			if err := canonicalizeValue(b, elem); err != nil {
+				return err
+			}
+		}
+		b.WriteByte(']')
+	default:
+		r := reflect.ValueOf(v)
+		switch r.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return canonicalizeNumber(b, float64(r.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return canonicalizeNumber(b, float64(r.Uint()))
+		case reflect.Float32, reflect.Float64:
+			return canonicalizeNumber(b, r.Float())
+		}
+		// Let encoding/json decide how other types, such as the
+		// map[string]string of aliases in an @context, look as JSON.
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		d := json.NewDecoder(bytes.NewReader(raw))
+		d.UseNumber()
+		var decoded interface{}
+		if err := d.Decode(&decoded); err != nil {
+			return err
+		}
+		return canonicalizeValue(b, decoded)
+	}
+	return nil
+}
+
+// canonicalizeObject writes the members of the object sorted by the UTF-16
+// code units of their names.
+func canonicalizeObject(b *bytes.Buffer, m map[string]interface{}) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessUTF16(keys[i], keys[j])
+	})
+	b.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := canonicalizeString(b, k); err != nil {
+			return err
+		}
+		b.WriteByte(':')
+		if err := canonicalizeValue(b, m[k]); err != nil {
+			return err
+		}
+	}
+	b.WriteByte('}')
+	return nil
+}
+
+// lessUTF16 compares strings by their UTF-16 code units, which differs from
+// comparing their UTF-8 bytes for characters beyond the Basic Multilingual
+// Plane.
+func lessUTF16(a, b string) bool {
+	ua := utf16.Encode([]rune(a))
+	ub := utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+}
+
+// canonicalizeString writes a string escaping only the quotation mark, the
+// reverse solidus, and the control characters.
+func canonicalizeString(b *bytes.Buffer, s string) error {
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("string is not valid UTF-8: %q", s)
+	}
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return nil
+}
+
+// canonicalizeNumber writes a number as the ECMAScript Number.prototype.toString
+// method does.
+func canonicalizeNumber(b *bytes.Buffer, f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return fmt.Errorf("number is not finite: %v", f)
+	}
+	if f == 0 {
+		// Negative zero is also "0".
+		b.WriteString("0")
+		return nil
+	}
+	if f < 0 {
+		b.WriteByte('-')
+		f = -f
+	}
+	format := byte('e')
+	if f >= 1e-6 && f < 1e21 {
+		format = 'f'
+	}
+	s := strconv.FormatFloat(f, format, -1, 64)
+	// ECMAScript has no leading zero in exponents: "1e+09" is "1e+9".
+	if i := strings.IndexByte(s, 'e'); i > 0 && s[i+2] == '0' {
+		s = s[:i+2] + s[i+3:]
+	}
+	b.WriteString(s)
+	return nil
+}
@@ -0,0 +1,74 @@
+package streams
+
+import (
+	"github.com/go-fed/activity/streams/jsonld"
+	"github.com/go-fed/activity/streams/vocab"
+	"sort"
+)
+
+// PropertyDiff is a property whose value differs between two ActivityStreams
+// values. Values are in their serialized form, as in the map returned by
+// Serialize.
+type PropertyDiff struct {
+	// Name is the name of the property when serialized, such as "content"
+	// or "contentMap".
+	Name string
+	// Old is the value before, which is nil if the property was added.
+	Old interface{}
+	// New is the value after, which is nil if the property was removed.
+	New interface{}
+}
+
+// TypeDiff reports the properties added, removed and changed between two
+// ActivityStreams values. Each list is sorted by the name of the properties.
+type TypeDiff struct {
+	Added   []PropertyDiff
+	Removed []PropertyDiff
+	Changed []PropertyDiff
+}
+
+// Empty returns true if there is no difference.
+func (d TypeDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff compares the serialized properties, including unknown ones, of two
+// ActivityStreams values. A property whose value is a nested type that differs
+// in any way is reported as changed as a whole. The @context is ignored.
+//
+// To only determine whether two values of the same type are equal, use the
+// Equals method of the type instead.
+func Diff(a, b vocab.Type) (d TypeDiff, err error) {
+	var am, bm map[string]interface{}
+	if am, err = Serialize(a); err != nil {
+		return
+	}
+	if bm, err = Serialize(b); err != nil {
+		return
+	}
+	for k, old := range am {
+		if k == jsonLDContext {
+			continue
+		}
+		if v, ok := bm[k]; !ok {
+			d.Removed = append(d.Removed, PropertyDiff{Name: k, Old: old})
+		} else if !jsonld.EqualValues(old, v) {
+			d.Changed = append(d.Changed, PropertyDiff{Name: k, Old: old, New: v})
+		}
+	}
+	for k, v := range bm {
+		if k == jsonLDContext {
+			continue
+		}
+		if _, ok := am[k]; !ok {
+			d.Added = append(d.Added, PropertyDiff{Name: k, New: v})
+		}
+	}
+	for _, l := range [][]PropertyDiff{d.Added, d.Removed, d.Changed} {
+		l := l // shadow loop variable
+		sort.Slice(l, func(i, j int) bool {
+			return l[i].Name < l[j].Name
+		})
+	}
+	return
+}
@@ -38,6 +38,9 @@ var ActivityStreamsCollectionPageName string = "CollectionPage"
 // ActivityStreamsCreateName is the string literal of the name for the Create type in the ActivityStreams vocabulary.
 var ActivityStreamsCreateName string = "Create"
 
+// W3IDDataIntegrityV1DataIntegrityProofName is the string literal of the name for the DataIntegrityProof type in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1DataIntegrityProofName string = "DataIntegrityProof"
+
 // ActivityStreamsDeleteName is the string literal of the name for the Delete type in the ActivityStreams vocabulary.
 var ActivityStreamsDeleteName string = "Delete"
 
@@ -92,6 +95,9 @@ var ActivityStreamsMentionName string = "Mention"
 // ActivityStreamsMoveName is the string literal of the name for the Move type in the ActivityStreams vocabulary.
 var ActivityStreamsMoveName string = "Move"
 
+// W3IDMultikeyV1MultikeyName is the string literal of the name for the Multikey type in the W3IDMultikeyV1 vocabulary.
+var W3IDMultikeyV1MultikeyName string = "Multikey"
+
 // ActivityStreamsNoteName is the string literal of the name for the Note type in the ActivityStreams vocabulary.
 var ActivityStreamsNoteName string = "Note"
 
@@ -140,6 +146,9 @@ var ActivityStreamsRelationshipName string = "Relationship"
 // ActivityStreamsRemoveName is the string literal of the name for the Remove type in the ActivityStreams vocabulary.
 var ActivityStreamsRemoveName string = "Remove"
 
+// W3IDSecurityV1RsaSignature2017Name is the string literal of the name for the RsaSignature2017 type in the W3IDSecurityV1 vocabulary.
+var W3IDSecurityV1RsaSignature2017Name string = "RsaSignature2017"
+
 // ActivityStreamsServiceName is the string literal of the name for the Service type in the ActivityStreams vocabulary.
 var ActivityStreamsServiceName string = "Service"
 
@@ -179,6 +188,9 @@ var ActivityStreamsAltitudePropertyName string = "altitude"
 // ActivityStreamsAnyOfPropertyName is the string literal of the name for the anyOf property in the ActivityStreams vocabulary.
 var ActivityStreamsAnyOfPropertyName string = "anyOf"
 
+// W3IDMultikeyV1AssertionMethodPropertyName is the string literal of the name for the assertionMethod property in the W3IDMultikeyV1 vocabulary.
+var W3IDMultikeyV1AssertionMethodPropertyName string = "assertionMethod"
+
 // ActivityStreamsAttachmentPropertyName is the string literal of the name for the attachment property in the ActivityStreams vocabulary.
 var ActivityStreamsAttachmentPropertyName string = "attachment"
 
@@ -209,6 +221,21 @@ var ActivityStreamsContentPropertyMapName string = "contentMap"
 // ActivityStreamsContextPropertyName is the string literal of the name for the context property in the ActivityStreams vocabulary.
 var ActivityStreamsContextPropertyName string = "context"
 
+// W3IDMultikeyV1ControllerPropertyName is the string literal of the name for the controller property in the W3IDMultikeyV1 vocabulary.
+var W3IDMultikeyV1ControllerPropertyName string = "controller"
+
+// W3IDDataIntegrityV1CreatedPropertyName is the string literal of the name for the created property in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1CreatedPropertyName string = "created"
+
+// W3IDSecurityV1CreatedPropertyName is the string literal of the name for the created property in the W3IDSecurityV1 vocabulary.
+var W3IDSecurityV1CreatedPropertyName string = "created"
+
+// W3IDSecurityV1CreatorPropertyName is the string literal of the name for the creator property in the W3IDSecurityV1 vocabulary.
+var W3IDSecurityV1CreatorPropertyName string = "creator"
+
+// W3IDDataIntegrityV1CryptosuitePropertyName is the string literal of the name for the cryptosuite property in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1CryptosuitePropertyName string = "cryptosuite"
+
 // ActivityStreamsCurrentPropertyName is the string literal of the name for the current property in the ActivityStreams vocabulary.
 var ActivityStreamsCurrentPropertyName string = "current"
 
@@ -329,9 +356,21 @@ var ActivityStreamsPrevPropertyName string = "prev"
 // ActivityStreamsPreviewPropertyName is the string literal of the name for the preview property in the ActivityStreams vocabulary.
 var ActivityStreamsPreviewPropertyName string = "preview"
 
+// W3IDDataIntegrityV1ProofPropertyName is the string literal of the name for the proof property in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1ProofPropertyName string = "proof"
+
+// W3IDDataIntegrityV1ProofPurposePropertyName is the string literal of the name for the proofPurpose property in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1ProofPurposePropertyName string = "proofPurpose"
+
+// W3IDDataIntegrityV1ProofValuePropertyName is the string literal of the name for the proofValue property in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1ProofValuePropertyName string = "proofValue"
+
 // W3IDSecurityV1PublicKeyPropertyName is the string literal of the name for the publicKey property in the W3IDSecurityV1 vocabulary.
 var W3IDSecurityV1PublicKeyPropertyName string = "publicKey"
 
+// W3IDMultikeyV1PublicKeyMultibasePropertyName is the string literal of the name for the publicKeyMultibase property in the W3IDMultikeyV1 vocabulary.
+var W3IDMultikeyV1PublicKeyMultibasePropertyName string = "publicKeyMultibase"
+
 // W3IDSecurityV1PublicKeyPemPropertyName is the string literal of the name for the publicKeyPem property in the W3IDSecurityV1 vocabulary.
 var W3IDSecurityV1PublicKeyPemPropertyName string = "publicKeyPem"
 
@@ -356,6 +395,12 @@ var ActivityStreamsResultPropertyName string = "result"
 // ActivityStreamsSharesPropertyName is the string literal of the name for the shares property in the ActivityStreams vocabulary.
 var ActivityStreamsSharesPropertyName string = "shares"
 
+// W3IDSecurityV1SignaturePropertyName is the string literal of the name for the signature property in the W3IDSecurityV1 vocabulary.
+var W3IDSecurityV1SignaturePropertyName string = "signature"
+
+// W3IDSecurityV1SignatureValuePropertyName is the string literal of the name for the signatureValue property in the W3IDSecurityV1 vocabulary.
+var W3IDSecurityV1SignatureValuePropertyName string = "signatureValue"
+
 // ActivityStreamsStartIndexPropertyName is the string literal of the name for the startIndex property in the ActivityStreams vocabulary.
 var ActivityStreamsStartIndexPropertyName string = "startIndex"
 
@@ -395,5 +440,8 @@ var ActivityStreamsUpdatedPropertyName string = "updated"
 // ActivityStreamsUrlPropertyName is the string literal of the name for the url property in the ActivityStreams vocabulary.
 var ActivityStreamsUrlPropertyName string = "url"
 
+// W3IDDataIntegrityV1VerificationMethodPropertyName is the string literal of the name for the verificationMethod property in the W3IDDataIntegrityV1 vocabulary.
+var W3IDDataIntegrityV1VerificationMethodPropertyName string = "verificationMethod"
+
 // ActivityStreamsWidthPropertyName is the string literal of the name for the width property in the ActivityStreams vocabulary.
 var ActivityStreamsWidthPropertyName string = "width"
@@ -127,10 +127,26 @@ import (
 	typeupdate "github.com/go-fed/activity/streams/impl/activitystreams/type_update"
 	typevideo "github.com/go-fed/activity/streams/impl/activitystreams/type_video"
 	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
+	propertycreated "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_created"
+	propertycryptosuite "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_cryptosuite"
+	propertyproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proof"
+	propertyproofpurpose "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proofpurpose"
+	propertyproofvalue "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proofvalue"
+	propertyverificationmethod "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_verificationmethod"
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	propertyassertionmethod "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_assertionmethod"
+	propertycontroller "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_controller"
+	propertypublickeymultibase "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_publickeymultibase"
+	typemultikey "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/type_multikey"
+	propertycreated1 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_created"
+	propertycreator "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_creator"
 	propertyowner "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_owner"
 	propertypublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickey"
 	propertypublickeypem "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickeypem"
+	propertysignature "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_signature"
+	propertysignaturevalue "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_signaturevalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 )
 
 var mgr *Manager
@@ -270,10 +286,26 @@ func init() {
 	typeupdate.SetManager(mgr)
 	typevideo.SetManager(mgr)
 	typeview.SetManager(mgr)
+	propertycreated.SetManager(mgr)
+	propertycryptosuite.SetManager(mgr)
+	propertyproof.SetManager(mgr)
+	propertyproofpurpose.SetManager(mgr)
+	propertyproofvalue.SetManager(mgr)
+	propertyverificationmethod.SetManager(mgr)
+	typedataintegrityproof.SetManager(mgr)
+	propertyassertionmethod.SetManager(mgr)
+	propertycontroller.SetManager(mgr)
+	propertypublickeymultibase.SetManager(mgr)
+	typemultikey.SetManager(mgr)
+	propertycreated1.SetManager(mgr)
+	propertycreator.SetManager(mgr)
 	propertyowner.SetManager(mgr)
 	propertypublickey.SetManager(mgr)
 	propertypublickeypem.SetManager(mgr)
+	propertysignature.SetManager(mgr)
+	propertysignaturevalue.SetManager(mgr)
 	typepublickey.SetManager(mgr)
+	typersasignature2017.SetManager(mgr)
 	typeaccept.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeactivity.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeadd.SetTypePropertyConstructor(NewJSONLDTypeProperty)
@@ -328,5 +360,8 @@ func init() {
 	typeupdate.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typevideo.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typeview.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typedataintegrityproof.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typemultikey.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 	typepublickey.SetTypePropertyConstructor(NewJSONLDTypeProperty)
+	typersasignature2017.SetTypePropertyConstructor(NewJSONLDTypeProperty)
 }
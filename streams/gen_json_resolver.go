@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"strings"
 )
@@ -55,6 +56,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCreate) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.W3IDDataIntegrityV1DataIntegrityProof) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDelete) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDislike) error:
@@ -91,6 +94,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsMove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.W3IDMultikeyV1Multikey) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsNote) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsObject) error:
@@ -123,6 +128,8 @@ func NewJSONResolver(callbacks ...interface{}) (*JSONResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRemove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.W3IDSecurityV1RsaSignature2017) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsService) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
@@ -166,7 +173,6 @@ func toAliasMap(i interface{}) (m map[string]string) {
 	switch v := i.(type) {
 	case string:
 		// Single entry, no alias.
-
 		if ok, http, https := toHttpHttpsFn(v); ok {
 			m[http] = ""
 			m[https] = ""
@@ -175,7 +181,6 @@ func toAliasMap(i interface{}) (m map[string]string) {
 		}
 	case []interface{}:
 		// Recursively apply.
-
 		for _, elem := range v {
 			r := toAliasMap(elem)
 			for k, val := range r {
@@ -184,7 +189,6 @@ func toAliasMap(i interface{}) (m map[string]string) {
 		}
 	case map[string]interface{}:
 		// Map any aliases.
-
 		for k, val := range v {
 			// Only handle string aliases.
 			switch conc := val.(type) {
@@ -203,8 +207,15 @@ func toAliasMap(i interface{}) (m map[string]string) {
 // if the ActivityStreams type does not match callbackers or is not a type
 // handled by the generated code. If multiple types are present, it will check
 // each one in order and apply only the first one. It returns an unhandled
-// error for a multi-typed object if none of the types were able to be handled.
+// error for a multi-typed object if none of the types were able to be
+// handled. The payload is first normalized against the JSON-LD contexts of
+// the vocabularies, so properties and types named by IRIs or by other terms
+// are recognized.
 func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{}) error {
+	m, err := jsonld.Normalize(ctx, m, "https://www.w3.org/ns/activitystreams", "https://w3id.org/security/data-integrity/v1", "https://w3id.org/security/multikey/v1", "https://w3id.org/security/v1")
+	if err != nil {
+		return err
+	}
 	typeValue, ok := m["type"]
 	if !ok {
 		return fmt.Errorf("cannot determine ActivityStreams type: 'type' property is missing")
@@ -223,6 +234,20 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 		if len(ActivityStreamsAlias) > 0 {
 			ActivityStreamsAlias += ":"
 		}
+		W3IDDataIntegrityV1Alias, ok := aliasMap["https://w3id.org/security/data-integrity/v1"]
+		if !ok {
+			W3IDDataIntegrityV1Alias = aliasMap["http://w3id.org/security/data-integrity/v1"]
+		}
+		if len(W3IDDataIntegrityV1Alias) > 0 {
+			W3IDDataIntegrityV1Alias += ":"
+		}
+		W3IDMultikeyV1Alias, ok := aliasMap["https://w3id.org/security/multikey/v1"]
+		if !ok {
+			W3IDMultikeyV1Alias = aliasMap["http://w3id.org/security/multikey/v1"]
+		}
+		if len(W3IDMultikeyV1Alias) > 0 {
+			W3IDMultikeyV1Alias += ":"
+		}
 		W3IDSecurityV1Alias, ok := aliasMap["https://w3id.org/security/v1"]
 		if !ok {
 			W3IDSecurityV1Alias = aliasMap["http://w3id.org/security/v1"]
@@ -363,6 +388,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == W3IDDataIntegrityV1Alias+"DataIntegrityProof" {
+			v, err := mgr.DeserializeDataIntegrityProofW3IDDataIntegrityV1()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.W3IDDataIntegrityV1DataIntegrityProof) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Delete" {
 			v, err := mgr.DeserializeDeleteActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -561,6 +597,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == W3IDMultikeyV1Alias+"Multikey" {
+			v, err := mgr.DeserializeMultikeyW3IDMultikeyV1()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.W3IDMultikeyV1Multikey) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Note" {
 			v, err := mgr.DeserializeNoteActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -737,6 +784,17 @@ func (this JSONResolver) Resolve(ctx context.Context, m map[string]interface{})
 				}
 			}
 			return ErrNoCallbackMatch
+		} else if typeString == W3IDSecurityV1Alias+"RsaSignature2017" {
+			v, err := mgr.DeserializeRsaSignature2017W3IDSecurityV1()(m, aliasMap)
+			if err != nil {
+				return err
+			}
+			for _, i := range this.callbacks {
+				if fn, ok := i.(func(context.Context, vocab.W3IDSecurityV1RsaSignature2017) error); ok {
+					return fn(ctx, v)
+				}
+			}
+			return ErrNoCallbackMatch
 		} else if typeString == ActivityStreamsAlias+"Service" {
 			v, err := mgr.DeserializeServiceActivityStreams()(m, aliasMap)
 			if err != nil {
@@ -129,10 +129,26 @@ import (
 	typeview "github.com/go-fed/activity/streams/impl/activitystreams/type_view"
 	propertyid "github.com/go-fed/activity/streams/impl/jsonld/property_id"
 	propertytype "github.com/go-fed/activity/streams/impl/jsonld/property_type"
+	propertycreated "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_created"
+	propertycryptosuite "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_cryptosuite"
+	propertyproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proof"
+	propertyproofpurpose "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proofpurpose"
+	propertyproofvalue "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proofvalue"
+	propertyverificationmethod "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_verificationmethod"
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	propertyassertionmethod "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_assertionmethod"
+	propertycontroller "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_controller"
+	propertypublickeymultibase "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_publickeymultibase"
+	typemultikey "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/type_multikey"
+	propertycreated1 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_created"
+	propertycreator "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_creator"
 	propertyowner "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_owner"
 	propertypublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickey"
 	propertypublickeypem "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickeypem"
+	propertysignature "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_signature"
+	propertysignaturevalue "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_signaturevalue"
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -285,6 +301,19 @@ func (this Manager) DeserializeArticleActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeAssertionMethodPropertyW3IDMultikeyV1 returns the deserialization
+// method for the "W3IDMultikeyV1AssertionMethodProperty" non-functional
+// property in the vocabulary "W3IDMultikeyV1"
+func (this Manager) DeserializeAssertionMethodPropertyW3IDMultikeyV1() func(map[string]interface{}, map[string]string) (vocab.W3IDMultikeyV1AssertionMethodProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDMultikeyV1AssertionMethodProperty, error) {
+		i, err := propertyassertionmethod.DeserializeAssertionMethodProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeAttachmentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsAttachmentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -454,6 +483,19 @@ func (this Manager) DeserializeContextPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeControllerPropertyW3IDMultikeyV1 returns the deserialization method
+// for the "W3IDMultikeyV1ControllerProperty" non-functional property in the
+// vocabulary "W3IDMultikeyV1"
+func (this Manager) DeserializeControllerPropertyW3IDMultikeyV1() func(map[string]interface{}, map[string]string) (vocab.W3IDMultikeyV1ControllerProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDMultikeyV1ControllerProperty, error) {
+		i, err := propertycontroller.DeserializeControllerProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeCreateActivityStreams returns the deserialization method for the
 // "ActivityStreamsCreate" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -467,6 +509,58 @@ func (this Manager) DeserializeCreateActivityStreams() func(map[string]interface
 	}
 }
 
+// DeserializeCreatedPropertyW3IDDataIntegrityV1 returns the deserialization
+// method for the "W3IDDataIntegrityV1CreatedProperty" non-functional property
+// in the vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeCreatedPropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1CreatedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1CreatedProperty, error) {
+		i, err := propertycreated.DeserializeCreatedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCreatedPropertyW3IDSecurityV1 returns the deserialization method for
+// the "W3IDSecurityV1CreatedProperty" non-functional property in the
+// vocabulary "W3IDSecurityV1"
+func (this Manager) DeserializeCreatedPropertyW3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1CreatedProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1CreatedProperty, error) {
+		i, err := propertycreated1.DeserializeCreatedProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCreatorPropertyW3IDSecurityV1 returns the deserialization method for
+// the "W3IDSecurityV1CreatorProperty" non-functional property in the
+// vocabulary "W3IDSecurityV1"
+func (this Manager) DeserializeCreatorPropertyW3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1CreatorProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1CreatorProperty, error) {
+		i, err := propertycreator.DeserializeCreatorProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeCryptosuitePropertyW3IDDataIntegrityV1 returns the deserialization
+// method for the "W3IDDataIntegrityV1CryptosuiteProperty" non-functional
+// property in the vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeCryptosuitePropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1CryptosuiteProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1CryptosuiteProperty, error) {
+		i, err := propertycryptosuite.DeserializeCryptosuiteProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeCurrentPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsCurrentProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -480,6 +574,19 @@ func (this Manager) DeserializeCurrentPropertyActivityStreams() func(map[string]
 	}
 }
 
+// DeserializeDataIntegrityProofW3IDDataIntegrityV1 returns the deserialization
+// method for the "W3IDDataIntegrityV1DataIntegrityProof" non-functional
+// property in the vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeDataIntegrityProofW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1DataIntegrityProof, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1DataIntegrityProof, error) {
+		i, err := typedataintegrityproof.DeserializeDataIntegrityProof(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeDeleteActivityStreams returns the deserialization method for the
 // "ActivityStreamsDelete" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1051,6 +1158,19 @@ func (this Manager) DeserializeMoveActivityStreams() func(map[string]interface{}
 	}
 }
 
+// DeserializeMultikeyW3IDMultikeyV1 returns the deserialization method for the
+// "W3IDMultikeyV1Multikey" non-functional property in the vocabulary
+// "W3IDMultikeyV1"
+func (this Manager) DeserializeMultikeyW3IDMultikeyV1() func(map[string]interface{}, map[string]string) (vocab.W3IDMultikeyV1Multikey, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDMultikeyV1Multikey, error) {
+		i, err := typemultikey.DeserializeMultikey(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeNamePropertyActivityStreams returns the deserialization method for
 // the "ActivityStreamsNameProperty" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1337,6 +1457,58 @@ func (this Manager) DeserializeProfileActivityStreams() func(map[string]interfac
 	}
 }
 
+// DeserializeProofPropertyW3IDDataIntegrityV1 returns the deserialization method
+// for the "W3IDDataIntegrityV1ProofProperty" non-functional property in the
+// vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeProofPropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1ProofProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1ProofProperty, error) {
+		i, err := propertyproof.DeserializeProofProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeProofPurposePropertyW3IDDataIntegrityV1 returns the deserialization
+// method for the "W3IDDataIntegrityV1ProofPurposeProperty" non-functional
+// property in the vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeProofPurposePropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1ProofPurposeProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1ProofPurposeProperty, error) {
+		i, err := propertyproofpurpose.DeserializeProofPurposeProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeProofValuePropertyW3IDDataIntegrityV1 returns the deserialization
+// method for the "W3IDDataIntegrityV1ProofValueProperty" non-functional
+// property in the vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeProofValuePropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1ProofValueProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1ProofValueProperty, error) {
+		i, err := propertyproofvalue.DeserializeProofValueProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializePublicKeyMultibasePropertyW3IDMultikeyV1 returns the deserialization
+// method for the "W3IDMultikeyV1PublicKeyMultibaseProperty" non-functional
+// property in the vocabulary "W3IDMultikeyV1"
+func (this Manager) DeserializePublicKeyMultibasePropertyW3IDMultikeyV1() func(map[string]interface{}, map[string]string) (vocab.W3IDMultikeyV1PublicKeyMultibaseProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDMultikeyV1PublicKeyMultibaseProperty, error) {
+		i, err := propertypublickeymultibase.DeserializePublicKeyMultibaseProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializePublicKeyPemPropertyW3IDSecurityV1 returns the deserialization
 // method for the "W3IDSecurityV1PublicKeyPemProperty" non-functional property
 // in the vocabulary "W3IDSecurityV1"
@@ -1519,6 +1691,19 @@ func (this Manager) DeserializeResultPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeRsaSignature2017W3IDSecurityV1 returns the deserialization method
+// for the "W3IDSecurityV1RsaSignature2017" non-functional property in the
+// vocabulary "W3IDSecurityV1"
+func (this Manager) DeserializeRsaSignature2017W3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1RsaSignature2017, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1RsaSignature2017, error) {
+		i, err := typersasignature2017.DeserializeRsaSignature2017(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeServiceActivityStreams returns the deserialization method for the
 // "ActivityStreamsService" non-functional property in the vocabulary
 // "ActivityStreams"
@@ -1545,6 +1730,32 @@ func (this Manager) DeserializeSharesPropertyActivityStreams() func(map[string]i
 	}
 }
 
+// DeserializeSignaturePropertyW3IDSecurityV1 returns the deserialization method
+// for the "W3IDSecurityV1SignatureProperty" non-functional property in the
+// vocabulary "W3IDSecurityV1"
+func (this Manager) DeserializeSignaturePropertyW3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1SignatureProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1SignatureProperty, error) {
+		i, err := propertysignature.DeserializeSignatureProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
+// DeserializeSignatureValuePropertyW3IDSecurityV1 returns the deserialization
+// method for the "W3IDSecurityV1SignatureValueProperty" non-functional
+// property in the vocabulary "W3IDSecurityV1"
+func (this Manager) DeserializeSignatureValuePropertyW3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1SignatureValueProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDSecurityV1SignatureValueProperty, error) {
+		i, err := propertysignaturevalue.DeserializeSignatureValueProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeStartIndexPropertyActivityStreams returns the deserialization method
 // for the "ActivityStreamsStartIndexProperty" non-functional property in the
 // vocabulary "ActivityStreams"
@@ -1791,6 +2002,20 @@ func (this Manager) DeserializeUrlPropertyActivityStreams() func(map[string]inte
 	}
 }
 
+// DeserializeVerificationMethodPropertyW3IDDataIntegrityV1 returns the
+// deserialization method for the
+// "W3IDDataIntegrityV1VerificationMethodProperty" non-functional property in
+// the vocabulary "W3IDDataIntegrityV1"
+func (this Manager) DeserializeVerificationMethodPropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1VerificationMethodProperty, error) {
+	return func(m map[string]interface{}, aliasMap map[string]string) (vocab.W3IDDataIntegrityV1VerificationMethodProperty, error) {
+		i, err := propertyverificationmethod.DeserializeVerificationMethodProperty(m, aliasMap)
+		if i == nil {
+			return nil, err
+		}
+		return i, err
+	}
+}
+
 // DeserializeVideoActivityStreams returns the deserialization method for the
 // "ActivityStreamsVideo" non-functional property in the vocabulary
 // "ActivityStreams"
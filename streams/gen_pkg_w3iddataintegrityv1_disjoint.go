@@ -0,0 +1,14 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// W3IDDataIntegrityV1DataIntegrityProofIsDisjointWith returns true if
+// DataIntegrityProof is disjoint with the other's type.
+func W3IDDataIntegrityV1DataIntegrityProofIsDisjointWith(other vocab.Type) bool {
+	return typedataintegrityproof.DataIntegrityProofIsDisjointWith(other)
+}
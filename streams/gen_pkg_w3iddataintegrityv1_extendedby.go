@@ -0,0 +1,15 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// W3IDDataIntegrityV1DataIntegrityProofIsExtendedBy returns true if the other's
+// type extends from DataIntegrityProof. Note that it returns false if the
+// types are the same; see the "IsOrExtends" variant instead.
+func W3IDDataIntegrityV1DataIntegrityProofIsExtendedBy(other vocab.Type) bool {
+	return typedataintegrityproof.DataIntegrityProofIsExtendedBy(other)
+}
@@ -0,0 +1,14 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// W3IDDataIntegrityV1W3IDDataIntegrityV1DataIntegrityProofExtends returns true if
+// DataIntegrityProof extends from the other's type.
+func W3IDDataIntegrityV1W3IDDataIntegrityV1DataIntegrityProofExtends(other vocab.Type) bool {
+	return typedataintegrityproof.W3IDDataIntegrityV1DataIntegrityProofExtends(other)
+}
@@ -0,0 +1,15 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// IsOrExtendsW3IDDataIntegrityV1DataIntegrityProof returns true if the other
+// provided type is the DataIntegrityProof type or extends from the
+// DataIntegrityProof type.
+func IsOrExtendsW3IDDataIntegrityV1DataIntegrityProof(other vocab.Type) bool {
+	return typedataintegrityproof.IsOrExtendsDataIntegrityProof(other)
+}
@@ -0,0 +1,49 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	propertycreated "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_created"
+	propertycryptosuite "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_cryptosuite"
+	propertyproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proof"
+	propertyproofpurpose "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proofpurpose"
+	propertyproofvalue "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_proofvalue"
+	propertyverificationmethod "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/property_verificationmethod"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewW3IDDataIntegrityV1W3IDDataIntegrityV1CreatedProperty creates a new
+// W3IDDataIntegrityV1CreatedProperty
+func NewW3IDDataIntegrityV1CreatedProperty() vocab.W3IDDataIntegrityV1CreatedProperty {
+	return propertycreated.NewW3IDDataIntegrityV1CreatedProperty()
+}
+
+// NewW3IDDataIntegrityV1W3IDDataIntegrityV1CryptosuiteProperty creates a new
+// W3IDDataIntegrityV1CryptosuiteProperty
+func NewW3IDDataIntegrityV1CryptosuiteProperty() vocab.W3IDDataIntegrityV1CryptosuiteProperty {
+	return propertycryptosuite.NewW3IDDataIntegrityV1CryptosuiteProperty()
+}
+
+// NewW3IDDataIntegrityV1W3IDDataIntegrityV1ProofProperty creates a new
+// W3IDDataIntegrityV1ProofProperty
+func NewW3IDDataIntegrityV1ProofProperty() vocab.W3IDDataIntegrityV1ProofProperty {
+	return propertyproof.NewW3IDDataIntegrityV1ProofProperty()
+}
+
+// NewW3IDDataIntegrityV1W3IDDataIntegrityV1ProofPurposeProperty creates a new
+// W3IDDataIntegrityV1ProofPurposeProperty
+func NewW3IDDataIntegrityV1ProofPurposeProperty() vocab.W3IDDataIntegrityV1ProofPurposeProperty {
+	return propertyproofpurpose.NewW3IDDataIntegrityV1ProofPurposeProperty()
+}
+
+// NewW3IDDataIntegrityV1W3IDDataIntegrityV1ProofValueProperty creates a new
+// W3IDDataIntegrityV1ProofValueProperty
+func NewW3IDDataIntegrityV1ProofValueProperty() vocab.W3IDDataIntegrityV1ProofValueProperty {
+	return propertyproofvalue.NewW3IDDataIntegrityV1ProofValueProperty()
+}
+
+// NewW3IDDataIntegrityV1W3IDDataIntegrityV1VerificationMethodProperty creates a
+// new W3IDDataIntegrityV1VerificationMethodProperty
+func NewW3IDDataIntegrityV1VerificationMethodProperty() vocab.W3IDDataIntegrityV1VerificationMethodProperty {
+	return propertyverificationmethod.NewW3IDDataIntegrityV1VerificationMethodProperty()
+}
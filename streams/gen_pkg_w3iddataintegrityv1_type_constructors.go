@@ -0,0 +1,14 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typedataintegrityproof "github.com/go-fed/activity/streams/impl/w3iddataintegrityv1/type_dataintegrityproof"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewW3IDDataIntegrityV1DataIntegrityProof creates a new
+// W3IDDataIntegrityV1DataIntegrityProof
+func NewW3IDDataIntegrityV1DataIntegrityProof() vocab.W3IDDataIntegrityV1DataIntegrityProof {
+	return typedataintegrityproof.NewW3IDDataIntegrityV1DataIntegrityProof()
+}
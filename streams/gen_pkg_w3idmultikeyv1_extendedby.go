@@ -0,0 +1,15 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typemultikey "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/type_multikey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// W3IDMultikeyV1MultikeyIsExtendedBy returns true if the other's type extends
+// from Multikey. Note that it returns false if the types are the same; see
+// the "IsOrExtends" variant instead.
+func W3IDMultikeyV1MultikeyIsExtendedBy(other vocab.Type) bool {
+	return typemultikey.MultikeyIsExtendedBy(other)
+}
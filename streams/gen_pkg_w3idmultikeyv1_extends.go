@@ -0,0 +1,14 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typemultikey "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/type_multikey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// W3IDMultikeyV1W3IDMultikeyV1MultikeyExtends returns true if Multikey extends
+// from the other's type.
+func W3IDMultikeyV1W3IDMultikeyV1MultikeyExtends(other vocab.Type) bool {
+	return typemultikey.W3IDMultikeyV1MultikeyExtends(other)
+}
@@ -0,0 +1,28 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	propertyassertionmethod "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_assertionmethod"
+	propertycontroller "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_controller"
+	propertypublickeymultibase "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/property_publickeymultibase"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewW3IDMultikeyV1W3IDMultikeyV1AssertionMethodProperty creates a new
+// W3IDMultikeyV1AssertionMethodProperty
+func NewW3IDMultikeyV1AssertionMethodProperty() vocab.W3IDMultikeyV1AssertionMethodProperty {
+	return propertyassertionmethod.NewW3IDMultikeyV1AssertionMethodProperty()
+}
+
+// NewW3IDMultikeyV1W3IDMultikeyV1ControllerProperty creates a new
+// W3IDMultikeyV1ControllerProperty
+func NewW3IDMultikeyV1ControllerProperty() vocab.W3IDMultikeyV1ControllerProperty {
+	return propertycontroller.NewW3IDMultikeyV1ControllerProperty()
+}
+
+// NewW3IDMultikeyV1W3IDMultikeyV1PublicKeyMultibaseProperty creates a new
+// W3IDMultikeyV1PublicKeyMultibaseProperty
+func NewW3IDMultikeyV1PublicKeyMultibaseProperty() vocab.W3IDMultikeyV1PublicKeyMultibaseProperty {
+	return propertypublickeymultibase.NewW3IDMultikeyV1PublicKeyMultibaseProperty()
+}
@@ -0,0 +1,13 @@
+// Code generated by astool. DO NOT EDIT.
+
+package streams
+
+import (
+	typemultikey "github.com/go-fed/activity/streams/impl/w3idmultikeyv1/type_multikey"
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// NewW3IDMultikeyV1Multikey creates a new W3IDMultikeyV1Multikey
+func NewW3IDMultikeyV1Multikey() vocab.W3IDMultikeyV1Multikey {
+	return typemultikey.NewW3IDMultikeyV1Multikey()
+}
@@ -4,6 +4,7 @@ package streams
 
 import (
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -12,3 +13,9 @@ import (
 func W3IDSecurityV1PublicKeyIsDisjointWith(other vocab.Type) bool {
 	return typepublickey.PublicKeyIsDisjointWith(other)
 }
+
+// W3IDSecurityV1RsaSignature2017IsDisjointWith returns true if RsaSignature2017
+// is disjoint with the other's type.
+func W3IDSecurityV1RsaSignature2017IsDisjointWith(other vocab.Type) bool {
+	return typersasignature2017.RsaSignature2017IsDisjointWith(other)
+}
@@ -4,6 +4,7 @@ package streams
 
 import (
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -13,3 +14,10 @@ import (
 func W3IDSecurityV1PublicKeyIsExtendedBy(other vocab.Type) bool {
 	return typepublickey.PublicKeyIsExtendedBy(other)
 }
+
+// W3IDSecurityV1RsaSignature2017IsExtendedBy returns true if the other's type
+// extends from RsaSignature2017. Note that it returns false if the types are
+// the same; see the "IsOrExtends" variant instead.
+func W3IDSecurityV1RsaSignature2017IsExtendedBy(other vocab.Type) bool {
+	return typersasignature2017.RsaSignature2017IsExtendedBy(other)
+}
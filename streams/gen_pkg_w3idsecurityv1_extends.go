@@ -4,6 +4,7 @@ package streams
 
 import (
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -12,3 +13,9 @@ import (
 func W3IDSecurityV1W3IDSecurityV1PublicKeyExtends(other vocab.Type) bool {
 	return typepublickey.W3IDSecurityV1PublicKeyExtends(other)
 }
+
+// W3IDSecurityV1W3IDSecurityV1RsaSignature2017Extends returns true if
+// RsaSignature2017 extends from the other's type.
+func W3IDSecurityV1W3IDSecurityV1RsaSignature2017Extends(other vocab.Type) bool {
+	return typersasignature2017.W3IDSecurityV1RsaSignature2017Extends(other)
+}
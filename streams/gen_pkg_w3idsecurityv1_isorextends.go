@@ -4,6 +4,7 @@ package streams
 
 import (
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -12,3 +13,9 @@ import (
 func IsOrExtendsW3IDSecurityV1PublicKey(other vocab.Type) bool {
 	return typepublickey.IsOrExtendsPublicKey(other)
 }
+
+// IsOrExtendsW3IDSecurityV1RsaSignature2017 returns true if the other provided
+// type is the RsaSignature2017 type or extends from the RsaSignature2017 type.
+func IsOrExtendsW3IDSecurityV1RsaSignature2017(other vocab.Type) bool {
+	return typersasignature2017.IsOrExtendsRsaSignature2017(other)
+}
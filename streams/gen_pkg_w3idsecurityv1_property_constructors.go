@@ -3,12 +3,28 @@
 package streams
 
 import (
+	propertycreated "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_created"
+	propertycreator "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_creator"
 	propertyowner "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_owner"
 	propertypublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickey"
 	propertypublickeypem "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_publickeypem"
+	propertysignature "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_signature"
+	propertysignaturevalue "github.com/go-fed/activity/streams/impl/w3idsecurityv1/property_signaturevalue"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
+// NewW3IDSecurityV1W3IDSecurityV1CreatedProperty creates a new
+// W3IDSecurityV1CreatedProperty
+func NewW3IDSecurityV1CreatedProperty() vocab.W3IDSecurityV1CreatedProperty {
+	return propertycreated.NewW3IDSecurityV1CreatedProperty()
+}
+
+// NewW3IDSecurityV1W3IDSecurityV1CreatorProperty creates a new
+// W3IDSecurityV1CreatorProperty
+func NewW3IDSecurityV1CreatorProperty() vocab.W3IDSecurityV1CreatorProperty {
+	return propertycreator.NewW3IDSecurityV1CreatorProperty()
+}
+
 // NewW3IDSecurityV1W3IDSecurityV1OwnerProperty creates a new
 // W3IDSecurityV1OwnerProperty
 func NewW3IDSecurityV1OwnerProperty() vocab.W3IDSecurityV1OwnerProperty {
@@ -26,3 +42,15 @@ func NewW3IDSecurityV1PublicKeyProperty() vocab.W3IDSecurityV1PublicKeyProperty
 func NewW3IDSecurityV1PublicKeyPemProperty() vocab.W3IDSecurityV1PublicKeyPemProperty {
 	return propertypublickeypem.NewW3IDSecurityV1PublicKeyPemProperty()
 }
+
+// NewW3IDSecurityV1W3IDSecurityV1SignatureProperty creates a new
+// W3IDSecurityV1SignatureProperty
+func NewW3IDSecurityV1SignatureProperty() vocab.W3IDSecurityV1SignatureProperty {
+	return propertysignature.NewW3IDSecurityV1SignatureProperty()
+}
+
+// NewW3IDSecurityV1W3IDSecurityV1SignatureValueProperty creates a new
+// W3IDSecurityV1SignatureValueProperty
+func NewW3IDSecurityV1SignatureValueProperty() vocab.W3IDSecurityV1SignatureValueProperty {
+	return propertysignaturevalue.NewW3IDSecurityV1SignatureValueProperty()
+}
@@ -4,6 +4,7 @@ package streams
 
 import (
 	typepublickey "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_publickey"
+	typersasignature2017 "github.com/go-fed/activity/streams/impl/w3idsecurityv1/type_rsasignature2017"
 	vocab "github.com/go-fed/activity/streams/vocab"
 )
 
@@ -11,3 +12,8 @@ import (
 func NewW3IDSecurityV1PublicKey() vocab.W3IDSecurityV1PublicKey {
 	return typepublickey.NewW3IDSecurityV1PublicKey()
 }
+
+// NewW3IDSecurityV1RsaSignature2017 creates a new W3IDSecurityV1RsaSignature2017
+func NewW3IDSecurityV1RsaSignature2017() vocab.W3IDSecurityV1RsaSignature2017 {
+	return typersasignature2017.NewW3IDSecurityV1RsaSignature2017()
+}
@@ -82,6 +82,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsCreate) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.W3IDDataIntegrityV1DataIntegrityProof) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsDelete) error {
 		t = i
 		return nil
@@ -136,6 +139,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsMove) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.W3IDMultikeyV1Multikey) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsNote) error {
 		t = i
 		return nil
@@ -184,6 +190,9 @@ func ToType(c context.Context, m map[string]interface{}) (t vocab.Type, err erro
 	}, func(ctx context.Context, i vocab.ActivityStreamsRemove) error {
 		t = i
 		return nil
+	}, func(ctx context.Context, i vocab.W3IDSecurityV1RsaSignature2017) error {
+		t = i
+		return nil
 	}, func(ctx context.Context, i vocab.ActivityStreamsService) error {
 		t = i
 		return nil
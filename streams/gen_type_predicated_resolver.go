@@ -53,6 +53,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsCreate) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.W3IDDataIntegrityV1DataIntegrityProof) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsDelete) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsDislike) (bool, error):
@@ -89,6 +91,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsMove) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.W3IDMultikeyV1Multikey) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsNote) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsObject) (bool, error):
@@ -121,6 +125,8 @@ func NewTypePredicatedResolver(delegate Resolver, predicate interface{}) (*TypeP
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsRemove) (bool, error):
 		// Do nothing, this predicate has a correct signature.
+	case func(context.Context, vocab.W3IDSecurityV1RsaSignature2017) (bool, error):
+		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsService) (bool, error):
 		// Do nothing, this predicate has a correct signature.
 	case func(context.Context, vocab.ActivityStreamsTentativeAccept) (bool, error):
@@ -290,6 +296,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://w3id.org/security/data-integrity/v1" && o.GetTypeName() == "DataIntegrityProof" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.W3IDDataIntegrityV1DataIntegrityProof) (bool, error)); ok {
+			if v, ok := o.(vocab.W3IDDataIntegrityV1DataIntegrityProof); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Delete" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsDelete) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsDelete); ok {
@@ -488,6 +505,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://w3id.org/security/multikey/v1" && o.GetTypeName() == "Multikey" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.W3IDMultikeyV1Multikey) (bool, error)); ok {
+			if v, ok := o.(vocab.W3IDMultikeyV1Multikey); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Note" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsNote) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsNote); ok {
@@ -664,6 +692,17 @@ func (this TypePredicatedResolver) Apply(ctx context.Context, o ActivityStreamsI
 		} else {
 			return false, ErrPredicateUnmatched
 		}
+	} else if o.VocabularyURI() == "https://w3id.org/security/v1" && o.GetTypeName() == "RsaSignature2017" {
+		if fn, ok := this.predicate.(func(context.Context, vocab.W3IDSecurityV1RsaSignature2017) (bool, error)); ok {
+			if v, ok := o.(vocab.W3IDSecurityV1RsaSignature2017); ok {
+				predicatePasses, err = fn(ctx, v)
+			} else {
+				// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+				return false, errCannotTypeAssertType
+			}
+		} else {
+			return false, ErrPredicateUnmatched
+		}
 	} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Service" {
 		if fn, ok := this.predicate.(func(context.Context, vocab.ActivityStreamsService) (bool, error)); ok {
 			if v, ok := o.(vocab.ActivityStreamsService); ok {
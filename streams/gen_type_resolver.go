@@ -52,6 +52,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsCreate) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.W3IDDataIntegrityV1DataIntegrityProof) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDelete) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsDislike) error:
@@ -88,6 +90,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsMove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.W3IDMultikeyV1Multikey) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsNote) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsObject) error:
@@ -120,6 +124,8 @@ func NewTypeResolver(callbacks ...interface{}) (*TypeResolver, error) {
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsRemove) error:
 			// Do nothing, this callback has a correct signature.
+		case func(context.Context, vocab.W3IDSecurityV1RsaSignature2017) error:
+			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsService) error:
 			// Do nothing, this callback has a correct signature.
 		case func(context.Context, vocab.ActivityStreamsTentativeAccept) error:
@@ -261,6 +267,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://w3id.org/security/data-integrity/v1" && o.GetTypeName() == "DataIntegrityProof" {
+			if fn, ok := i.(func(context.Context, vocab.W3IDDataIntegrityV1DataIntegrityProof) error); ok {
+				if v, ok := o.(vocab.W3IDDataIntegrityV1DataIntegrityProof); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Delete" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsDelete) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsDelete); ok {
@@ -423,6 +438,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://w3id.org/security/multikey/v1" && o.GetTypeName() == "Multikey" {
+			if fn, ok := i.(func(context.Context, vocab.W3IDMultikeyV1Multikey) error); ok {
+				if v, ok := o.(vocab.W3IDMultikeyV1Multikey); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Note" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsNote) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsNote); ok {
@@ -567,6 +591,15 @@ func (this TypeResolver) Resolve(ctx context.Context, o ActivityStreamsInterface
 					return errCannotTypeAssertType
 				}
 			}
+		} else if o.VocabularyURI() == "https://w3id.org/security/v1" && o.GetTypeName() == "RsaSignature2017" {
+			if fn, ok := i.(func(context.Context, vocab.W3IDSecurityV1RsaSignature2017) error); ok {
+				if v, ok := o.(vocab.W3IDSecurityV1RsaSignature2017); ok {
+					return fn(ctx, v)
+				} else {
+					// This occurs when the value is either not a go-fed type and is improperly satisfying various interfaces, or there is a bug in the go-fed generated code.
+					return errCannotTypeAssertType
+				}
+			}
 		} else if o.VocabularyURI() == "https://www.w3.org/ns/activitystreams" && o.GetTypeName() == "Service" {
 			if fn, ok := i.(func(context.Context, vocab.ActivityStreamsService) error); ok {
 				if v, ok := o.(vocab.ActivityStreamsService); ok {
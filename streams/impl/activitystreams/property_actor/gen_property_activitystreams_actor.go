@@ -4,6 +4,7 @@ package propertyactor
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
 )
@@ -434,6 +435,127 @@ func deserializeActivityStreamsActorPropertyIterator(i interface{}, aliasMap map
 	return this, nil
 }
 
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsActorPropertyIterator) Equals(o vocab.ActivityStreamsActorPropertyIterator) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Equals(o.GetActivityStreamsObject())
+	} else if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().Equals(o.GetActivityStreamsLink())
+	} else if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Equals(o.GetActivityStreamsAccept())
+	} else if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Equals(o.GetActivityStreamsActivity())
+	} else if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Equals(o.GetActivityStreamsAdd())
+	} else if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Equals(o.GetActivityStreamsAnnounce())
+	} else if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Equals(o.GetActivityStreamsApplication())
+	} else if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Equals(o.GetActivityStreamsArrive())
+	} else if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Equals(o.GetActivityStreamsArticle())
+	} else if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Equals(o.GetActivityStreamsAudio())
+	} else if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Equals(o.GetActivityStreamsBlock())
+	} else if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Equals(o.GetActivityStreamsCollection())
+	} else if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Equals(o.GetActivityStreamsCollectionPage())
+	} else if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Equals(o.GetActivityStreamsCreate())
+	} else if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Equals(o.GetActivityStreamsDelete())
+	} else if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Equals(o.GetActivityStreamsDislike())
+	} else if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Equals(o.GetActivityStreamsDocument())
+	} else if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Equals(o.GetActivityStreamsEvent())
+	} else if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Equals(o.GetActivityStreamsFlag())
+	} else if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Equals(o.GetActivityStreamsFollow())
+	} else if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Equals(o.GetActivityStreamsGroup())
+	} else if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Equals(o.GetActivityStreamsIgnore())
+	} else if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Equals(o.GetActivityStreamsImage())
+	} else if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Equals(o.GetActivityStreamsIntransitiveActivity())
+	} else if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Equals(o.GetActivityStreamsInvite())
+	} else if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Equals(o.GetActivityStreamsJoin())
+	} else if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Equals(o.GetActivityStreamsLeave())
+	} else if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Equals(o.GetActivityStreamsLike())
+	} else if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Equals(o.GetActivityStreamsListen())
+	} else if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().Equals(o.GetActivityStreamsMention())
+	} else if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Equals(o.GetActivityStreamsMove())
+	} else if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Equals(o.GetActivityStreamsNote())
+	} else if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Equals(o.GetActivityStreamsOffer())
+	} else if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Equals(o.GetActivityStreamsOrderedCollection())
+	} else if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Equals(o.GetActivityStreamsOrderedCollectionPage())
+	} else if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Equals(o.GetActivityStreamsOrganization())
+	} else if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Equals(o.GetActivityStreamsPage())
+	} else if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Equals(o.GetActivityStreamsPerson())
+	} else if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Equals(o.GetActivityStreamsPlace())
+	} else if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Equals(o.GetActivityStreamsProfile())
+	} else if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Equals(o.GetActivityStreamsQuestion())
+	} else if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Equals(o.GetActivityStreamsRead())
+	} else if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Equals(o.GetActivityStreamsReject())
+	} else if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Equals(o.GetActivityStreamsRelationship())
+	} else if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Equals(o.GetActivityStreamsRemove())
+	} else if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Equals(o.GetActivityStreamsService())
+	} else if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Equals(o.GetActivityStreamsTentativeAccept())
+	} else if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Equals(o.GetActivityStreamsTentativeReject())
+	} else if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Equals(o.GetActivityStreamsTombstone())
+	} else if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Equals(o.GetActivityStreamsTravel())
+	} else if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Equals(o.GetActivityStreamsUndo())
+	} else if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Equals(o.GetActivityStreamsUpdate())
+	} else if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Equals(o.GetActivityStreamsVideo())
+	} else if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Equals(o.GetActivityStreamsView())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, ok := o.(*ActivityStreamsActorPropertyIterator)
+	return ok && jsonld.EqualValues(this.unknown, rhs.unknown)
+}
+
 // GetActivityStreamsAccept returns the value of this property. When
 // IsActivityStreamsAccept returns false, GetActivityStreamsAccept will return
 // an arbitrary value.
@@ -2492,6 +2614,359 @@ func (this *ActivityStreamsActorPropertyIterator) SetType(t vocab.Type) error {
 	return fmt.Errorf("illegal type to set on ActivityStreamsActor property: %T", t)
 }
 
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsActorPropertyIterator) Validate() error {
+	if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject().Validate()
+	}
+	if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink().Validate()
+	}
+	if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept().Validate()
+	}
+	if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity().Validate()
+	}
+	if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd().Validate()
+	}
+	if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce().Validate()
+	}
+	if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication().Validate()
+	}
+	if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive().Validate()
+	}
+	if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle().Validate()
+	}
+	if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio().Validate()
+	}
+	if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock().Validate()
+	}
+	if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection().Validate()
+	}
+	if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage().Validate()
+	}
+	if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate().Validate()
+	}
+	if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete().Validate()
+	}
+	if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike().Validate()
+	}
+	if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument().Validate()
+	}
+	if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent().Validate()
+	}
+	if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag().Validate()
+	}
+	if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow().Validate()
+	}
+	if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup().Validate()
+	}
+	if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore().Validate()
+	}
+	if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage().Validate()
+	}
+	if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity().Validate()
+	}
+	if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite().Validate()
+	}
+	if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin().Validate()
+	}
+	if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave().Validate()
+	}
+	if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike().Validate()
+	}
+	if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen().Validate()
+	}
+	if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention().Validate()
+	}
+	if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove().Validate()
+	}
+	if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote().Validate()
+	}
+	if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer().Validate()
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection().Validate()
+	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage().Validate()
+	}
+	if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization().Validate()
+	}
+	if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage().Validate()
+	}
+	if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson().Validate()
+	}
+	if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace().Validate()
+	}
+	if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile().Validate()
+	}
+	if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion().Validate()
+	}
+	if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead().Validate()
+	}
+	if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject().Validate()
+	}
+	if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship().Validate()
+	}
+	if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove().Validate()
+	}
+	if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService().Validate()
+	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept().Validate()
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject().Validate()
+	}
+	if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone().Validate()
+	}
+	if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel().Validate()
+	}
+	if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo().Validate()
+	}
+	if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate().Validate()
+	}
+	if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo().Validate()
+	}
+	if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView().Validate()
+	}
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if _, ok := this.unknown.(map[string]interface{}); this.unknown != nil && !ok {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsActorPropertyIterator) Value() interface{} {
+	if this.IsActivityStreamsObject() {
+		return this.GetActivityStreamsObject()
+	}
+	if this.IsActivityStreamsLink() {
+		return this.GetActivityStreamsLink()
+	}
+	if this.IsActivityStreamsAccept() {
+		return this.GetActivityStreamsAccept()
+	}
+	if this.IsActivityStreamsActivity() {
+		return this.GetActivityStreamsActivity()
+	}
+	if this.IsActivityStreamsAdd() {
+		return this.GetActivityStreamsAdd()
+	}
+	if this.IsActivityStreamsAnnounce() {
+		return this.GetActivityStreamsAnnounce()
+	}
+	if this.IsActivityStreamsApplication() {
+		return this.GetActivityStreamsApplication()
+	}
+	if this.IsActivityStreamsArrive() {
+		return this.GetActivityStreamsArrive()
+	}
+	if this.IsActivityStreamsArticle() {
+		return this.GetActivityStreamsArticle()
+	}
+	if this.IsActivityStreamsAudio() {
+		return this.GetActivityStreamsAudio()
+	}
+	if this.IsActivityStreamsBlock() {
+		return this.GetActivityStreamsBlock()
+	}
+	if this.IsActivityStreamsCollection() {
+		return this.GetActivityStreamsCollection()
+	}
+	if this.IsActivityStreamsCollectionPage() {
+		return this.GetActivityStreamsCollectionPage()
+	}
+	if this.IsActivityStreamsCreate() {
+		return this.GetActivityStreamsCreate()
+	}
+	if this.IsActivityStreamsDelete() {
+		return this.GetActivityStreamsDelete()
+	}
+	if this.IsActivityStreamsDislike() {
+		return this.GetActivityStreamsDislike()
+	}
+	if this.IsActivityStreamsDocument() {
+		return this.GetActivityStreamsDocument()
+	}
+	if this.IsActivityStreamsEvent() {
+		return this.GetActivityStreamsEvent()
+	}
+	if this.IsActivityStreamsFlag() {
+		return this.GetActivityStreamsFlag()
+	}
+	if this.IsActivityStreamsFollow() {
+		return this.GetActivityStreamsFollow()
+	}
+	if this.IsActivityStreamsGroup() {
+		return this.GetActivityStreamsGroup()
+	}
+	if this.IsActivityStreamsIgnore() {
+		return this.GetActivityStreamsIgnore()
+	}
+	if this.IsActivityStreamsImage() {
+		return this.GetActivityStreamsImage()
+	}
+	if this.IsActivityStreamsIntransitiveActivity() {
+		return this.GetActivityStreamsIntransitiveActivity()
+	}
+	if this.IsActivityStreamsInvite() {
+		return this.GetActivityStreamsInvite()
+	}
+	if this.IsActivityStreamsJoin() {
+		return this.GetActivityStreamsJoin()
+	}
+	if this.IsActivityStreamsLeave() {
+		return this.GetActivityStreamsLeave()
+	}
+	if this.IsActivityStreamsLike() {
+		return this.GetActivityStreamsLike()
+	}
+	if this.IsActivityStreamsListen() {
+		return this.GetActivityStreamsListen()
+	}
+	if this.IsActivityStreamsMention() {
+		return this.GetActivityStreamsMention()
+	}
+	if this.IsActivityStreamsMove() {
+		return this.GetActivityStreamsMove()
+	}
+	if this.IsActivityStreamsNote() {
+		return this.GetActivityStreamsNote()
+	}
+	if this.IsActivityStreamsOffer() {
+		return this.GetActivityStreamsOffer()
+	}
+	if this.IsActivityStreamsOrderedCollection() {
+		return this.GetActivityStreamsOrderedCollection()
+	}
+	if this.IsActivityStreamsOrderedCollectionPage() {
+		return this.GetActivityStreamsOrderedCollectionPage()
+	}
+	if this.IsActivityStreamsOrganization() {
+		return this.GetActivityStreamsOrganization()
+	}
+	if this.IsActivityStreamsPage() {
+		return this.GetActivityStreamsPage()
+	}
+	if this.IsActivityStreamsPerson() {
+		return this.GetActivityStreamsPerson()
+	}
+	if this.IsActivityStreamsPlace() {
+		return this.GetActivityStreamsPlace()
+	}
+	if this.IsActivityStreamsProfile() {
+		return this.GetActivityStreamsProfile()
+	}
+	if this.IsActivityStreamsQuestion() {
+		return this.GetActivityStreamsQuestion()
+	}
+	if this.IsActivityStreamsRead() {
+		return this.GetActivityStreamsRead()
+	}
+	if this.IsActivityStreamsReject() {
+		return this.GetActivityStreamsReject()
+	}
+	if this.IsActivityStreamsRelationship() {
+		return this.GetActivityStreamsRelationship()
+	}
+	if this.IsActivityStreamsRemove() {
+		return this.GetActivityStreamsRemove()
+	}
+	if this.IsActivityStreamsService() {
+		return this.GetActivityStreamsService()
+	}
+	if this.IsActivityStreamsTentativeAccept() {
+		return this.GetActivityStreamsTentativeAccept()
+	}
+	if this.IsActivityStreamsTentativeReject() {
+		return this.GetActivityStreamsTentativeReject()
+	}
+	if this.IsActivityStreamsTombstone() {
+		return this.GetActivityStreamsTombstone()
+	}
+	if this.IsActivityStreamsTravel() {
+		return this.GetActivityStreamsTravel()
+	}
+	if this.IsActivityStreamsUndo() {
+		return this.GetActivityStreamsUndo()
+	}
+	if this.IsActivityStreamsUpdate() {
+		return this.GetActivityStreamsUpdate()
+	}
+	if this.IsActivityStreamsVideo() {
+		return this.GetActivityStreamsVideo()
+	}
+	if this.IsActivityStreamsView() {
+		return this.GetActivityStreamsView()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
+
 // clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *ActivityStreamsActorPropertyIterator) clear() {
@@ -2553,6 +3028,183 @@ func (this *ActivityStreamsActorPropertyIterator) clear() {
 	this.iri = nil
 }
 
+// clone returns a deep copy of this iterator, belonging to the parent property.
+func (this ActivityStreamsActorPropertyIterator) clone(parent vocab.ActivityStreamsActorProperty) *ActivityStreamsActorPropertyIterator {
+	c := &ActivityStreamsActorPropertyIterator{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	if this.activitystreamsObjectMember != nil {
+		c.activitystreamsObjectMember = this.activitystreamsObjectMember.Clone()
+	}
+	if this.activitystreamsLinkMember != nil {
+		c.activitystreamsLinkMember = this.activitystreamsLinkMember.Clone()
+	}
+	if this.activitystreamsAcceptMember != nil {
+		c.activitystreamsAcceptMember = this.activitystreamsAcceptMember.Clone()
+	}
+	if this.activitystreamsActivityMember != nil {
+		c.activitystreamsActivityMember = this.activitystreamsActivityMember.Clone()
+	}
+	if this.activitystreamsAddMember != nil {
+		c.activitystreamsAddMember = this.activitystreamsAddMember.Clone()
+	}
+	if this.activitystreamsAnnounceMember != nil {
+		c.activitystreamsAnnounceMember = this.activitystreamsAnnounceMember.Clone()
+	}
+	if this.activitystreamsApplicationMember != nil {
+		c.activitystreamsApplicationMember = this.activitystreamsApplicationMember.Clone()
+	}
+	if this.activitystreamsArriveMember != nil {
+		c.activitystreamsArriveMember = this.activitystreamsArriveMember.Clone()
+	}
+	if this.activitystreamsArticleMember != nil {
+		c.activitystreamsArticleMember = this.activitystreamsArticleMember.Clone()
+	}
+	if this.activitystreamsAudioMember != nil {
+		c.activitystreamsAudioMember = this.activitystreamsAudioMember.Clone()
+	}
+	if this.activitystreamsBlockMember != nil {
+		c.activitystreamsBlockMember = this.activitystreamsBlockMember.Clone()
+	}
+	if this.activitystreamsCollectionMember != nil {
+		c.activitystreamsCollectionMember = this.activitystreamsCollectionMember.Clone()
+	}
+	if this.activitystreamsCollectionPageMember != nil {
+		c.activitystreamsCollectionPageMember = this.activitystreamsCollectionPageMember.Clone()
+	}
+	if this.activitystreamsCreateMember != nil {
+		c.activitystreamsCreateMember = this.activitystreamsCreateMember.Clone()
+	}
+	if this.activitystreamsDeleteMember != nil {
+		c.activitystreamsDeleteMember = this.activitystreamsDeleteMember.Clone()
+	}
+	if this.activitystreamsDislikeMember != nil {
+		c.activitystreamsDislikeMember = this.activitystreamsDislikeMember.Clone()
+	}
+	if this.activitystreamsDocumentMember != nil {
+		c.activitystreamsDocumentMember = this.activitystreamsDocumentMember.Clone()
+	}
+	if this.activitystreamsEventMember != nil {
+		c.activitystreamsEventMember = this.activitystreamsEventMember.Clone()
+	}
+	if this.activitystreamsFlagMember != nil {
+		c.activitystreamsFlagMember = this.activitystreamsFlagMember.Clone()
+	}
+	if this.activitystreamsFollowMember != nil {
+		c.activitystreamsFollowMember = this.activitystreamsFollowMember.Clone()
+	}
+	if this.activitystreamsGroupMember != nil {
+		c.activitystreamsGroupMember = this.activitystreamsGroupMember.Clone()
+	}
+	if this.activitystreamsIgnoreMember != nil {
+		c.activitystreamsIgnoreMember = this.activitystreamsIgnoreMember.Clone()
+	}
+	if this.activitystreamsImageMember != nil {
+		c.activitystreamsImageMember = this.activitystreamsImageMember.Clone()
+	}
+	if this.activitystreamsIntransitiveActivityMember != nil {
+		c.activitystreamsIntransitiveActivityMember = this.activitystreamsIntransitiveActivityMember.Clone()
+	}
+	if this.activitystreamsInviteMember != nil {
+		c.activitystreamsInviteMember = this.activitystreamsInviteMember.Clone()
+	}
+	if this.activitystreamsJoinMember != nil {
+		c.activitystreamsJoinMember = this.activitystreamsJoinMember.Clone()
+	}
+	if this.activitystreamsLeaveMember != nil {
+		c.activitystreamsLeaveMember = this.activitystreamsLeaveMember.Clone()
+	}
+	if this.activitystreamsLikeMember != nil {
+		c.activitystreamsLikeMember = this.activitystreamsLikeMember.Clone()
+	}
+	if this.activitystreamsListenMember != nil {
+		c.activitystreamsListenMember = this.activitystreamsListenMember.Clone()
+	}
+	if this.activitystreamsMentionMember != nil {
+		c.activitystreamsMentionMember = this.activitystreamsMentionMember.Clone()
+	}
+	if this.activitystreamsMoveMember != nil {
+		c.activitystreamsMoveMember = this.activitystreamsMoveMember.Clone()
+	}
+	if this.activitystreamsNoteMember != nil {
+		c.activitystreamsNoteMember = this.activitystreamsNoteMember.Clone()
+	}
+	if this.activitystreamsOfferMember != nil {
+		c.activitystreamsOfferMember = this.activitystreamsOfferMember.Clone()
+	}
+	if this.activitystreamsOrderedCollectionMember != nil {
+		c.activitystreamsOrderedCollectionMember = this.activitystreamsOrderedCollectionMember.Clone()
+	}
+	if this.activitystreamsOrderedCollectionPageMember != nil {
+		c.activitystreamsOrderedCollectionPageMember = this.activitystreamsOrderedCollectionPageMember.Clone()
+	}
+	if this.activitystreamsOrganizationMember != nil {
+		c.activitystreamsOrganizationMember = this.activitystreamsOrganizationMember.Clone()
+	}
+	if this.activitystreamsPageMember != nil {
+		c.activitystreamsPageMember = this.activitystreamsPageMember.Clone()
+	}
+	if this.activitystreamsPersonMember != nil {
+		c.activitystreamsPersonMember = this.activitystreamsPersonMember.Clone()
+	}
+	if this.activitystreamsPlaceMember != nil {
+		c.activitystreamsPlaceMember = this.activitystreamsPlaceMember.Clone()
+	}
+	if this.activitystreamsProfileMember != nil {
+		c.activitystreamsProfileMember = this.activitystreamsProfileMember.Clone()
+	}
+	if this.activitystreamsQuestionMember != nil {
+		c.activitystreamsQuestionMember = this.activitystreamsQuestionMember.Clone()
+	}
+	if this.activitystreamsReadMember != nil {
+		c.activitystreamsReadMember = this.activitystreamsReadMember.Clone()
+	}
+	if this.activitystreamsRejectMember != nil {
+		c.activitystreamsRejectMember = this.activitystreamsRejectMember.Clone()
+	}
+	if this.activitystreamsRelationshipMember != nil {
+		c.activitystreamsRelationshipMember = this.activitystreamsRelationshipMember.Clone()
+	}
+	if this.activitystreamsRemoveMember != nil {
+		c.activitystreamsRemoveMember = this.activitystreamsRemoveMember.Clone()
+	}
+	if this.activitystreamsServiceMember != nil {
+		c.activitystreamsServiceMember = this.activitystreamsServiceMember.Clone()
+	}
+	if this.activitystreamsTentativeAcceptMember != nil {
+		c.activitystreamsTentativeAcceptMember = this.activitystreamsTentativeAcceptMember.Clone()
+	}
+	if this.activitystreamsTentativeRejectMember != nil {
+		c.activitystreamsTentativeRejectMember = this.activitystreamsTentativeRejectMember.Clone()
+	}
+	if this.activitystreamsTombstoneMember != nil {
+		c.activitystreamsTombstoneMember = this.activitystreamsTombstoneMember.Clone()
+	}
+	if this.activitystreamsTravelMember != nil {
+		c.activitystreamsTravelMember = this.activitystreamsTravelMember.Clone()
+	}
+	if this.activitystreamsUndoMember != nil {
+		c.activitystreamsUndoMember = this.activitystreamsUndoMember.Clone()
+	}
+	if this.activitystreamsUpdateMember != nil {
+		c.activitystreamsUpdateMember = this.activitystreamsUpdateMember.Clone()
+	}
+	if this.activitystreamsVideoMember != nil {
+		c.activitystreamsVideoMember = this.activitystreamsVideoMember.Clone()
+	}
+	if this.activitystreamsViewMember != nil {
+		c.activitystreamsViewMember = this.activitystreamsViewMember.Clone()
+	}
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	c.myIdx = this.myIdx
+	c.parent = parent
+	return c
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -3375,6 +4027,19 @@ func (this ActivityStreamsActorProperty) Begin() vocab.ActivityStreamsActorPrope
 	}
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Each of its values is copied.
+func (this ActivityStreamsActorProperty) Clone() vocab.ActivityStreamsActorProperty {
+	c := &ActivityStreamsActorProperty{alias: this.alias}
+	if this.properties != nil {
+		c.properties = make([]*ActivityStreamsActorPropertyIterator, len(this.properties))
+		for i, it := range this.properties {
+			c.properties[i] = it.clone(c)
+		}
+	}
+	return c
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsActorProperty) Empty() bool {
 	return this.Len() == 0
@@ -3387,6 +4052,20 @@ func (this ActivityStreamsActorProperty) End() vocab.ActivityStreamsActorPropert
 	return nil
 }
 
+// Equals determines whether this property has the same values as the other, in
+// the same order.
+func (this ActivityStreamsActorProperty) Equals(o vocab.ActivityStreamsActorProperty) bool {
+	if o == nil || this.Len() != o.Len() {
+		return false
+	}
+	for i, it := range this.properties {
+		if !it.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // InsertActivityStreamsAccept inserts a Accept value at the specified index for a
 // property "actor". Existing elements at that index and higher are shifted
 // back once. Invalidates all iterators.
@@ -5447,7 +6126,9 @@ func (this *ActivityStreamsActorProperty) Remove(idx int) {
 // properties. It is exposed for alternatives to go-fed implementations to use.
 func (this ActivityStreamsActorProperty) Serialize() (interface{}, error) {
 	s := make([]interface{}, 0, len(this.properties))
+
 	for _, iterator := range this.properties {
+
 		if b, err := iterator.serialize(); err != nil {
 			return s, err
 		} else {
@@ -6195,3 +6876,26 @@ func (this *ActivityStreamsActorProperty) SetType(idx int, t vocab.Type) error {
 func (this ActivityStreamsActorProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
 }
+
+// Validate returns an error naming the first value of this property that is out
+// of its range, or is an IRI that is not absolute.
+func (this ActivityStreamsActorProperty) Validate() error {
+	for i, it := range this.properties {
+		if err := it.Validate(); err != nil {
+			return fmt.Errorf("value %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Values returns the values of this property in order, as returned by the Value
+// method of each of them.
+func (this ActivityStreamsActorProperty) Values() []interface{} {
+	v := make([]interface{}, 0, len(this.properties))
+	for _, it := range this.properties {
+		if value := it.Value(); value != nil {
+			v = append(v, value)
+		}
+	}
+	return v
+}
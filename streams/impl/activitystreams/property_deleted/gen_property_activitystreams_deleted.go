@@ -4,6 +4,7 @@ package propertydeleted
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	datetime "github.com/go-fed/activity/streams/values/dateTime"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
@@ -77,6 +78,37 @@ func (this *ActivityStreamsDeletedProperty) Clear() {
 	this.hasDateTimeMember = false
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this ActivityStreamsDeletedProperty) Clone() vocab.ActivityStreamsDeletedProperty {
+	c := &ActivityStreamsDeletedProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	c.xmlschemaDateTimeMember = this.xmlschemaDateTimeMember
+	c.hasDateTimeMember = this.hasDateTimeMember
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsDeletedProperty) Equals(o vocab.ActivityStreamsDeletedProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaDateTime() {
+		return !datetime.LessDateTime(this.Get(), o.Get()) && !datetime.LessDateTime(o.Get(), this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
 // Get returns the value of this property. When IsXMLSchemaDateTime returns false,
 // Get will return any arbitrary value.
 func (this ActivityStreamsDeletedProperty) Get() time.Time {
@@ -202,3 +234,35 @@ func (this *ActivityStreamsDeletedProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsDeletedProperty) Validate() error {
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsDeletedProperty) Value() interface{} {
+	if this.IsXMLSchemaDateTime() {
+		return this.Get()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
@@ -4,6 +4,7 @@ package propertyhref
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
@@ -60,6 +61,33 @@ func (this *ActivityStreamsHrefProperty) Clear() {
 	this.xmlschemaAnyURIMember = nil
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this ActivityStreamsHrefProperty) Clone() vocab.ActivityStreamsHrefProperty {
+	c := &ActivityStreamsHrefProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		c.xmlschemaAnyURIMember = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsHrefProperty) Equals(o vocab.ActivityStreamsHrefProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.Get(), o.Get()) && !anyuri.LessAnyURI(o.Get(), this.Get())
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
 // Get returns the value of this property. When IsXMLSchemaAnyURI returns false,
 // Get will return any arbitrary value.
 func (this ActivityStreamsHrefProperty) Get() *url.URL {
@@ -179,3 +207,32 @@ func (this *ActivityStreamsHrefProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.Set(v)
 }
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsHrefProperty) Validate() error {
+	if this.IsXMLSchemaAnyURI() {
+		if !this.Get().IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.Get())
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsHrefProperty) Value() interface{} {
+	if this.IsXMLSchemaAnyURI() {
+		return this.Get()
+	}
+
+	return this.unknown
+}
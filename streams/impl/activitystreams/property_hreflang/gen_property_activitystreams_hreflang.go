@@ -4,6 +4,7 @@ package propertyhreflang
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	bcp47 "github.com/go-fed/activity/streams/values/bcp47"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
@@ -76,6 +77,37 @@ func (this *ActivityStreamsHreflangProperty) Clear() {
 	this.hasBcp47Member = false
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this ActivityStreamsHreflangProperty) Clone() vocab.ActivityStreamsHreflangProperty {
+	c := &ActivityStreamsHreflangProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	c.rfcBcp47Member = this.rfcBcp47Member
+	c.hasBcp47Member = this.hasBcp47Member
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsHreflangProperty) Equals(o vocab.ActivityStreamsHreflangProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsRFCBcp47() {
+		return !bcp47.LessBcp47(this.Get(), o.Get()) && !bcp47.LessBcp47(o.Get(), this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
 // Get returns the value of this property. When IsRFCBcp47 returns false, Get will
 // return any arbitrary value.
 func (this ActivityStreamsHreflangProperty) Get() string {
@@ -201,3 +233,35 @@ func (this *ActivityStreamsHreflangProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsHreflangProperty) Validate() error {
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsHreflangProperty) Value() interface{} {
+	if this.IsRFCBcp47() {
+		return this.Get()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
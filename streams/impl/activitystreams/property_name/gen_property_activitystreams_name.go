@@ -4,6 +4,7 @@ package propertyname
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	langstring "github.com/go-fed/activity/streams/values/langString"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
@@ -72,6 +73,23 @@ func deserializeActivityStreamsNamePropertyIterator(i interface{}, aliasMap map[
 	return this, nil
 }
 
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsNamePropertyIterator) Equals(o vocab.ActivityStreamsNamePropertyIterator) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	} else if this.IsRDFLangString() {
+		return !langstring.LessLangString(this.GetRDFLangString(), o.GetRDFLangString()) && !langstring.LessLangString(o.GetRDFLangString(), this.GetRDFLangString())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, ok := o.(*ActivityStreamsNamePropertyIterator)
+	return ok && jsonld.EqualValues(this.unknown, rhs.unknown)
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsNamePropertyIterator) GetIRI() *url.URL {
@@ -263,6 +281,41 @@ func (this *ActivityStreamsNamePropertyIterator) SetXMLSchemaString(v string) {
 	this.hasStringMember = true
 }
 
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsNamePropertyIterator) Validate() error {
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsNamePropertyIterator) Value() interface{} {
+	if this.IsXMLSchemaString() {
+		return this.GetXMLSchemaString()
+	}
+	if this.IsRDFLangString() {
+		return this.GetRDFLangString()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
+
 // clear ensures no value and no language map for this property is set. Calling
 // HasAny or any of the 'Is' methods afterwards will return false.
 func (this *ActivityStreamsNamePropertyIterator) clear() {
@@ -273,6 +326,29 @@ func (this *ActivityStreamsNamePropertyIterator) clear() {
 	this.rdfLangStringMember = nil
 }
 
+// clone returns a deep copy of this iterator, belonging to the parent property.
+func (this ActivityStreamsNamePropertyIterator) clone(parent vocab.ActivityStreamsNameProperty) *ActivityStreamsNamePropertyIterator {
+	c := &ActivityStreamsNamePropertyIterator{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	c.xmlschemaStringMember = this.xmlschemaStringMember
+	c.hasStringMember = this.hasStringMember
+	if this.rdfLangStringMember != nil {
+		c.rdfLangStringMember = make(map[string]string, len(this.rdfLangStringMember))
+		for k, v := range this.rdfLangStringMember {
+			c.rdfLangStringMember[k] = v
+		}
+	}
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	c.myIdx = this.myIdx
+	c.parent = parent
+	return c
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -307,9 +383,15 @@ func DeserializeNameProperty(m map[string]interface{}, aliasMap map[string]strin
 		propName = fmt.Sprintf("%s:%s", alias, "name")
 	}
 	i, ok := m[propName]
-	if !ok {
-		// Attempt to find the map instead.
-		i, ok = m[propName+"Map"]
+	if mi, mok := m[propName+"Map"]; mok {
+		// Read the map after any plain values, as both may be present.
+		if !ok {
+			i, ok = mi, true
+		} else if list, isList := i.([]interface{}); isList {
+			i = append(list, mi)
+		} else {
+			i = []interface{}{i, mi}
+		}
 	}
 	if ok {
 		this := &ActivityStreamsNameProperty{
@@ -396,6 +478,19 @@ func (this ActivityStreamsNameProperty) Begin() vocab.ActivityStreamsNamePropert
 	}
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Each of its values is copied.
+func (this ActivityStreamsNameProperty) Clone() vocab.ActivityStreamsNameProperty {
+	c := &ActivityStreamsNameProperty{alias: this.alias}
+	if this.properties != nil {
+		c.properties = make([]*ActivityStreamsNamePropertyIterator, len(this.properties))
+		for i, it := range this.properties {
+			c.properties[i] = it.clone(c)
+		}
+	}
+	return c
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsNameProperty) Empty() bool {
 	return this.Len() == 0
@@ -408,6 +503,20 @@ func (this ActivityStreamsNameProperty) End() vocab.ActivityStreamsNamePropertyI
 	return nil
 }
 
+// Equals determines whether this property has the same values as the other, in
+// the same order.
+func (this ActivityStreamsNameProperty) Equals(o vocab.ActivityStreamsNameProperty) bool {
+	if o == nil || this.Len() != o.Len() {
+		return false
+	}
+	for i, it := range this.properties {
+		if !it.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "name".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -608,7 +717,12 @@ func (this *ActivityStreamsNameProperty) Remove(idx int) {
 // properties. It is exposed for alternatives to go-fed implementations to use.
 func (this ActivityStreamsNameProperty) Serialize() (interface{}, error) {
 	s := make([]interface{}, 0, len(this.properties))
-	for _, iterator := range this.properties {
+	mapIdx := this.languageMapIndex()
+	for idx, iterator := range this.properties {
+		if idx == mapIdx {
+			// Serialized by SerializeMap instead.
+			continue
+		}
 		if b, err := iterator.serialize(); err != nil {
 			return s, err
 		} else {
@@ -622,6 +736,19 @@ func (this ActivityStreamsNameProperty) Serialize() (interface{}, error) {
 	return s, nil
 }
 
+// SerializeMap converts the langString value into an interface representation to
+// serialize as the "nameMap" property, alongside the other values serialized
+// as the "name" property. It returns nil if there is no such value, in which
+// case Serialize serializes all of the values. Applications should not need
+// this function as most typical use cases serialize types instead of
+// individual properties.
+func (this ActivityStreamsNameProperty) SerializeMap() (interface{}, error) {
+	if idx := this.languageMapIndex(); idx >= 0 {
+		return this.properties[idx].serialize()
+	}
+	return nil, nil
+}
+
 // SetIRI sets an IRI value to be at the specified index for the property "name".
 // Panics if the index is out of bounds.
 func (this *ActivityStreamsNameProperty) SetIRI(idx int, v *url.URL) {
@@ -665,3 +792,45 @@ func (this *ActivityStreamsNameProperty) SetXMLSchemaString(idx int, v string) {
 func (this ActivityStreamsNameProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
 }
+
+// Validate returns an error naming the first value of this property that is out
+// of its range, or is an IRI that is not absolute.
+func (this ActivityStreamsNameProperty) Validate() error {
+	for i, it := range this.properties {
+		if err := it.Validate(); err != nil {
+			return fmt.Errorf("value %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Values returns the values of this property in order, as returned by the Value
+// method of each of them.
+func (this ActivityStreamsNameProperty) Values() []interface{} {
+	v := make([]interface{}, 0, len(this.properties))
+	for _, it := range this.properties {
+		if value := it.Value(); value != nil {
+			v = append(v, value)
+		}
+	}
+	return v
+}
+
+// languageMapIndex returns the index of the only langString value when there are
+// other values as well, which is serialized as the "nameMap" property
+// alongside them. It returns -1 otherwise.
+func (this ActivityStreamsNameProperty) languageMapIndex() int {
+	mapIdx := -1
+	if this.Len() < 2 {
+		return mapIdx
+	}
+	for idx, iterator := range this.properties {
+		if !iterator.IsRDFLangString() {
+			continue
+		} else if mapIdx >= 0 {
+			return -1
+		}
+		mapIdx = idx
+	}
+	return mapIdx
+}
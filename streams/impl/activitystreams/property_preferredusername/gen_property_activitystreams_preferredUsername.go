@@ -4,6 +4,7 @@ package propertypreferredusername
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	langstring "github.com/go-fed/activity/streams/values/langString"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
@@ -95,6 +96,45 @@ func (this *ActivityStreamsPreferredUsernameProperty) Clear() {
 	this.rdfLangStringMember = nil
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this ActivityStreamsPreferredUsernameProperty) Clone() vocab.ActivityStreamsPreferredUsernameProperty {
+	c := &ActivityStreamsPreferredUsernameProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	c.xmlschemaStringMember = this.xmlschemaStringMember
+	c.hasStringMember = this.hasStringMember
+	if this.rdfLangStringMember != nil {
+		c.rdfLangStringMember = make(map[string]string, len(this.rdfLangStringMember))
+		for k, v := range this.rdfLangStringMember {
+			c.rdfLangStringMember[k] = v
+		}
+	}
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsPreferredUsernameProperty) Equals(o vocab.ActivityStreamsPreferredUsernameProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	} else if this.IsRDFLangString() {
+		return !langstring.LessLangString(this.GetRDFLangString(), o.GetRDFLangString()) && !langstring.LessLangString(o.GetRDFLangString(), this.GetRDFLangString())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsPreferredUsernameProperty) GetIRI() *url.URL {
@@ -282,3 +322,38 @@ func (this *ActivityStreamsPreferredUsernameProperty) SetXMLSchemaString(v strin
 	this.xmlschemaStringMember = v
 	this.hasStringMember = true
 }
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsPreferredUsernameProperty) Validate() error {
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsPreferredUsernameProperty) Value() interface{} {
+	if this.IsXMLSchemaString() {
+		return this.GetXMLSchemaString()
+	}
+	if this.IsRDFLangString() {
+		return this.GetRDFLangString()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
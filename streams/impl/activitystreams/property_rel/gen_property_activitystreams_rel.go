@@ -4,6 +4,7 @@ package propertyrel
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	rfc5988 "github.com/go-fed/activity/streams/values/rfc5988"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
@@ -60,6 +61,21 @@ func deserializeActivityStreamsRelPropertyIterator(i interface{}, aliasMap map[s
 	return this, nil
 }
 
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsRelPropertyIterator) Equals(o vocab.ActivityStreamsRelPropertyIterator) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsRFCRfc5988() {
+		return !rfc5988.LessRfc5988(this.Get(), o.Get()) && !rfc5988.LessRfc5988(o.Get(), this.Get())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, ok := o.(*ActivityStreamsRelPropertyIterator)
+	return ok && jsonld.EqualValues(this.unknown, rhs.unknown)
+}
+
 // Get returns the value of this property. When IsRFCRfc5988 returns false, Get
 // will return any arbitrary value.
 func (this ActivityStreamsRelPropertyIterator) Get() string {
@@ -191,6 +207,38 @@ func (this *ActivityStreamsRelPropertyIterator) SetIRI(v *url.URL) {
 	this.iri = v
 }
 
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsRelPropertyIterator) Validate() error {
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsRelPropertyIterator) Value() interface{} {
+	if this.IsRFCRfc5988() {
+		return this.Get()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
+
 // clear ensures no value of this property is set. Calling IsRFCRfc5988 afterwards
 // will return false.
 func (this *ActivityStreamsRelPropertyIterator) clear() {
@@ -199,6 +247,23 @@ func (this *ActivityStreamsRelPropertyIterator) clear() {
 	this.hasRfc5988Member = false
 }
 
+// clone returns a deep copy of this iterator, belonging to the parent property.
+func (this ActivityStreamsRelPropertyIterator) clone(parent vocab.ActivityStreamsRelProperty) *ActivityStreamsRelPropertyIterator {
+	c := &ActivityStreamsRelPropertyIterator{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	c.rfcRfc5988Member = this.rfcRfc5988Member
+	c.hasRfc5988Member = this.hasRfc5988Member
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	c.myIdx = this.myIdx
+	c.parent = parent
+	return c
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -306,6 +371,19 @@ func (this ActivityStreamsRelProperty) Begin() vocab.ActivityStreamsRelPropertyI
 	}
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Each of its values is copied.
+func (this ActivityStreamsRelProperty) Clone() vocab.ActivityStreamsRelProperty {
+	c := &ActivityStreamsRelProperty{alias: this.alias}
+	if this.properties != nil {
+		c.properties = make([]*ActivityStreamsRelPropertyIterator, len(this.properties))
+		for i, it := range this.properties {
+			c.properties[i] = it.clone(c)
+		}
+	}
+	return c
+}
+
 // Empty returns returns true if there are no elements.
 func (this ActivityStreamsRelProperty) Empty() bool {
 	return this.Len() == 0
@@ -318,6 +396,20 @@ func (this ActivityStreamsRelProperty) End() vocab.ActivityStreamsRelPropertyIte
 	return nil
 }
 
+// Equals determines whether this property has the same values as the other, in
+// the same order.
+func (this ActivityStreamsRelProperty) Equals(o vocab.ActivityStreamsRelProperty) bool {
+	if o == nil || this.Len() != o.Len() {
+		return false
+	}
+	for i, it := range this.properties {
+		if !it.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "rel".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -483,7 +575,9 @@ func (this *ActivityStreamsRelProperty) Remove(idx int) {
 // properties. It is exposed for alternatives to go-fed implementations to use.
 func (this ActivityStreamsRelProperty) Serialize() (interface{}, error) {
 	s := make([]interface{}, 0, len(this.properties))
+
 	for _, iterator := range this.properties {
+
 		if b, err := iterator.serialize(); err != nil {
 			return s, err
 		} else {
@@ -526,3 +620,26 @@ func (this *ActivityStreamsRelProperty) SetIRI(idx int, v *url.URL) {
 func (this ActivityStreamsRelProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
 }
+
+// Validate returns an error naming the first value of this property that is out
+// of its range, or is an IRI that is not absolute.
+func (this ActivityStreamsRelProperty) Validate() error {
+	for i, it := range this.properties {
+		if err := it.Validate(); err != nil {
+			return fmt.Errorf("value %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Values returns the values of this property in order, as returned by the Value
+// method of each of them.
+func (this ActivityStreamsRelProperty) Values() []interface{} {
+	v := make([]interface{}, 0, len(this.properties))
+	for _, it := range this.properties {
+		if value := it.Value(); value != nil {
+			v = append(v, value)
+		}
+	}
+	return v
+}
@@ -4,6 +4,7 @@ package propertyunits
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
@@ -74,6 +75,37 @@ func (this *ActivityStreamsUnitsProperty) Clear() {
 	this.unknown = nil
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this ActivityStreamsUnitsProperty) Clone() vocab.ActivityStreamsUnitsProperty {
+	c := &ActivityStreamsUnitsProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	c.xmlschemaStringMember = this.xmlschemaStringMember
+	c.hasStringMember = this.hasStringMember
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		c.xmlschemaAnyURIMember = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this ActivityStreamsUnitsProperty) Equals(o vocab.ActivityStreamsUnitsProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	} else if this.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI()) && !anyuri.LessAnyURI(o.GetXMLSchemaAnyURI(), this.GetXMLSchemaAnyURI())
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this ActivityStreamsUnitsProperty) GetIRI() *url.URL {
@@ -213,3 +245,35 @@ func (this *ActivityStreamsUnitsProperty) SetXMLSchemaString(v string) {
 	this.xmlschemaStringMember = v
 	this.hasStringMember = true
 }
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this ActivityStreamsUnitsProperty) Validate() error {
+	if this.IsXMLSchemaAnyURI() {
+		if !this.GetXMLSchemaAnyURI().IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.GetXMLSchemaAnyURI())
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this ActivityStreamsUnitsProperty) Value() interface{} {
+	if this.IsXMLSchemaString() {
+		return this.GetXMLSchemaString()
+	}
+	if this.IsXMLSchemaAnyURI() {
+		return this.GetXMLSchemaAnyURI()
+	}
+
+	return this.unknown
+}
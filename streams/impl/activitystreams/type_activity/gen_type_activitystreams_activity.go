@@ -4,7 +4,9 @@ package typeactivity
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
 	"strings"
 )
 
@@ -53,10 +55,12 @@ type ActivityStreamsActivity struct {
 	ActivityStreamsObject       vocab.ActivityStreamsObjectProperty
 	ActivityStreamsOrigin       vocab.ActivityStreamsOriginProperty
 	ActivityStreamsPreview      vocab.ActivityStreamsPreviewProperty
+	W3IDDataIntegrityV1Proof    vocab.W3IDDataIntegrityV1ProofProperty
 	ActivityStreamsPublished    vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies      vocab.ActivityStreamsRepliesProperty
 	ActivityStreamsResult       vocab.ActivityStreamsResultProperty
 	ActivityStreamsShares       vocab.ActivityStreamsSharesProperty
+	W3IDSecurityV1Signature     vocab.W3IDSecurityV1SignatureProperty
 	ActivityStreamsStartTime    vocab.ActivityStreamsStartTimeProperty
 	ActivityStreamsSummary      vocab.ActivityStreamsSummaryProperty
 	ActivityStreamsTag          vocab.ActivityStreamsTagProperty
@@ -268,6 +272,11 @@ func DeserializeActivity(m map[string]interface{}, aliasMap map[string]string) (
 	} else if p != nil {
 		this.ActivityStreamsPreview = p
 	}
+	if p, err := mgr.DeserializeProofPropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1Proof = p
+	}
 	if p, err := mgr.DeserializePublishedPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -288,6 +297,11 @@ func DeserializeActivity(m map[string]interface{}, aliasMap map[string]string) (
 	} else if p != nil {
 		this.ActivityStreamsShares = p
 	}
+	if p, err := mgr.DeserializeSignaturePropertyW3IDSecurityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDSecurityV1Signature = p
+	}
 	if p, err := mgr.DeserializeStartTimePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -387,6 +401,8 @@ func DeserializeActivity(m map[string]interface{}, aliasMap map[string]string) (
 			continue
 		} else if k == "preview" {
 			continue
+		} else if k == "proof" {
+			continue
 		} else if k == "published" {
 			continue
 		} else if k == "replies" {
@@ -395,6 +411,8 @@ func DeserializeActivity(m map[string]interface{}, aliasMap map[string]string) (
 			continue
 		} else if k == "shares" {
 			continue
+		} else if k == "signature" {
+			continue
 		} else if k == "startTime" {
 			continue
 		} else if k == "summary" {
@@ -442,6 +460,463 @@ func NewActivityStreamsActivity() *ActivityStreamsActivity {
 	}
 }
 
+// Clone returns a deep copy of this Activity, which can be modified without
+// changing this one. Its properties, values, IRIs, and unknown properties are
+// copied.
+func (this ActivityStreamsActivity) Clone() vocab.ActivityStreamsActivity {
+	c := &ActivityStreamsActivity{
+		alias:   this.alias,
+		unknown: jsonld.CopyObject(this.unknown),
+	}
+	if this.ActivityStreamsActor != nil {
+		c.ActivityStreamsActor = this.ActivityStreamsActor.Clone()
+	}
+	if this.ActivityStreamsAltitude != nil {
+		c.ActivityStreamsAltitude = this.ActivityStreamsAltitude.Clone()
+	}
+	if this.ActivityStreamsAttachment != nil {
+		c.ActivityStreamsAttachment = this.ActivityStreamsAttachment.Clone()
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		c.ActivityStreamsAttributedTo = this.ActivityStreamsAttributedTo.Clone()
+	}
+	if this.ActivityStreamsAudience != nil {
+		c.ActivityStreamsAudience = this.ActivityStreamsAudience.Clone()
+	}
+	if this.ActivityStreamsBcc != nil {
+		c.ActivityStreamsBcc = this.ActivityStreamsBcc.Clone()
+	}
+	if this.ActivityStreamsBto != nil {
+		c.ActivityStreamsBto = this.ActivityStreamsBto.Clone()
+	}
+	if this.ActivityStreamsCc != nil {
+		c.ActivityStreamsCc = this.ActivityStreamsCc.Clone()
+	}
+	if this.ActivityStreamsContent != nil {
+		c.ActivityStreamsContent = this.ActivityStreamsContent.Clone()
+	}
+	if this.ActivityStreamsContext != nil {
+		c.ActivityStreamsContext = this.ActivityStreamsContext.Clone()
+	}
+	if this.ActivityStreamsDuration != nil {
+		c.ActivityStreamsDuration = this.ActivityStreamsDuration.Clone()
+	}
+	if this.ActivityStreamsEndTime != nil {
+		c.ActivityStreamsEndTime = this.ActivityStreamsEndTime.Clone()
+	}
+	if this.ActivityStreamsGenerator != nil {
+		c.ActivityStreamsGenerator = this.ActivityStreamsGenerator.Clone()
+	}
+	if this.ActivityStreamsIcon != nil {
+		c.ActivityStreamsIcon = this.ActivityStreamsIcon.Clone()
+	}
+	if this.JSONLDId != nil {
+		c.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.ActivityStreamsImage != nil {
+		c.ActivityStreamsImage = this.ActivityStreamsImage.Clone()
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		c.ActivityStreamsInReplyTo = this.ActivityStreamsInReplyTo.Clone()
+	}
+	if this.ActivityStreamsInstrument != nil {
+		c.ActivityStreamsInstrument = this.ActivityStreamsInstrument.Clone()
+	}
+	if this.ActivityStreamsLikes != nil {
+		c.ActivityStreamsLikes = this.ActivityStreamsLikes.Clone()
+	}
+	if this.ActivityStreamsLocation != nil {
+		c.ActivityStreamsLocation = this.ActivityStreamsLocation.Clone()
+	}
+	if this.ActivityStreamsMediaType != nil {
+		c.ActivityStreamsMediaType = this.ActivityStreamsMediaType.Clone()
+	}
+	if this.ActivityStreamsName != nil {
+		c.ActivityStreamsName = this.ActivityStreamsName.Clone()
+	}
+	if this.ActivityStreamsObject != nil {
+		c.ActivityStreamsObject = this.ActivityStreamsObject.Clone()
+	}
+	if this.ActivityStreamsOrigin != nil {
+		c.ActivityStreamsOrigin = this.ActivityStreamsOrigin.Clone()
+	}
+	if this.ActivityStreamsPreview != nil {
+		c.ActivityStreamsPreview = this.ActivityStreamsPreview.Clone()
+	}
+	if this.W3IDDataIntegrityV1Proof != nil {
+		c.W3IDDataIntegrityV1Proof = this.W3IDDataIntegrityV1Proof.Clone()
+	}
+	if this.ActivityStreamsPublished != nil {
+		c.ActivityStreamsPublished = this.ActivityStreamsPublished.Clone()
+	}
+	if this.ActivityStreamsReplies != nil {
+		c.ActivityStreamsReplies = this.ActivityStreamsReplies.Clone()
+	}
+	if this.ActivityStreamsResult != nil {
+		c.ActivityStreamsResult = this.ActivityStreamsResult.Clone()
+	}
+	if this.ActivityStreamsShares != nil {
+		c.ActivityStreamsShares = this.ActivityStreamsShares.Clone()
+	}
+	if this.W3IDSecurityV1Signature != nil {
+		c.W3IDSecurityV1Signature = this.W3IDSecurityV1Signature.Clone()
+	}
+	if this.ActivityStreamsStartTime != nil {
+		c.ActivityStreamsStartTime = this.ActivityStreamsStartTime.Clone()
+	}
+	if this.ActivityStreamsSummary != nil {
+		c.ActivityStreamsSummary = this.ActivityStreamsSummary.Clone()
+	}
+	if this.ActivityStreamsTag != nil {
+		c.ActivityStreamsTag = this.ActivityStreamsTag.Clone()
+	}
+	if this.ActivityStreamsTarget != nil {
+		c.ActivityStreamsTarget = this.ActivityStreamsTarget.Clone()
+	}
+	if this.ActivityStreamsTo != nil {
+		c.ActivityStreamsTo = this.ActivityStreamsTo.Clone()
+	}
+	if this.JSONLDType != nil {
+		c.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.ActivityStreamsUpdated != nil {
+		c.ActivityStreamsUpdated = this.ActivityStreamsUpdated.Clone()
+	}
+	if this.ActivityStreamsUrl != nil {
+		c.ActivityStreamsUrl = this.ActivityStreamsUrl.Clone()
+	}
+	return c
+}
+
+// DeleteUnknownProperty removes the unknown property with the name.
+func (this *ActivityStreamsActivity) DeleteUnknownProperty(name string) {
+	delete(this.unknown, name)
+}
+
+// Equals determines whether this Activity has the same properties, values, and
+// unknown properties as the other. Values of non-functional properties are
+// compared in order, and the aliases used for vocabularies are ignored.
+func (this ActivityStreamsActivity) Equals(o vocab.ActivityStreamsActivity) bool {
+	if o == nil {
+		return false
+	}
+	// Compare property "actor"
+	if lhs, rhs := this.ActivityStreamsActor, o.GetActivityStreamsActor(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "altitude"
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "attachment"
+	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "attributedTo"
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "audience"
+	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "bcc"
+	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "bto"
+	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "cc"
+	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "content"
+	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "context"
+	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "duration"
+	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "endTime"
+	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "generator"
+	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "icon"
+	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "image"
+	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "inReplyTo"
+	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "instrument"
+	if lhs, rhs := this.ActivityStreamsInstrument, o.GetActivityStreamsInstrument(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "likes"
+	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "location"
+	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "mediaType"
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "name"
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "object"
+	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "origin"
+	if lhs, rhs := this.ActivityStreamsOrigin, o.GetActivityStreamsOrigin(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "preview"
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "proof"
+	if lhs, rhs := this.W3IDDataIntegrityV1Proof, o.GetW3IDDataIntegrityV1Proof(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "published"
+	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "replies"
+	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "result"
+	if lhs, rhs := this.ActivityStreamsResult, o.GetActivityStreamsResult(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "shares"
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "signature"
+	if lhs, rhs := this.W3IDSecurityV1Signature, o.GetW3IDSecurityV1Signature(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "startTime"
+	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "summary"
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "tag"
+	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "target"
+	if lhs, rhs := this.ActivityStreamsTarget, o.GetActivityStreamsTarget(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "to"
+	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "updated"
+	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "url"
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+
+	// Compare unknown properties, except the @context of the document.
+	return jsonld.EqualObjects(this.unknown, o.GetUnknownProperties(), "@context")
+}
+
 // GetActivityStreamsActor returns the "actor" property if it exists, and nil
 // otherwise.
 func (this ActivityStreamsActivity) GetActivityStreamsActor() vocab.ActivityStreamsActorProperty {
@@ -675,6 +1150,26 @@ func (this ActivityStreamsActivity) GetUnknownProperties() map[string]interface{
 	return this.unknown
 }
 
+// GetUnknownProperty returns the value of the unknown property with the name, and
+// whether it is set. The value is as it was deserialized from JSON, such as a
+// string, float64, bool, map[string]interface{} or []interface{}.
+func (this ActivityStreamsActivity) GetUnknownProperty(name string) (interface{}, bool) {
+	v, ok := this.unknown[name]
+	return v, ok
+}
+
+// GetW3IDDataIntegrityV1Proof returns the "proof" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsActivity) GetW3IDDataIntegrityV1Proof() vocab.W3IDDataIntegrityV1ProofProperty {
+	return this.W3IDDataIntegrityV1Proof
+}
+
+// GetW3IDSecurityV1Signature returns the "signature" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsActivity) GetW3IDSecurityV1Signature() vocab.W3IDSecurityV1SignatureProperty {
+	return this.W3IDSecurityV1Signature
+}
+
 // IsExtending returns true if the Activity type extends from the other type.
 func (this ActivityStreamsActivity) IsExtending(other vocab.Type) bool {
 	return ActivityStreamsActivityExtends(other)
@@ -710,10 +1205,12 @@ func (this ActivityStreamsActivity) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsObject, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsOrigin, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1Proof, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsResult, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
+	m = this.helperJSONLDContext(this.W3IDSecurityV1Signature, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsTag, m)
@@ -1080,6 +1577,20 @@ func (this ActivityStreamsActivity) LessThan(o vocab.ActivityStreamsActivity) bo
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "proof"
+	if lhs, rhs := this.W3IDDataIntegrityV1Proof, o.GetW3IDDataIntegrityV1Proof(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "published"
 	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1108,8 +1619,22 @@ func (this ActivityStreamsActivity) LessThan(o vocab.ActivityStreamsActivity) bo
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
-	// Compare property "result"
-	if lhs, rhs := this.ActivityStreamsResult, o.GetActivityStreamsResult(); lhs != nil && rhs != nil {
+	// Compare property "result"
+	if lhs, rhs := this.ActivityStreamsResult, o.GetActivityStreamsResult(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "shares"
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
 			return true
 		} else if rhs.LessThan(lhs) {
@@ -1122,8 +1647,8 @@ func (this ActivityStreamsActivity) LessThan(o vocab.ActivityStreamsActivity) bo
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
-	// Compare property "shares"
-	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs != nil && rhs != nil {
+	// Compare property "signature"
+	if lhs, rhs := this.W3IDSecurityV1Signature, o.GetW3IDSecurityV1Signature(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
 			return true
 		} else if rhs.LessThan(lhs) {
@@ -1261,6 +1786,174 @@ func (this ActivityStreamsActivity) LessThan(o vocab.ActivityStreamsActivity) bo
 	return false
 }
 
+// RangeProperties calls the function with the name and values of each property of
+// this Activity that has a value, in the order of their names, until it
+// returns false. Each value is a type, an IRI, or the value of a literal, as
+// returned by the Value method of the property. Unknown properties are not
+// included.
+func (this ActivityStreamsActivity) RangeProperties(fn func(name string, values []interface{}) bool) {
+	if this.ActivityStreamsActor != nil && this.ActivityStreamsActor.Len() > 0 && !fn("actor", this.ActivityStreamsActor.Values()) {
+		return
+	}
+	if this.ActivityStreamsAltitude != nil {
+		if v := this.ActivityStreamsAltitude.Value(); v != nil && !fn("altitude", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsAttachment != nil && this.ActivityStreamsAttachment.Len() > 0 && !fn("attachment", this.ActivityStreamsAttachment.Values()) {
+		return
+	}
+	if this.ActivityStreamsAttributedTo != nil && this.ActivityStreamsAttributedTo.Len() > 0 && !fn("attributedTo", this.ActivityStreamsAttributedTo.Values()) {
+		return
+	}
+	if this.ActivityStreamsAudience != nil && this.ActivityStreamsAudience.Len() > 0 && !fn("audience", this.ActivityStreamsAudience.Values()) {
+		return
+	}
+	if this.ActivityStreamsBcc != nil && this.ActivityStreamsBcc.Len() > 0 && !fn("bcc", this.ActivityStreamsBcc.Values()) {
+		return
+	}
+	if this.ActivityStreamsBto != nil && this.ActivityStreamsBto.Len() > 0 && !fn("bto", this.ActivityStreamsBto.Values()) {
+		return
+	}
+	if this.ActivityStreamsCc != nil && this.ActivityStreamsCc.Len() > 0 && !fn("cc", this.ActivityStreamsCc.Values()) {
+		return
+	}
+	if this.ActivityStreamsContent != nil && this.ActivityStreamsContent.Len() > 0 && !fn("content", this.ActivityStreamsContent.Values()) {
+		return
+	}
+	if this.ActivityStreamsContext != nil && this.ActivityStreamsContext.Len() > 0 && !fn("context", this.ActivityStreamsContext.Values()) {
+		return
+	}
+	if this.ActivityStreamsDuration != nil {
+		if v := this.ActivityStreamsDuration.Value(); v != nil && !fn("duration", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsEndTime != nil {
+		if v := this.ActivityStreamsEndTime.Value(); v != nil && !fn("endTime", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsGenerator != nil && this.ActivityStreamsGenerator.Len() > 0 && !fn("generator", this.ActivityStreamsGenerator.Values()) {
+		return
+	}
+	if this.ActivityStreamsIcon != nil && this.ActivityStreamsIcon.Len() > 0 && !fn("icon", this.ActivityStreamsIcon.Values()) {
+		return
+	}
+	if this.JSONLDId != nil {
+		if v := this.JSONLDId.Value(); v != nil && !fn("id", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsImage != nil && this.ActivityStreamsImage.Len() > 0 && !fn("image", this.ActivityStreamsImage.Values()) {
+		return
+	}
+	if this.ActivityStreamsInReplyTo != nil && this.ActivityStreamsInReplyTo.Len() > 0 && !fn("inReplyTo", this.ActivityStreamsInReplyTo.Values()) {
+		return
+	}
+	if this.ActivityStreamsInstrument != nil && this.ActivityStreamsInstrument.Len() > 0 && !fn("instrument", this.ActivityStreamsInstrument.Values()) {
+		return
+	}
+	if this.ActivityStreamsLikes != nil {
+		if v := this.ActivityStreamsLikes.Value(); v != nil && !fn("likes", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsLocation != nil && this.ActivityStreamsLocation.Len() > 0 && !fn("location", this.ActivityStreamsLocation.Values()) {
+		return
+	}
+	if this.ActivityStreamsMediaType != nil {
+		if v := this.ActivityStreamsMediaType.Value(); v != nil && !fn("mediaType", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsName != nil && this.ActivityStreamsName.Len() > 0 && !fn("name", this.ActivityStreamsName.Values()) {
+		return
+	}
+	if this.ActivityStreamsObject != nil && this.ActivityStreamsObject.Len() > 0 && !fn("object", this.ActivityStreamsObject.Values()) {
+		return
+	}
+	if this.ActivityStreamsOrigin != nil && this.ActivityStreamsOrigin.Len() > 0 && !fn("origin", this.ActivityStreamsOrigin.Values()) {
+		return
+	}
+	if this.ActivityStreamsPreview != nil && this.ActivityStreamsPreview.Len() > 0 && !fn("preview", this.ActivityStreamsPreview.Values()) {
+		return
+	}
+	if this.W3IDDataIntegrityV1Proof != nil && this.W3IDDataIntegrityV1Proof.Len() > 0 && !fn("proof", this.W3IDDataIntegrityV1Proof.Values()) {
+		return
+	}
+	if this.ActivityStreamsPublished != nil {
+		if v := this.ActivityStreamsPublished.Value(); v != nil && !fn("published", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsReplies != nil {
+		if v := this.ActivityStreamsReplies.Value(); v != nil && !fn("replies", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsResult != nil && this.ActivityStreamsResult.Len() > 0 && !fn("result", this.ActivityStreamsResult.Values()) {
+		return
+	}
+	if this.ActivityStreamsShares != nil {
+		if v := this.ActivityStreamsShares.Value(); v != nil && !fn("shares", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDSecurityV1Signature != nil {
+		if v := this.W3IDSecurityV1Signature.Value(); v != nil && !fn("signature", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsStartTime != nil {
+		if v := this.ActivityStreamsStartTime.Value(); v != nil && !fn("startTime", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsSummary != nil && this.ActivityStreamsSummary.Len() > 0 && !fn("summary", this.ActivityStreamsSummary.Values()) {
+		return
+	}
+	if this.ActivityStreamsTag != nil && this.ActivityStreamsTag.Len() > 0 && !fn("tag", this.ActivityStreamsTag.Values()) {
+		return
+	}
+	if this.ActivityStreamsTarget != nil && this.ActivityStreamsTarget.Len() > 0 && !fn("target", this.ActivityStreamsTarget.Values()) {
+		return
+	}
+	if this.ActivityStreamsTo != nil && this.ActivityStreamsTo.Len() > 0 && !fn("to", this.ActivityStreamsTo.Values()) {
+		return
+	}
+	if this.JSONLDType != nil && this.JSONLDType.Len() > 0 && !fn("type", this.JSONLDType.Values()) {
+		return
+	}
+	if this.ActivityStreamsUpdated != nil {
+		if v := this.ActivityStreamsUpdated.Value(); v != nil && !fn("updated", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsUrl != nil && this.ActivityStreamsUrl.Len() > 0 && !fn("url", this.ActivityStreamsUrl.Values()) {
+		return
+	}
+
+}
+
+// RangeUnknownProperties calls the function with each unknown property in the
+// order of their names, until it returns false. The @context of the document
+// the Activity was deserialized from is skipped.
+func (this ActivityStreamsActivity) RangeUnknownProperties(fn func(name string, v interface{}) bool) {
+	names := make([]string, 0, len(this.unknown))
+	for k := range this.unknown {
+		if k != "@context" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !fn(k, this.unknown[k]) {
+			return
+		}
+	}
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error) {
@@ -1278,6 +1971,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsActor.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "altitude"
 	if this.ActivityStreamsAltitude != nil {
@@ -1286,6 +1980,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsAltitude.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "attachment"
 	if this.ActivityStreamsAttachment != nil {
@@ -1294,6 +1989,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsAttachment.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "attributedTo"
 	if this.ActivityStreamsAttributedTo != nil {
@@ -1302,6 +1998,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsAttributedTo.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "audience"
 	if this.ActivityStreamsAudience != nil {
@@ -1310,6 +2007,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsAudience.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "bcc"
 	if this.ActivityStreamsBcc != nil {
@@ -1318,6 +2016,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsBcc.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "bto"
 	if this.ActivityStreamsBto != nil {
@@ -1326,6 +2025,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsBto.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "cc"
 	if this.ActivityStreamsCc != nil {
@@ -1334,6 +2034,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsCc.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "content"
 	if this.ActivityStreamsContent != nil {
@@ -1342,6 +2043,11 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsContent.Name()] = i
 		}
+		if i, err := this.ActivityStreamsContent.SerializeMap(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsContent.Name()+"Map"] = i
+		}
 	}
 	// Maybe serialize property "context"
 	if this.ActivityStreamsContext != nil {
@@ -1350,6 +2056,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsContext.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "duration"
 	if this.ActivityStreamsDuration != nil {
@@ -1358,6 +2065,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsDuration.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "endTime"
 	if this.ActivityStreamsEndTime != nil {
@@ -1366,6 +2074,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsEndTime.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "generator"
 	if this.ActivityStreamsGenerator != nil {
@@ -1374,6 +2083,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsGenerator.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "icon"
 	if this.ActivityStreamsIcon != nil {
@@ -1382,6 +2092,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsIcon.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "id"
 	if this.JSONLDId != nil {
@@ -1390,6 +2101,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.JSONLDId.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "image"
 	if this.ActivityStreamsImage != nil {
@@ -1398,6 +2110,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsImage.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "inReplyTo"
 	if this.ActivityStreamsInReplyTo != nil {
@@ -1406,6 +2119,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsInReplyTo.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "instrument"
 	if this.ActivityStreamsInstrument != nil {
@@ -1414,6 +2128,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsInstrument.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "likes"
 	if this.ActivityStreamsLikes != nil {
@@ -1422,6 +2137,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsLikes.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "location"
 	if this.ActivityStreamsLocation != nil {
@@ -1430,6 +2146,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsLocation.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "mediaType"
 	if this.ActivityStreamsMediaType != nil {
@@ -1438,6 +2155,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsMediaType.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "name"
 	if this.ActivityStreamsName != nil {
@@ -1446,6 +2164,11 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsName.Name()] = i
 		}
+		if i, err := this.ActivityStreamsName.SerializeMap(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsName.Name()+"Map"] = i
+		}
 	}
 	// Maybe serialize property "object"
 	if this.ActivityStreamsObject != nil {
@@ -1454,6 +2177,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsObject.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "origin"
 	if this.ActivityStreamsOrigin != nil {
@@ -1462,6 +2186,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsOrigin.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "preview"
 	if this.ActivityStreamsPreview != nil {
@@ -1470,6 +2195,16 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsPreview.Name()] = i
 		}
+
+	}
+	// Maybe serialize property "proof"
+	if this.W3IDDataIntegrityV1Proof != nil {
+		if i, err := this.W3IDDataIntegrityV1Proof.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1Proof.Name()] = i
+		}
+
 	}
 	// Maybe serialize property "published"
 	if this.ActivityStreamsPublished != nil {
@@ -1478,6 +2213,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsPublished.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "replies"
 	if this.ActivityStreamsReplies != nil {
@@ -1486,6 +2222,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsReplies.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "result"
 	if this.ActivityStreamsResult != nil {
@@ -1494,6 +2231,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsResult.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
@@ -1502,6 +2240,16 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsShares.Name()] = i
 		}
+
+	}
+	// Maybe serialize property "signature"
+	if this.W3IDSecurityV1Signature != nil {
+		if i, err := this.W3IDSecurityV1Signature.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDSecurityV1Signature.Name()] = i
+		}
+
 	}
 	// Maybe serialize property "startTime"
 	if this.ActivityStreamsStartTime != nil {
@@ -1510,6 +2258,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsStartTime.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "summary"
 	if this.ActivityStreamsSummary != nil {
@@ -1518,6 +2267,11 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsSummary.Name()] = i
 		}
+		if i, err := this.ActivityStreamsSummary.SerializeMap(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSummary.Name()+"Map"] = i
+		}
 	}
 	// Maybe serialize property "tag"
 	if this.ActivityStreamsTag != nil {
@@ -1526,6 +2280,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsTag.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "target"
 	if this.ActivityStreamsTarget != nil {
@@ -1534,6 +2289,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsTarget.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
@@ -1542,6 +2298,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsTo.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "type"
 	if this.JSONLDType != nil {
@@ -1550,6 +2307,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.JSONLDType.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "updated"
 	if this.ActivityStreamsUpdated != nil {
@@ -1558,6 +2316,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsUpdated.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "url"
 	if this.ActivityStreamsUrl != nil {
@@ -1566,6 +2325,7 @@ func (this ActivityStreamsActivity) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.ActivityStreamsUrl.Name()] = i
 		}
+
 	}
 	// End: Serialize known properties
 
@@ -1766,6 +2526,230 @@ func (this *ActivityStreamsActivity) SetJSONLDType(i vocab.JSONLDTypeProperty) {
 	this.JSONLDType = i
 }
 
+// SetUnknownProperty sets the unknown property with the name, which is serialized
+// with the value as is. The value must be marshallable into JSON. It is not
+// serialized if the name is the name of a property known to the Activity type.
+func (this *ActivityStreamsActivity) SetUnknownProperty(name string, v interface{}) {
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
+	this.unknown[name] = v
+}
+
+// SetW3IDDataIntegrityV1Proof sets the "proof" property.
+func (this *ActivityStreamsActivity) SetW3IDDataIntegrityV1Proof(i vocab.W3IDDataIntegrityV1ProofProperty) {
+	this.W3IDDataIntegrityV1Proof = i
+}
+
+// SetW3IDSecurityV1Signature sets the "signature" property.
+func (this *ActivityStreamsActivity) SetW3IDSecurityV1Signature(i vocab.W3IDSecurityV1SignatureProperty) {
+	this.W3IDSecurityV1Signature = i
+}
+
+// Validate returns an error if this Activity violates its vocabulary: if a value
+// is out of the range of its property, if an IRI is not absolute, or if a
+// property required by the specification is missing. The error names the
+// property of the first violation found.
+func (this ActivityStreamsActivity) Validate() error {
+	if this.ActivityStreamsActor != nil {
+		if err := this.ActivityStreamsActor.Validate(); err != nil {
+			return fmt.Errorf("actor: %v", err)
+		}
+	}
+	if this.ActivityStreamsAltitude != nil {
+		if err := this.ActivityStreamsAltitude.Validate(); err != nil {
+			return fmt.Errorf("altitude: %v", err)
+		}
+	}
+	if this.ActivityStreamsAttachment != nil {
+		if err := this.ActivityStreamsAttachment.Validate(); err != nil {
+			return fmt.Errorf("attachment: %v", err)
+		}
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		if err := this.ActivityStreamsAttributedTo.Validate(); err != nil {
+			return fmt.Errorf("attributedTo: %v", err)
+		}
+	}
+	if this.ActivityStreamsAudience != nil {
+		if err := this.ActivityStreamsAudience.Validate(); err != nil {
+			return fmt.Errorf("audience: %v", err)
+		}
+	}
+	if this.ActivityStreamsBcc != nil {
+		if err := this.ActivityStreamsBcc.Validate(); err != nil {
+			return fmt.Errorf("bcc: %v", err)
+		}
+	}
+	if this.ActivityStreamsBto != nil {
+		if err := this.ActivityStreamsBto.Validate(); err != nil {
+			return fmt.Errorf("bto: %v", err)
+		}
+	}
+	if this.ActivityStreamsCc != nil {
+		if err := this.ActivityStreamsCc.Validate(); err != nil {
+			return fmt.Errorf("cc: %v", err)
+		}
+	}
+	if this.ActivityStreamsContent != nil {
+		if err := this.ActivityStreamsContent.Validate(); err != nil {
+			return fmt.Errorf("content: %v", err)
+		}
+	}
+	if this.ActivityStreamsContext != nil {
+		if err := this.ActivityStreamsContext.Validate(); err != nil {
+			return fmt.Errorf("context: %v", err)
+		}
+	}
+	if this.ActivityStreamsDuration != nil {
+		if err := this.ActivityStreamsDuration.Validate(); err != nil {
+			return fmt.Errorf("duration: %v", err)
+		}
+	}
+	if this.ActivityStreamsEndTime != nil {
+		if err := this.ActivityStreamsEndTime.Validate(); err != nil {
+			return fmt.Errorf("endTime: %v", err)
+		}
+	}
+	if this.ActivityStreamsGenerator != nil {
+		if err := this.ActivityStreamsGenerator.Validate(); err != nil {
+			return fmt.Errorf("generator: %v", err)
+		}
+	}
+	if this.ActivityStreamsIcon != nil {
+		if err := this.ActivityStreamsIcon.Validate(); err != nil {
+			return fmt.Errorf("icon: %v", err)
+		}
+	}
+	if this.JSONLDId != nil {
+		if err := this.JSONLDId.Validate(); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+	if this.ActivityStreamsImage != nil {
+		if err := this.ActivityStreamsImage.Validate(); err != nil {
+			return fmt.Errorf("image: %v", err)
+		}
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		if err := this.ActivityStreamsInReplyTo.Validate(); err != nil {
+			return fmt.Errorf("inReplyTo: %v", err)
+		}
+	}
+	if this.ActivityStreamsInstrument != nil {
+		if err := this.ActivityStreamsInstrument.Validate(); err != nil {
+			return fmt.Errorf("instrument: %v", err)
+		}
+	}
+	if this.ActivityStreamsLikes != nil {
+		if err := this.ActivityStreamsLikes.Validate(); err != nil {
+			return fmt.Errorf("likes: %v", err)
+		}
+	}
+	if this.ActivityStreamsLocation != nil {
+		if err := this.ActivityStreamsLocation.Validate(); err != nil {
+			return fmt.Errorf("location: %v", err)
+		}
+	}
+	if this.ActivityStreamsMediaType != nil {
+		if err := this.ActivityStreamsMediaType.Validate(); err != nil {
+			return fmt.Errorf("mediaType: %v", err)
+		}
+	}
+	if this.ActivityStreamsName != nil {
+		if err := this.ActivityStreamsName.Validate(); err != nil {
+			return fmt.Errorf("name: %v", err)
+		}
+	}
+	if this.ActivityStreamsObject != nil {
+		if err := this.ActivityStreamsObject.Validate(); err != nil {
+			return fmt.Errorf("object: %v", err)
+		}
+	}
+	if this.ActivityStreamsOrigin != nil {
+		if err := this.ActivityStreamsOrigin.Validate(); err != nil {
+			return fmt.Errorf("origin: %v", err)
+		}
+	}
+	if this.ActivityStreamsPreview != nil {
+		if err := this.ActivityStreamsPreview.Validate(); err != nil {
+			return fmt.Errorf("preview: %v", err)
+		}
+	}
+	if this.W3IDDataIntegrityV1Proof != nil {
+		if err := this.W3IDDataIntegrityV1Proof.Validate(); err != nil {
+			return fmt.Errorf("proof: %v", err)
+		}
+	}
+	if this.ActivityStreamsPublished != nil {
+		if err := this.ActivityStreamsPublished.Validate(); err != nil {
+			return fmt.Errorf("published: %v", err)
+		}
+	}
+	if this.ActivityStreamsReplies != nil {
+		if err := this.ActivityStreamsReplies.Validate(); err != nil {
+			return fmt.Errorf("replies: %v", err)
+		}
+	}
+	if this.ActivityStreamsResult != nil {
+		if err := this.ActivityStreamsResult.Validate(); err != nil {
+			return fmt.Errorf("result: %v", err)
+		}
+	}
+	if this.ActivityStreamsShares != nil {
+		if err := this.ActivityStreamsShares.Validate(); err != nil {
+			return fmt.Errorf("shares: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1Signature != nil {
+		if err := this.W3IDSecurityV1Signature.Validate(); err != nil {
+			return fmt.Errorf("signature: %v", err)
+		}
+	}
+	if this.ActivityStreamsStartTime != nil {
+		if err := this.ActivityStreamsStartTime.Validate(); err != nil {
+			return fmt.Errorf("startTime: %v", err)
+		}
+	}
+	if this.ActivityStreamsSummary != nil {
+		if err := this.ActivityStreamsSummary.Validate(); err != nil {
+			return fmt.Errorf("summary: %v", err)
+		}
+	}
+	if this.ActivityStreamsTag != nil {
+		if err := this.ActivityStreamsTag.Validate(); err != nil {
+			return fmt.Errorf("tag: %v", err)
+		}
+	}
+	if this.ActivityStreamsTarget != nil {
+		if err := this.ActivityStreamsTarget.Validate(); err != nil {
+			return fmt.Errorf("target: %v", err)
+		}
+	}
+	if this.ActivityStreamsTo != nil {
+		if err := this.ActivityStreamsTo.Validate(); err != nil {
+			return fmt.Errorf("to: %v", err)
+		}
+	}
+	if this.JSONLDType != nil {
+		if err := this.JSONLDType.Validate(); err != nil {
+			return fmt.Errorf("type: %v", err)
+		}
+	}
+	if this.ActivityStreamsUpdated != nil {
+		if err := this.ActivityStreamsUpdated.Validate(); err != nil {
+			return fmt.Errorf("updated: %v", err)
+		}
+	}
+	if this.ActivityStreamsUrl != nil {
+		if err := this.ActivityStreamsUrl.Validate(); err != nil {
+			return fmt.Errorf("url: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // VocabularyURI returns the vocabulary's URI as a string.
 func (this ActivityStreamsActivity) VocabularyURI() string {
 	return "https://www.w3.org/ns/activitystreams"
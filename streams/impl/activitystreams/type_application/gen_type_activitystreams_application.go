@@ -4,7 +4,9 @@ package typeapplication
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
 	"strings"
 )
 
@@ -17,6 +19,7 @@ import (
 //   }
 type ActivityStreamsApplication struct {
 	ActivityStreamsAltitude          vocab.ActivityStreamsAltitudeProperty
+	W3IDMultikeyV1AssertionMethod    vocab.W3IDMultikeyV1AssertionMethodProperty
 	ActivityStreamsAttachment        vocab.ActivityStreamsAttachmentProperty
 	ActivityStreamsAttributedTo      vocab.ActivityStreamsAttributedToProperty
 	ActivityStreamsAudience          vocab.ActivityStreamsAudienceProperty
@@ -44,10 +47,12 @@ type ActivityStreamsApplication struct {
 	ActivityStreamsOutbox            vocab.ActivityStreamsOutboxProperty
 	ActivityStreamsPreferredUsername vocab.ActivityStreamsPreferredUsernameProperty
 	ActivityStreamsPreview           vocab.ActivityStreamsPreviewProperty
+	W3IDDataIntegrityV1Proof         vocab.W3IDDataIntegrityV1ProofProperty
 	W3IDSecurityV1PublicKey          vocab.W3IDSecurityV1PublicKeyProperty
 	ActivityStreamsPublished         vocab.ActivityStreamsPublishedProperty
 	ActivityStreamsReplies           vocab.ActivityStreamsRepliesProperty
 	ActivityStreamsShares            vocab.ActivityStreamsSharesProperty
+	W3IDSecurityV1Signature          vocab.W3IDSecurityV1SignatureProperty
 	ActivityStreamsStartTime         vocab.ActivityStreamsStartTimeProperty
 	ActivityStreamsStreams           vocab.ActivityStreamsStreamsProperty
 	ActivityStreamsSummary           vocab.ActivityStreamsSummaryProperty
@@ -134,6 +139,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsAltitude = p
 	}
+	if p, err := mgr.DeserializeAssertionMethodPropertyW3IDMultikeyV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDMultikeyV1AssertionMethod = p
+	}
 	if p, err := mgr.DeserializeAttachmentPropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -269,6 +279,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsPreview = p
 	}
+	if p, err := mgr.DeserializeProofPropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1Proof = p
+	}
 	if p, err := mgr.DeserializePublicKeyPropertyW3IDSecurityV1()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -289,6 +304,11 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 	} else if p != nil {
 		this.ActivityStreamsShares = p
 	}
+	if p, err := mgr.DeserializeSignaturePropertyW3IDSecurityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDSecurityV1Signature = p
+	}
 	if p, err := mgr.DeserializeStartTimePropertyActivityStreams()(m, aliasMap); err != nil {
 		return nil, err
 	} else if p != nil {
@@ -336,6 +356,8 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 		// Begin: Code that ensures a property name is unknown
 		if k == "altitude" {
 			continue
+		} else if k == "assertionMethod" {
+			continue
 		} else if k == "attachment" {
 			continue
 		} else if k == "attributedTo" {
@@ -396,6 +418,8 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "preview" {
 			continue
+		} else if k == "proof" {
+			continue
 		} else if k == "publicKey" {
 			continue
 		} else if k == "published" {
@@ -404,6 +428,8 @@ func DeserializeApplication(m map[string]interface{}, aliasMap map[string]string
 			continue
 		} else if k == "shares" {
 			continue
+		} else if k == "signature" {
+			continue
 		} else if k == "startTime" {
 			continue
 		} else if k == "streams" {
@@ -451,6 +477,507 @@ func NewActivityStreamsApplication() *ActivityStreamsApplication {
 	}
 }
 
+// Clone returns a deep copy of this Application, which can be modified without
+// changing this one. Its properties, values, IRIs, and unknown properties are
+// copied.
+func (this ActivityStreamsApplication) Clone() vocab.ActivityStreamsApplication {
+	c := &ActivityStreamsApplication{
+		alias:   this.alias,
+		unknown: jsonld.CopyObject(this.unknown),
+	}
+	if this.ActivityStreamsAltitude != nil {
+		c.ActivityStreamsAltitude = this.ActivityStreamsAltitude.Clone()
+	}
+	if this.W3IDMultikeyV1AssertionMethod != nil {
+		c.W3IDMultikeyV1AssertionMethod = this.W3IDMultikeyV1AssertionMethod.Clone()
+	}
+	if this.ActivityStreamsAttachment != nil {
+		c.ActivityStreamsAttachment = this.ActivityStreamsAttachment.Clone()
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		c.ActivityStreamsAttributedTo = this.ActivityStreamsAttributedTo.Clone()
+	}
+	if this.ActivityStreamsAudience != nil {
+		c.ActivityStreamsAudience = this.ActivityStreamsAudience.Clone()
+	}
+	if this.ActivityStreamsBcc != nil {
+		c.ActivityStreamsBcc = this.ActivityStreamsBcc.Clone()
+	}
+	if this.ActivityStreamsBto != nil {
+		c.ActivityStreamsBto = this.ActivityStreamsBto.Clone()
+	}
+	if this.ActivityStreamsCc != nil {
+		c.ActivityStreamsCc = this.ActivityStreamsCc.Clone()
+	}
+	if this.ActivityStreamsContent != nil {
+		c.ActivityStreamsContent = this.ActivityStreamsContent.Clone()
+	}
+	if this.ActivityStreamsContext != nil {
+		c.ActivityStreamsContext = this.ActivityStreamsContext.Clone()
+	}
+	if this.ActivityStreamsDuration != nil {
+		c.ActivityStreamsDuration = this.ActivityStreamsDuration.Clone()
+	}
+	if this.ActivityStreamsEndTime != nil {
+		c.ActivityStreamsEndTime = this.ActivityStreamsEndTime.Clone()
+	}
+	if this.ActivityStreamsFollowers != nil {
+		c.ActivityStreamsFollowers = this.ActivityStreamsFollowers.Clone()
+	}
+	if this.ActivityStreamsFollowing != nil {
+		c.ActivityStreamsFollowing = this.ActivityStreamsFollowing.Clone()
+	}
+	if this.ActivityStreamsGenerator != nil {
+		c.ActivityStreamsGenerator = this.ActivityStreamsGenerator.Clone()
+	}
+	if this.ActivityStreamsIcon != nil {
+		c.ActivityStreamsIcon = this.ActivityStreamsIcon.Clone()
+	}
+	if this.JSONLDId != nil {
+		c.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.ActivityStreamsImage != nil {
+		c.ActivityStreamsImage = this.ActivityStreamsImage.Clone()
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		c.ActivityStreamsInReplyTo = this.ActivityStreamsInReplyTo.Clone()
+	}
+	if this.ActivityStreamsInbox != nil {
+		c.ActivityStreamsInbox = this.ActivityStreamsInbox.Clone()
+	}
+	if this.ActivityStreamsLiked != nil {
+		c.ActivityStreamsLiked = this.ActivityStreamsLiked.Clone()
+	}
+	if this.ActivityStreamsLikes != nil {
+		c.ActivityStreamsLikes = this.ActivityStreamsLikes.Clone()
+	}
+	if this.ActivityStreamsLocation != nil {
+		c.ActivityStreamsLocation = this.ActivityStreamsLocation.Clone()
+	}
+	if this.ActivityStreamsMediaType != nil {
+		c.ActivityStreamsMediaType = this.ActivityStreamsMediaType.Clone()
+	}
+	if this.ActivityStreamsName != nil {
+		c.ActivityStreamsName = this.ActivityStreamsName.Clone()
+	}
+	if this.ActivityStreamsObject != nil {
+		c.ActivityStreamsObject = this.ActivityStreamsObject.Clone()
+	}
+	if this.ActivityStreamsOutbox != nil {
+		c.ActivityStreamsOutbox = this.ActivityStreamsOutbox.Clone()
+	}
+	if this.ActivityStreamsPreferredUsername != nil {
+		c.ActivityStreamsPreferredUsername = this.ActivityStreamsPreferredUsername.Clone()
+	}
+	if this.ActivityStreamsPreview != nil {
+		c.ActivityStreamsPreview = this.ActivityStreamsPreview.Clone()
+	}
+	if this.W3IDDataIntegrityV1Proof != nil {
+		c.W3IDDataIntegrityV1Proof = this.W3IDDataIntegrityV1Proof.Clone()
+	}
+	if this.W3IDSecurityV1PublicKey != nil {
+		c.W3IDSecurityV1PublicKey = this.W3IDSecurityV1PublicKey.Clone()
+	}
+	if this.ActivityStreamsPublished != nil {
+		c.ActivityStreamsPublished = this.ActivityStreamsPublished.Clone()
+	}
+	if this.ActivityStreamsReplies != nil {
+		c.ActivityStreamsReplies = this.ActivityStreamsReplies.Clone()
+	}
+	if this.ActivityStreamsShares != nil {
+		c.ActivityStreamsShares = this.ActivityStreamsShares.Clone()
+	}
+	if this.W3IDSecurityV1Signature != nil {
+		c.W3IDSecurityV1Signature = this.W3IDSecurityV1Signature.Clone()
+	}
+	if this.ActivityStreamsStartTime != nil {
+		c.ActivityStreamsStartTime = this.ActivityStreamsStartTime.Clone()
+	}
+	if this.ActivityStreamsStreams != nil {
+		c.ActivityStreamsStreams = this.ActivityStreamsStreams.Clone()
+	}
+	if this.ActivityStreamsSummary != nil {
+		c.ActivityStreamsSummary = this.ActivityStreamsSummary.Clone()
+	}
+	if this.ActivityStreamsTag != nil {
+		c.ActivityStreamsTag = this.ActivityStreamsTag.Clone()
+	}
+	if this.ActivityStreamsTo != nil {
+		c.ActivityStreamsTo = this.ActivityStreamsTo.Clone()
+	}
+	if this.JSONLDType != nil {
+		c.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.ActivityStreamsUpdated != nil {
+		c.ActivityStreamsUpdated = this.ActivityStreamsUpdated.Clone()
+	}
+	if this.ActivityStreamsUrl != nil {
+		c.ActivityStreamsUrl = this.ActivityStreamsUrl.Clone()
+	}
+	return c
+}
+
+// DeleteUnknownProperty removes the unknown property with the name.
+func (this *ActivityStreamsApplication) DeleteUnknownProperty(name string) {
+	delete(this.unknown, name)
+}
+
+// Equals determines whether this Application has the same properties, values, and
+// unknown properties as the other. Values of non-functional properties are
+// compared in order, and the aliases used for vocabularies are ignored.
+func (this ActivityStreamsApplication) Equals(o vocab.ActivityStreamsApplication) bool {
+	if o == nil {
+		return false
+	}
+	// Compare property "altitude"
+	if lhs, rhs := this.ActivityStreamsAltitude, o.GetActivityStreamsAltitude(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "assertionMethod"
+	if lhs, rhs := this.W3IDMultikeyV1AssertionMethod, o.GetW3IDMultikeyV1AssertionMethod(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "attachment"
+	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "attributedTo"
+	if lhs, rhs := this.ActivityStreamsAttributedTo, o.GetActivityStreamsAttributedTo(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "audience"
+	if lhs, rhs := this.ActivityStreamsAudience, o.GetActivityStreamsAudience(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "bcc"
+	if lhs, rhs := this.ActivityStreamsBcc, o.GetActivityStreamsBcc(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "bto"
+	if lhs, rhs := this.ActivityStreamsBto, o.GetActivityStreamsBto(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "cc"
+	if lhs, rhs := this.ActivityStreamsCc, o.GetActivityStreamsCc(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "content"
+	if lhs, rhs := this.ActivityStreamsContent, o.GetActivityStreamsContent(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "context"
+	if lhs, rhs := this.ActivityStreamsContext, o.GetActivityStreamsContext(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "duration"
+	if lhs, rhs := this.ActivityStreamsDuration, o.GetActivityStreamsDuration(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "endTime"
+	if lhs, rhs := this.ActivityStreamsEndTime, o.GetActivityStreamsEndTime(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "followers"
+	if lhs, rhs := this.ActivityStreamsFollowers, o.GetActivityStreamsFollowers(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "following"
+	if lhs, rhs := this.ActivityStreamsFollowing, o.GetActivityStreamsFollowing(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "generator"
+	if lhs, rhs := this.ActivityStreamsGenerator, o.GetActivityStreamsGenerator(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "icon"
+	if lhs, rhs := this.ActivityStreamsIcon, o.GetActivityStreamsIcon(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "image"
+	if lhs, rhs := this.ActivityStreamsImage, o.GetActivityStreamsImage(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "inReplyTo"
+	if lhs, rhs := this.ActivityStreamsInReplyTo, o.GetActivityStreamsInReplyTo(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "inbox"
+	if lhs, rhs := this.ActivityStreamsInbox, o.GetActivityStreamsInbox(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "liked"
+	if lhs, rhs := this.ActivityStreamsLiked, o.GetActivityStreamsLiked(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "likes"
+	if lhs, rhs := this.ActivityStreamsLikes, o.GetActivityStreamsLikes(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "location"
+	if lhs, rhs := this.ActivityStreamsLocation, o.GetActivityStreamsLocation(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "mediaType"
+	if lhs, rhs := this.ActivityStreamsMediaType, o.GetActivityStreamsMediaType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "name"
+	if lhs, rhs := this.ActivityStreamsName, o.GetActivityStreamsName(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "object"
+	if lhs, rhs := this.ActivityStreamsObject, o.GetActivityStreamsObject(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "outbox"
+	if lhs, rhs := this.ActivityStreamsOutbox, o.GetActivityStreamsOutbox(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "preferredUsername"
+	if lhs, rhs := this.ActivityStreamsPreferredUsername, o.GetActivityStreamsPreferredUsername(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "preview"
+	if lhs, rhs := this.ActivityStreamsPreview, o.GetActivityStreamsPreview(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "proof"
+	if lhs, rhs := this.W3IDDataIntegrityV1Proof, o.GetW3IDDataIntegrityV1Proof(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "publicKey"
+	if lhs, rhs := this.W3IDSecurityV1PublicKey, o.GetW3IDSecurityV1PublicKey(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "published"
+	if lhs, rhs := this.ActivityStreamsPublished, o.GetActivityStreamsPublished(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "replies"
+	if lhs, rhs := this.ActivityStreamsReplies, o.GetActivityStreamsReplies(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "shares"
+	if lhs, rhs := this.ActivityStreamsShares, o.GetActivityStreamsShares(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "signature"
+	if lhs, rhs := this.W3IDSecurityV1Signature, o.GetW3IDSecurityV1Signature(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "startTime"
+	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "streams"
+	if lhs, rhs := this.ActivityStreamsStreams, o.GetActivityStreamsStreams(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "summary"
+	if lhs, rhs := this.ActivityStreamsSummary, o.GetActivityStreamsSummary(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "tag"
+	if lhs, rhs := this.ActivityStreamsTag, o.GetActivityStreamsTag(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "to"
+	if lhs, rhs := this.ActivityStreamsTo, o.GetActivityStreamsTo(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "updated"
+	if lhs, rhs := this.ActivityStreamsUpdated, o.GetActivityStreamsUpdated(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "url"
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+
+	// Compare unknown properties, except the @context of the document.
+	return jsonld.EqualObjects(this.unknown, o.GetUnknownProperties(), "@context")
+}
+
 // GetActivityStreamsAltitude returns the "altitude" property if it exists, and
 // nil otherwise.
 func (this ActivityStreamsApplication) GetActivityStreamsAltitude() vocab.ActivityStreamsAltitudeProperty {
@@ -696,12 +1223,38 @@ func (this ActivityStreamsApplication) GetUnknownProperties() map[string]interfa
 	return this.unknown
 }
 
+// GetUnknownProperty returns the value of the unknown property with the name, and
+// whether it is set. The value is as it was deserialized from JSON, such as a
+// string, float64, bool, map[string]interface{} or []interface{}.
+func (this ActivityStreamsApplication) GetUnknownProperty(name string) (interface{}, bool) {
+	v, ok := this.unknown[name]
+	return v, ok
+}
+
+// GetW3IDDataIntegrityV1Proof returns the "proof" property if it exists, and nil
+// otherwise.
+func (this ActivityStreamsApplication) GetW3IDDataIntegrityV1Proof() vocab.W3IDDataIntegrityV1ProofProperty {
+	return this.W3IDDataIntegrityV1Proof
+}
+
+// GetW3IDMultikeyV1AssertionMethod returns the "assertionMethod" property if it
+// exists, and nil otherwise.
+func (this ActivityStreamsApplication) GetW3IDMultikeyV1AssertionMethod() vocab.W3IDMultikeyV1AssertionMethodProperty {
+	return this.W3IDMultikeyV1AssertionMethod
+}
+
 // GetW3IDSecurityV1PublicKey returns the "publicKey" property if it exists, and
 // nil otherwise.
 func (this ActivityStreamsApplication) GetW3IDSecurityV1PublicKey() vocab.W3IDSecurityV1PublicKeyProperty {
 	return this.W3IDSecurityV1PublicKey
 }
 
+// GetW3IDSecurityV1Signature returns the "signature" property if it exists, and
+// nil otherwise.
+func (this ActivityStreamsApplication) GetW3IDSecurityV1Signature() vocab.W3IDSecurityV1SignatureProperty {
+	return this.W3IDSecurityV1Signature
+}
+
 // IsExtending returns true if the Application type extends from the other type.
 func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
 	return ActivityStreamsApplicationExtends(other)
@@ -713,6 +1266,7 @@ func (this ActivityStreamsApplication) IsExtending(other vocab.Type) bool {
 func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/ns/activitystreams": this.alias}
 	m = this.helperJSONLDContext(this.ActivityStreamsAltitude, m)
+	m = this.helperJSONLDContext(this.W3IDMultikeyV1AssertionMethod, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAttachment, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAttributedTo, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsAudience, m)
@@ -740,10 +1294,12 @@ func (this ActivityStreamsApplication) JSONLDContext() map[string]string {
 	m = this.helperJSONLDContext(this.ActivityStreamsOutbox, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPreferredUsername, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPreview, m)
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1Proof, m)
 	m = this.helperJSONLDContext(this.W3IDSecurityV1PublicKey, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsPublished, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsReplies, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsShares, m)
+	m = this.helperJSONLDContext(this.W3IDSecurityV1Signature, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStartTime, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsStreams, m)
 	m = this.helperJSONLDContext(this.ActivityStreamsSummary, m)
@@ -774,6 +1330,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "assertionMethod"
+	if lhs, rhs := this.W3IDMultikeyV1AssertionMethod, o.GetW3IDMultikeyV1AssertionMethod(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "attachment"
 	if lhs, rhs := this.ActivityStreamsAttachment, o.GetActivityStreamsAttachment(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1152,6 +1722,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "proof"
+	if lhs, rhs := this.W3IDDataIntegrityV1Proof, o.GetW3IDDataIntegrityV1Proof(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "publicKey"
 	if lhs, rhs := this.W3IDSecurityV1PublicKey, o.GetW3IDSecurityV1PublicKey(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1208,6 +1792,20 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		// Anything else is greater than nil
 		return false
 	} // Else: Both are nil
+	// Compare property "signature"
+	if lhs, rhs := this.W3IDSecurityV1Signature, o.GetW3IDSecurityV1Signature(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
 	// Compare property "startTime"
 	if lhs, rhs := this.ActivityStreamsStartTime, o.GetActivityStreamsStartTime(); lhs != nil && rhs != nil {
 		if lhs.LessThan(rhs) {
@@ -1299,38 +1897,230 @@ func (this ActivityStreamsApplication) LessThan(o vocab.ActivityStreamsApplicati
 		} else if rhs.LessThan(lhs) {
 			return false
 		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
-		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
-	// Compare property "url"
-	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
-		if lhs.LessThan(rhs) {
-			return true
-		} else if rhs.LessThan(lhs) {
-			return false
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "url"
+	if lhs, rhs := this.ActivityStreamsUrl, o.GetActivityStreamsUrl(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// RangeProperties calls the function with the name and values of each property of
+// this Application that has a value, in the order of their names, until it
+// returns false. Each value is a type, an IRI, or the value of a literal, as
+// returned by the Value method of the property. Unknown properties are not
+// included.
+func (this ActivityStreamsApplication) RangeProperties(fn func(name string, values []interface{}) bool) {
+	if this.ActivityStreamsAltitude != nil {
+		if v := this.ActivityStreamsAltitude.Value(); v != nil && !fn("altitude", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDMultikeyV1AssertionMethod != nil && this.W3IDMultikeyV1AssertionMethod.Len() > 0 && !fn("assertionMethod", this.W3IDMultikeyV1AssertionMethod.Values()) {
+		return
+	}
+	if this.ActivityStreamsAttachment != nil && this.ActivityStreamsAttachment.Len() > 0 && !fn("attachment", this.ActivityStreamsAttachment.Values()) {
+		return
+	}
+	if this.ActivityStreamsAttributedTo != nil && this.ActivityStreamsAttributedTo.Len() > 0 && !fn("attributedTo", this.ActivityStreamsAttributedTo.Values()) {
+		return
+	}
+	if this.ActivityStreamsAudience != nil && this.ActivityStreamsAudience.Len() > 0 && !fn("audience", this.ActivityStreamsAudience.Values()) {
+		return
+	}
+	if this.ActivityStreamsBcc != nil && this.ActivityStreamsBcc.Len() > 0 && !fn("bcc", this.ActivityStreamsBcc.Values()) {
+		return
+	}
+	if this.ActivityStreamsBto != nil && this.ActivityStreamsBto.Len() > 0 && !fn("bto", this.ActivityStreamsBto.Values()) {
+		return
+	}
+	if this.ActivityStreamsCc != nil && this.ActivityStreamsCc.Len() > 0 && !fn("cc", this.ActivityStreamsCc.Values()) {
+		return
+	}
+	if this.ActivityStreamsContent != nil && this.ActivityStreamsContent.Len() > 0 && !fn("content", this.ActivityStreamsContent.Values()) {
+		return
+	}
+	if this.ActivityStreamsContext != nil && this.ActivityStreamsContext.Len() > 0 && !fn("context", this.ActivityStreamsContext.Values()) {
+		return
+	}
+	if this.ActivityStreamsDuration != nil {
+		if v := this.ActivityStreamsDuration.Value(); v != nil && !fn("duration", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsEndTime != nil {
+		if v := this.ActivityStreamsEndTime.Value(); v != nil && !fn("endTime", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsFollowers != nil {
+		if v := this.ActivityStreamsFollowers.Value(); v != nil && !fn("followers", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsFollowing != nil {
+		if v := this.ActivityStreamsFollowing.Value(); v != nil && !fn("following", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsGenerator != nil && this.ActivityStreamsGenerator.Len() > 0 && !fn("generator", this.ActivityStreamsGenerator.Values()) {
+		return
+	}
+	if this.ActivityStreamsIcon != nil && this.ActivityStreamsIcon.Len() > 0 && !fn("icon", this.ActivityStreamsIcon.Values()) {
+		return
+	}
+	if this.JSONLDId != nil {
+		if v := this.JSONLDId.Value(); v != nil && !fn("id", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsImage != nil && this.ActivityStreamsImage.Len() > 0 && !fn("image", this.ActivityStreamsImage.Values()) {
+		return
+	}
+	if this.ActivityStreamsInReplyTo != nil && this.ActivityStreamsInReplyTo.Len() > 0 && !fn("inReplyTo", this.ActivityStreamsInReplyTo.Values()) {
+		return
+	}
+	if this.ActivityStreamsInbox != nil {
+		if v := this.ActivityStreamsInbox.Value(); v != nil && !fn("inbox", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsLiked != nil {
+		if v := this.ActivityStreamsLiked.Value(); v != nil && !fn("liked", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsLikes != nil {
+		if v := this.ActivityStreamsLikes.Value(); v != nil && !fn("likes", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsLocation != nil && this.ActivityStreamsLocation.Len() > 0 && !fn("location", this.ActivityStreamsLocation.Values()) {
+		return
+	}
+	if this.ActivityStreamsMediaType != nil {
+		if v := this.ActivityStreamsMediaType.Value(); v != nil && !fn("mediaType", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsName != nil && this.ActivityStreamsName.Len() > 0 && !fn("name", this.ActivityStreamsName.Values()) {
+		return
+	}
+	if this.ActivityStreamsObject != nil && this.ActivityStreamsObject.Len() > 0 && !fn("object", this.ActivityStreamsObject.Values()) {
+		return
+	}
+	if this.ActivityStreamsOutbox != nil {
+		if v := this.ActivityStreamsOutbox.Value(); v != nil && !fn("outbox", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsPreferredUsername != nil {
+		if v := this.ActivityStreamsPreferredUsername.Value(); v != nil && !fn("preferredUsername", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsPreview != nil && this.ActivityStreamsPreview.Len() > 0 && !fn("preview", this.ActivityStreamsPreview.Values()) {
+		return
+	}
+	if this.W3IDDataIntegrityV1Proof != nil && this.W3IDDataIntegrityV1Proof.Len() > 0 && !fn("proof", this.W3IDDataIntegrityV1Proof.Values()) {
+		return
+	}
+	if this.W3IDSecurityV1PublicKey != nil && this.W3IDSecurityV1PublicKey.Len() > 0 && !fn("publicKey", this.W3IDSecurityV1PublicKey.Values()) {
+		return
+	}
+	if this.ActivityStreamsPublished != nil {
+		if v := this.ActivityStreamsPublished.Value(); v != nil && !fn("published", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsReplies != nil {
+		if v := this.ActivityStreamsReplies.Value(); v != nil && !fn("replies", []interface{}{v}) {
+			return
 		}
-	} else if lhs == nil && rhs != nil {
-		// Nil is less than anything else
-		return true
-	} else if rhs != nil && rhs == nil {
-		// Anything else is greater than nil
-		return false
-	} // Else: Both are nil
-	// End: Compare known properties
+	}
+	if this.ActivityStreamsShares != nil {
+		if v := this.ActivityStreamsShares.Value(); v != nil && !fn("shares", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDSecurityV1Signature != nil {
+		if v := this.W3IDSecurityV1Signature.Value(); v != nil && !fn("signature", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsStartTime != nil {
+		if v := this.ActivityStreamsStartTime.Value(); v != nil && !fn("startTime", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsStreams != nil && this.ActivityStreamsStreams.Len() > 0 && !fn("streams", this.ActivityStreamsStreams.Values()) {
+		return
+	}
+	if this.ActivityStreamsSummary != nil && this.ActivityStreamsSummary.Len() > 0 && !fn("summary", this.ActivityStreamsSummary.Values()) {
+		return
+	}
+	if this.ActivityStreamsTag != nil && this.ActivityStreamsTag.Len() > 0 && !fn("tag", this.ActivityStreamsTag.Values()) {
+		return
+	}
+	if this.ActivityStreamsTo != nil && this.ActivityStreamsTo.Len() > 0 && !fn("to", this.ActivityStreamsTo.Values()) {
+		return
+	}
+	if this.JSONLDType != nil && this.JSONLDType.Len() > 0 && !fn("type", this.JSONLDType.Values()) {
+		return
+	}
+	if this.ActivityStreamsUpdated != nil {
+		if v := this.ActivityStreamsUpdated.Value(); v != nil && !fn("updated", []interface{}{v}) {
+			return
+		}
+	}
+	if this.ActivityStreamsUrl != nil && this.ActivityStreamsUrl.Len() > 0 && !fn("url", this.ActivityStreamsUrl.Values()) {
+		return
+	}
 
-	// Begin: Compare unknown properties (only by number of them)
-	if len(this.unknown) < len(o.GetUnknownProperties()) {
-		return true
-	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
-		return false
-	} // End: Compare unknown properties (only by number of them)
+}
 
-	// All properties are the same.
-	return false
+// RangeUnknownProperties calls the function with each unknown property in the
+// order of their names, until it returns false. The @context of the document
+// the Application was deserialized from is skipped.
+func (this ActivityStreamsApplication) RangeUnknownProperties(fn func(name string, v interface{}) bool) {
+	names := make([]string, 0, len(this.unknown))
+	for k := range this.unknown {
+		if k != "@context" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !fn(k, this.unknown[k]) {
+			return
+		}
+	}
 }
 
 // Serialize converts this into an interface representation suitable for
@@ -1350,6 +2140,16 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsAltitude.Name()] = i
 		}
+
+	}
+	// Maybe serialize property "assertionMethod"
+	if this.W3IDMultikeyV1AssertionMethod != nil {
+		if i, err := this.W3IDMultikeyV1AssertionMethod.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDMultikeyV1AssertionMethod.Name()] = i
+		}
+
 	}
 	// Maybe serialize property "attachment"
 	if this.ActivityStreamsAttachment != nil {
@@ -1358,6 +2158,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsAttachment.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "attributedTo"
 	if this.ActivityStreamsAttributedTo != nil {
@@ -1366,6 +2167,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsAttributedTo.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "audience"
 	if this.ActivityStreamsAudience != nil {
@@ -1374,6 +2176,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsAudience.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "bcc"
 	if this.ActivityStreamsBcc != nil {
@@ -1382,6 +2185,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsBcc.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "bto"
 	if this.ActivityStreamsBto != nil {
@@ -1390,6 +2194,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsBto.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "cc"
 	if this.ActivityStreamsCc != nil {
@@ -1398,6 +2203,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsCc.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "content"
 	if this.ActivityStreamsContent != nil {
@@ -1406,6 +2212,11 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsContent.Name()] = i
 		}
+		if i, err := this.ActivityStreamsContent.SerializeMap(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsContent.Name()+"Map"] = i
+		}
 	}
 	// Maybe serialize property "context"
 	if this.ActivityStreamsContext != nil {
@@ -1414,6 +2225,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsContext.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "duration"
 	if this.ActivityStreamsDuration != nil {
@@ -1422,6 +2234,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsDuration.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "endTime"
 	if this.ActivityStreamsEndTime != nil {
@@ -1430,6 +2243,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsEndTime.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "followers"
 	if this.ActivityStreamsFollowers != nil {
@@ -1438,6 +2252,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsFollowers.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "following"
 	if this.ActivityStreamsFollowing != nil {
@@ -1446,6 +2261,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsFollowing.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "generator"
 	if this.ActivityStreamsGenerator != nil {
@@ -1454,6 +2270,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsGenerator.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "icon"
 	if this.ActivityStreamsIcon != nil {
@@ -1462,6 +2279,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsIcon.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "id"
 	if this.JSONLDId != nil {
@@ -1470,6 +2288,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.JSONLDId.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "image"
 	if this.ActivityStreamsImage != nil {
@@ -1478,6 +2297,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsImage.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "inReplyTo"
 	if this.ActivityStreamsInReplyTo != nil {
@@ -1486,6 +2306,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsInReplyTo.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "inbox"
 	if this.ActivityStreamsInbox != nil {
@@ -1494,6 +2315,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsInbox.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "liked"
 	if this.ActivityStreamsLiked != nil {
@@ -1502,6 +2324,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsLiked.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "likes"
 	if this.ActivityStreamsLikes != nil {
@@ -1510,6 +2333,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsLikes.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "location"
 	if this.ActivityStreamsLocation != nil {
@@ -1518,6 +2342,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsLocation.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "mediaType"
 	if this.ActivityStreamsMediaType != nil {
@@ -1526,6 +2351,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsMediaType.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "name"
 	if this.ActivityStreamsName != nil {
@@ -1534,6 +2360,11 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsName.Name()] = i
 		}
+		if i, err := this.ActivityStreamsName.SerializeMap(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsName.Name()+"Map"] = i
+		}
 	}
 	// Maybe serialize property "object"
 	if this.ActivityStreamsObject != nil {
@@ -1542,6 +2373,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsObject.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "outbox"
 	if this.ActivityStreamsOutbox != nil {
@@ -1550,6 +2382,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsOutbox.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "preferredUsername"
 	if this.ActivityStreamsPreferredUsername != nil {
@@ -1558,6 +2391,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsPreferredUsername.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "preview"
 	if this.ActivityStreamsPreview != nil {
@@ -1566,6 +2400,16 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsPreview.Name()] = i
 		}
+
+	}
+	// Maybe serialize property "proof"
+	if this.W3IDDataIntegrityV1Proof != nil {
+		if i, err := this.W3IDDataIntegrityV1Proof.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1Proof.Name()] = i
+		}
+
 	}
 	// Maybe serialize property "publicKey"
 	if this.W3IDSecurityV1PublicKey != nil {
@@ -1574,6 +2418,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.W3IDSecurityV1PublicKey.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "published"
 	if this.ActivityStreamsPublished != nil {
@@ -1582,6 +2427,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsPublished.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "replies"
 	if this.ActivityStreamsReplies != nil {
@@ -1590,6 +2436,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsReplies.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "shares"
 	if this.ActivityStreamsShares != nil {
@@ -1598,6 +2445,16 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsShares.Name()] = i
 		}
+
+	}
+	// Maybe serialize property "signature"
+	if this.W3IDSecurityV1Signature != nil {
+		if i, err := this.W3IDSecurityV1Signature.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDSecurityV1Signature.Name()] = i
+		}
+
 	}
 	// Maybe serialize property "startTime"
 	if this.ActivityStreamsStartTime != nil {
@@ -1606,6 +2463,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsStartTime.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "streams"
 	if this.ActivityStreamsStreams != nil {
@@ -1614,6 +2472,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsStreams.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "summary"
 	if this.ActivityStreamsSummary != nil {
@@ -1622,6 +2481,11 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsSummary.Name()] = i
 		}
+		if i, err := this.ActivityStreamsSummary.SerializeMap(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.ActivityStreamsSummary.Name()+"Map"] = i
+		}
 	}
 	// Maybe serialize property "tag"
 	if this.ActivityStreamsTag != nil {
@@ -1630,6 +2494,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsTag.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "to"
 	if this.ActivityStreamsTo != nil {
@@ -1638,6 +2503,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsTo.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "type"
 	if this.JSONLDType != nil {
@@ -1646,6 +2512,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.JSONLDType.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "updated"
 	if this.ActivityStreamsUpdated != nil {
@@ -1654,6 +2521,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsUpdated.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "url"
 	if this.ActivityStreamsUrl != nil {
@@ -1662,6 +2530,7 @@ func (this ActivityStreamsApplication) Serialize() (map[string]interface{}, erro
 		} else if i != nil {
 			m[this.ActivityStreamsUrl.Name()] = i
 		}
+
 	}
 	// End: Serialize known properties
 
@@ -1872,11 +2741,261 @@ func (this *ActivityStreamsApplication) SetJSONLDType(i vocab.JSONLDTypeProperty
 	this.JSONLDType = i
 }
 
+// SetUnknownProperty sets the unknown property with the name, which is serialized
+// with the value as is. The value must be marshallable into JSON. It is not
+// serialized if the name is the name of a property known to the Application
+// type.
+func (this *ActivityStreamsApplication) SetUnknownProperty(name string, v interface{}) {
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
+	this.unknown[name] = v
+}
+
+// SetW3IDDataIntegrityV1Proof sets the "proof" property.
+func (this *ActivityStreamsApplication) SetW3IDDataIntegrityV1Proof(i vocab.W3IDDataIntegrityV1ProofProperty) {
+	this.W3IDDataIntegrityV1Proof = i
+}
+
+// SetW3IDMultikeyV1AssertionMethod sets the "assertionMethod" property.
+func (this *ActivityStreamsApplication) SetW3IDMultikeyV1AssertionMethod(i vocab.W3IDMultikeyV1AssertionMethodProperty) {
+	this.W3IDMultikeyV1AssertionMethod = i
+}
+
 // SetW3IDSecurityV1PublicKey sets the "publicKey" property.
 func (this *ActivityStreamsApplication) SetW3IDSecurityV1PublicKey(i vocab.W3IDSecurityV1PublicKeyProperty) {
 	this.W3IDSecurityV1PublicKey = i
 }
 
+// SetW3IDSecurityV1Signature sets the "signature" property.
+func (this *ActivityStreamsApplication) SetW3IDSecurityV1Signature(i vocab.W3IDSecurityV1SignatureProperty) {
+	this.W3IDSecurityV1Signature = i
+}
+
+// Validate returns an error if this Application violates its vocabulary: if a
+// value is out of the range of its property, if an IRI is not absolute, or if
+// a property required by the specification is missing. The error names the
+// property of the first violation found.
+func (this ActivityStreamsApplication) Validate() error {
+	if this.ActivityStreamsAltitude != nil {
+		if err := this.ActivityStreamsAltitude.Validate(); err != nil {
+			return fmt.Errorf("altitude: %v", err)
+		}
+	}
+	if this.W3IDMultikeyV1AssertionMethod != nil {
+		if err := this.W3IDMultikeyV1AssertionMethod.Validate(); err != nil {
+			return fmt.Errorf("assertionMethod: %v", err)
+		}
+	}
+	if this.ActivityStreamsAttachment != nil {
+		if err := this.ActivityStreamsAttachment.Validate(); err != nil {
+			return fmt.Errorf("attachment: %v", err)
+		}
+	}
+	if this.ActivityStreamsAttributedTo != nil {
+		if err := this.ActivityStreamsAttributedTo.Validate(); err != nil {
+			return fmt.Errorf("attributedTo: %v", err)
+		}
+	}
+	if this.ActivityStreamsAudience != nil {
+		if err := this.ActivityStreamsAudience.Validate(); err != nil {
+			return fmt.Errorf("audience: %v", err)
+		}
+	}
+	if this.ActivityStreamsBcc != nil {
+		if err := this.ActivityStreamsBcc.Validate(); err != nil {
+			return fmt.Errorf("bcc: %v", err)
+		}
+	}
+	if this.ActivityStreamsBto != nil {
+		if err := this.ActivityStreamsBto.Validate(); err != nil {
+			return fmt.Errorf("bto: %v", err)
+		}
+	}
+	if this.ActivityStreamsCc != nil {
+		if err := this.ActivityStreamsCc.Validate(); err != nil {
+			return fmt.Errorf("cc: %v", err)
+		}
+	}
+	if this.ActivityStreamsContent != nil {
+		if err := this.ActivityStreamsContent.Validate(); err != nil {
+			return fmt.Errorf("content: %v", err)
+		}
+	}
+	if this.ActivityStreamsContext != nil {
+		if err := this.ActivityStreamsContext.Validate(); err != nil {
+			return fmt.Errorf("context: %v", err)
+		}
+	}
+	if this.ActivityStreamsDuration != nil {
+		if err := this.ActivityStreamsDuration.Validate(); err != nil {
+			return fmt.Errorf("duration: %v", err)
+		}
+	}
+	if this.ActivityStreamsEndTime != nil {
+		if err := this.ActivityStreamsEndTime.Validate(); err != nil {
+			return fmt.Errorf("endTime: %v", err)
+		}
+	}
+	if this.ActivityStreamsFollowers != nil {
+		if err := this.ActivityStreamsFollowers.Validate(); err != nil {
+			return fmt.Errorf("followers: %v", err)
+		}
+	}
+	if this.ActivityStreamsFollowing != nil {
+		if err := this.ActivityStreamsFollowing.Validate(); err != nil {
+			return fmt.Errorf("following: %v", err)
+		}
+	}
+	if this.ActivityStreamsGenerator != nil {
+		if err := this.ActivityStreamsGenerator.Validate(); err != nil {
+			return fmt.Errorf("generator: %v", err)
+		}
+	}
+	if this.ActivityStreamsIcon != nil {
+		if err := this.ActivityStreamsIcon.Validate(); err != nil {
+			return fmt.Errorf("icon: %v", err)
+		}
+	}
+	if this.JSONLDId != nil {
+		if err := this.JSONLDId.Validate(); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+	if this.ActivityStreamsImage != nil {
+		if err := this.ActivityStreamsImage.Validate(); err != nil {
+			return fmt.Errorf("image: %v", err)
+		}
+	}
+	if this.ActivityStreamsInReplyTo != nil {
+		if err := this.ActivityStreamsInReplyTo.Validate(); err != nil {
+			return fmt.Errorf("inReplyTo: %v", err)
+		}
+	}
+	if this.ActivityStreamsInbox != nil {
+		if err := this.ActivityStreamsInbox.Validate(); err != nil {
+			return fmt.Errorf("inbox: %v", err)
+		}
+	}
+	if this.ActivityStreamsLiked != nil {
+		if err := this.ActivityStreamsLiked.Validate(); err != nil {
+			return fmt.Errorf("liked: %v", err)
+		}
+	}
+	if this.ActivityStreamsLikes != nil {
+		if err := this.ActivityStreamsLikes.Validate(); err != nil {
+			return fmt.Errorf("likes: %v", err)
+		}
+	}
+	if this.ActivityStreamsLocation != nil {
+		if err := this.ActivityStreamsLocation.Validate(); err != nil {
+			return fmt.Errorf("location: %v", err)
+		}
+	}
+	if this.ActivityStreamsMediaType != nil {
+		if err := this.ActivityStreamsMediaType.Validate(); err != nil {
+			return fmt.Errorf("mediaType: %v", err)
+		}
+	}
+	if this.ActivityStreamsName != nil {
+		if err := this.ActivityStreamsName.Validate(); err != nil {
+			return fmt.Errorf("name: %v", err)
+		}
+	}
+	if this.ActivityStreamsObject != nil {
+		if err := this.ActivityStreamsObject.Validate(); err != nil {
+			return fmt.Errorf("object: %v", err)
+		}
+	}
+	if this.ActivityStreamsOutbox != nil {
+		if err := this.ActivityStreamsOutbox.Validate(); err != nil {
+			return fmt.Errorf("outbox: %v", err)
+		}
+	}
+	if this.ActivityStreamsPreferredUsername != nil {
+		if err := this.ActivityStreamsPreferredUsername.Validate(); err != nil {
+			return fmt.Errorf("preferredUsername: %v", err)
+		}
+	}
+	if this.ActivityStreamsPreview != nil {
+		if err := this.ActivityStreamsPreview.Validate(); err != nil {
+			return fmt.Errorf("preview: %v", err)
+		}
+	}
+	if this.W3IDDataIntegrityV1Proof != nil {
+		if err := this.W3IDDataIntegrityV1Proof.Validate(); err != nil {
+			return fmt.Errorf("proof: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1PublicKey != nil {
+		if err := this.W3IDSecurityV1PublicKey.Validate(); err != nil {
+			return fmt.Errorf("publicKey: %v", err)
+		}
+	}
+	if this.ActivityStreamsPublished != nil {
+		if err := this.ActivityStreamsPublished.Validate(); err != nil {
+			return fmt.Errorf("published: %v", err)
+		}
+	}
+	if this.ActivityStreamsReplies != nil {
+		if err := this.ActivityStreamsReplies.Validate(); err != nil {
+			return fmt.Errorf("replies: %v", err)
+		}
+	}
+	if this.ActivityStreamsShares != nil {
+		if err := this.ActivityStreamsShares.Validate(); err != nil {
+			return fmt.Errorf("shares: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1Signature != nil {
+		if err := this.W3IDSecurityV1Signature.Validate(); err != nil {
+			return fmt.Errorf("signature: %v", err)
+		}
+	}
+	if this.ActivityStreamsStartTime != nil {
+		if err := this.ActivityStreamsStartTime.Validate(); err != nil {
+			return fmt.Errorf("startTime: %v", err)
+		}
+	}
+	if this.ActivityStreamsStreams != nil {
+		if err := this.ActivityStreamsStreams.Validate(); err != nil {
+			return fmt.Errorf("streams: %v", err)
+		}
+	}
+	if this.ActivityStreamsSummary != nil {
+		if err := this.ActivityStreamsSummary.Validate(); err != nil {
+			return fmt.Errorf("summary: %v", err)
+		}
+	}
+	if this.ActivityStreamsTag != nil {
+		if err := this.ActivityStreamsTag.Validate(); err != nil {
+			return fmt.Errorf("tag: %v", err)
+		}
+	}
+	if this.ActivityStreamsTo != nil {
+		if err := this.ActivityStreamsTo.Validate(); err != nil {
+			return fmt.Errorf("to: %v", err)
+		}
+	}
+	if this.JSONLDType != nil {
+		if err := this.JSONLDType.Validate(); err != nil {
+			return fmt.Errorf("type: %v", err)
+		}
+	}
+	if this.ActivityStreamsUpdated != nil {
+		if err := this.ActivityStreamsUpdated.Validate(); err != nil {
+			return fmt.Errorf("updated: %v", err)
+		}
+	}
+	if this.ActivityStreamsUrl != nil {
+		if err := this.ActivityStreamsUrl.Validate(); err != nil {
+			return fmt.Errorf("url: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // VocabularyURI returns the vocabulary's URI as a string.
 func (this ActivityStreamsApplication) VocabularyURI() string {
 	return "https://www.w3.org/ns/activitystreams"
@@ -117,6 +117,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsPreviewProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializePreviewPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsPreviewProperty, error)
+	// DeserializeProofPropertyW3IDDataIntegrityV1 returns the deserialization
+	// method for the "W3IDDataIntegrityV1ProofProperty" non-functional
+	// property in the vocabulary "W3IDDataIntegrityV1"
+	DeserializeProofPropertyW3IDDataIntegrityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDDataIntegrityV1ProofProperty, error)
 	// DeserializePublishedPropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsPublishedProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
@@ -129,6 +133,10 @@ type privateManager interface {
 	// method for the "ActivityStreamsSharesProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
 	DeserializeSharesPropertyActivityStreams() func(map[string]interface{}, map[string]string) (vocab.ActivityStreamsSharesProperty, error)
+	// DeserializeSignaturePropertyW3IDSecurityV1 returns the deserialization
+	// method for the "W3IDSecurityV1SignatureProperty" non-functional
+	// property in the vocabulary "W3IDSecurityV1"
+	DeserializeSignaturePropertyW3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1SignatureProperty, error)
 	// DeserializeStartTimePropertyActivityStreams returns the deserialization
 	// method for the "ActivityStreamsStartTimeProperty" non-functional
 	// property in the vocabulary "ActivityStreams"
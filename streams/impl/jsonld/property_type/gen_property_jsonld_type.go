@@ -4,6 +4,7 @@ package propertytype
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	anyuri "github.com/go-fed/activity/streams/values/anyURI"
 	string1 "github.com/go-fed/activity/streams/values/string"
 	vocab "github.com/go-fed/activity/streams/vocab"
@@ -56,6 +57,21 @@ func deserializeJSONLDTypePropertyIterator(i interface{}, aliasMap map[string]st
 	return this, nil
 }
 
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this JSONLDTypePropertyIterator) Equals(o vocab.JSONLDTypePropertyIterator) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.GetXMLSchemaAnyURI(), o.GetXMLSchemaAnyURI()) && !anyuri.LessAnyURI(o.GetXMLSchemaAnyURI(), this.GetXMLSchemaAnyURI())
+	} else if this.IsXMLSchemaString() {
+		return !string1.LessString(this.GetXMLSchemaString(), o.GetXMLSchemaString()) && !string1.LessString(o.GetXMLSchemaString(), this.GetXMLSchemaString())
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, ok := o.(*JSONLDTypePropertyIterator)
+	return ok && jsonld.EqualValues(this.unknown, rhs.unknown)
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return an arbitrary value.
 func (this JSONLDTypePropertyIterator) GetIRI() *url.URL {
@@ -201,6 +217,38 @@ func (this *JSONLDTypePropertyIterator) SetXMLSchemaString(v string) {
 	this.hasStringMember = true
 }
 
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this JSONLDTypePropertyIterator) Validate() error {
+	if this.IsXMLSchemaAnyURI() {
+		if !this.GetXMLSchemaAnyURI().IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.GetXMLSchemaAnyURI())
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this JSONLDTypePropertyIterator) Value() interface{} {
+	if this.IsXMLSchemaAnyURI() {
+		return this.GetXMLSchemaAnyURI()
+	}
+	if this.IsXMLSchemaString() {
+		return this.GetXMLSchemaString()
+	}
+
+	return this.unknown
+}
+
 // clear ensures no value of this property is set. Calling HasAny or any of the
 // 'Is' methods afterwards will return false.
 func (this *JSONLDTypePropertyIterator) clear() {
@@ -209,6 +257,23 @@ func (this *JSONLDTypePropertyIterator) clear() {
 	this.unknown = nil
 }
 
+// clone returns a deep copy of this iterator, belonging to the parent property.
+func (this JSONLDTypePropertyIterator) clone(parent vocab.JSONLDTypeProperty) *JSONLDTypePropertyIterator {
+	c := &JSONLDTypePropertyIterator{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		c.xmlschemaAnyURIMember = &u
+	}
+	c.xmlschemaStringMember = this.xmlschemaStringMember
+	c.hasStringMember = this.hasStringMember
+	c.myIdx = this.myIdx
+	c.parent = parent
+	return c
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -325,6 +390,19 @@ func (this JSONLDTypeProperty) Begin() vocab.JSONLDTypePropertyIterator {
 	}
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Each of its values is copied.
+func (this JSONLDTypeProperty) Clone() vocab.JSONLDTypeProperty {
+	c := &JSONLDTypeProperty{alias: this.alias}
+	if this.properties != nil {
+		c.properties = make([]*JSONLDTypePropertyIterator, len(this.properties))
+		for i, it := range this.properties {
+			c.properties[i] = it.clone(c)
+		}
+	}
+	return c
+}
+
 // Empty returns returns true if there are no elements.
 func (this JSONLDTypeProperty) Empty() bool {
 	return this.Len() == 0
@@ -337,6 +415,20 @@ func (this JSONLDTypeProperty) End() vocab.JSONLDTypePropertyIterator {
 	return nil
 }
 
+// Equals determines whether this property has the same values as the other, in
+// the same order.
+func (this JSONLDTypeProperty) Equals(o vocab.JSONLDTypeProperty) bool {
+	if o == nil || this.Len() != o.Len() {
+		return false
+	}
+	for i, it := range this.properties {
+		if !it.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "type".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -537,7 +629,9 @@ func (this *JSONLDTypeProperty) Remove(idx int) {
 // properties. It is exposed for alternatives to go-fed implementations to use.
 func (this JSONLDTypeProperty) Serialize() (interface{}, error) {
 	s := make([]interface{}, 0, len(this.properties))
+
 	for _, iterator := range this.properties {
+
 		if b, err := iterator.serialize(); err != nil {
 			return s, err
 		} else {
@@ -594,3 +688,26 @@ func (this *JSONLDTypeProperty) SetXMLSchemaString(idx int, v string) {
 func (this JSONLDTypeProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
 }
+
+// Validate returns an error naming the first value of this property that is out
+// of its range, or is an IRI that is not absolute.
+func (this JSONLDTypeProperty) Validate() error {
+	for i, it := range this.properties {
+		if err := it.Validate(); err != nil {
+			return fmt.Errorf("value %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Values returns the values of this property in order, as returned by the Value
+// method of each of them.
+func (this JSONLDTypeProperty) Values() []interface{} {
+	v := make([]interface{}, 0, len(this.properties))
+	for _, it := range this.properties {
+		if value := it.Value(); value != nil {
+			v = append(v, value)
+		}
+	}
+	return v
+}
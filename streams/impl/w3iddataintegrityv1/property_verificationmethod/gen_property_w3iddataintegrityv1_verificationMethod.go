@@ -0,0 +1,240 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertyverificationmethod
+
+import (
+	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
+	anyuri "github.com/go-fed/activity/streams/values/anyURI"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// W3IDDataIntegrityV1VerificationMethodProperty is the functional property
+// "verificationMethod". It is permitted to be a single nilable value type.
+type W3IDDataIntegrityV1VerificationMethodProperty struct {
+	xmlschemaAnyURIMember *url.URL
+	unknown               interface{}
+	alias                 string
+}
+
+// DeserializeVerificationMethodProperty creates a "verificationMethod" property
+// from an interface representation that has been unmarshalled from a text or
+// binary format.
+func DeserializeVerificationMethodProperty(m map[string]interface{}, aliasMap map[string]string) (*W3IDDataIntegrityV1VerificationMethodProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://w3id.org/security/data-integrity/v1"]; ok {
+		alias = a
+	}
+	propName := "verificationMethod"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "verificationMethod")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		if v, err := anyuri.DeserializeAnyURI(i); err == nil {
+			this := &W3IDDataIntegrityV1VerificationMethodProperty{
+				alias:                 alias,
+				xmlschemaAnyURIMember: v,
+			}
+			return this, nil
+		}
+		this := &W3IDDataIntegrityV1VerificationMethodProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewW3IDDataIntegrityV1VerificationMethodProperty creates a new
+// verificationMethod property.
+func NewW3IDDataIntegrityV1VerificationMethodProperty() *W3IDDataIntegrityV1VerificationMethodProperty {
+	return &W3IDDataIntegrityV1VerificationMethodProperty{alias: ""}
+}
+
+// Clear ensures no value of this property is set. Calling IsXMLSchemaAnyURI
+// afterwards will return false.
+func (this *W3IDDataIntegrityV1VerificationMethodProperty) Clear() {
+	this.unknown = nil
+	this.xmlschemaAnyURIMember = nil
+}
+
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Clone() vocab.W3IDDataIntegrityV1VerificationMethodProperty {
+	c := &W3IDDataIntegrityV1VerificationMethodProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	if this.xmlschemaAnyURIMember != nil {
+		u := *this.xmlschemaAnyURIMember
+		c.xmlschemaAnyURIMember = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Equals(o vocab.W3IDDataIntegrityV1VerificationMethodProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsXMLSchemaAnyURI() {
+		return !anyuri.LessAnyURI(this.Get(), o.Get()) && !anyuri.LessAnyURI(o.Get(), this.Get())
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
+// Get returns the value of this property. When IsXMLSchemaAnyURI returns false,
+// Get will return any arbitrary value.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Get() *url.URL {
+	return this.xmlschemaAnyURIMember
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) GetIRI() *url.URL {
+	return this.xmlschemaAnyURIMember
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) HasAny() bool {
+	return this.IsXMLSchemaAnyURI()
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) IsIRI() bool {
+	return this.xmlschemaAnyURIMember != nil
+}
+
+// IsXMLSchemaAnyURI returns true if this property is set and not an IRI.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) IsXMLSchemaAnyURI() bool {
+	return this.xmlschemaAnyURIMember != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/data-integrity/v1": this.alias}
+	var child map[string]string
+
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) KindIndex() int {
+	if this.IsXMLSchemaAnyURI() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) LessThan(o vocab.W3IDDataIntegrityV1VerificationMethodProperty) bool {
+	if this.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return true
+	} else if o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return false
+	}
+	// LessThan comparison for the single value or unknown value.
+	if !this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Both are unknowns.
+		return false
+	} else if this.IsXMLSchemaAnyURI() && !o.IsXMLSchemaAnyURI() {
+		// Values are always greater than unknown values.
+		return false
+	} else if !this.IsXMLSchemaAnyURI() && o.IsXMLSchemaAnyURI() {
+		// Unknowns are always less than known values.
+		return true
+	} else {
+		// Actual comparison.
+		return anyuri.LessAnyURI(this.Get(), o.Get())
+	}
+}
+
+// Name returns the name of this property: "verificationMethod".
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "verificationMethod"
+	} else {
+		return "verificationMethod"
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Serialize() (interface{}, error) {
+	if this.IsXMLSchemaAnyURI() {
+		return anyuri.SerializeAnyURI(this.Get())
+	}
+	return this.unknown, nil
+}
+
+// Set sets the value of this property. Calling IsXMLSchemaAnyURI afterwards will
+// return true.
+func (this *W3IDDataIntegrityV1VerificationMethodProperty) Set(v *url.URL) {
+	this.Clear()
+	this.xmlschemaAnyURIMember = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *W3IDDataIntegrityV1VerificationMethodProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.Set(v)
+}
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Validate() error {
+	if this.IsXMLSchemaAnyURI() {
+		if !this.Get().IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.Get())
+		}
+		return nil
+	}
+	if this.unknown != nil {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this W3IDDataIntegrityV1VerificationMethodProperty) Value() interface{} {
+	if this.IsXMLSchemaAnyURI() {
+		return this.Get()
+	}
+
+	return this.unknown
+}
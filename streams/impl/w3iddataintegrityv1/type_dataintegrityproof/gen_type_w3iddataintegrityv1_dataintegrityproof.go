@@ -0,0 +1,732 @@
+// Code generated by astool. DO NOT EDIT.
+
+package typedataintegrityproof
+
+import (
+	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
+	"strings"
+)
+
+// A Data Integrity proof of a document, whose cryptographic suite is named by the
+// cryptosuite
+type W3IDDataIntegrityV1DataIntegrityProof struct {
+	W3IDDataIntegrityV1Created            vocab.W3IDDataIntegrityV1CreatedProperty
+	W3IDDataIntegrityV1Cryptosuite        vocab.W3IDDataIntegrityV1CryptosuiteProperty
+	JSONLDId                              vocab.JSONLDIdProperty
+	W3IDDataIntegrityV1ProofPurpose       vocab.W3IDDataIntegrityV1ProofPurposeProperty
+	W3IDDataIntegrityV1ProofValue         vocab.W3IDDataIntegrityV1ProofValueProperty
+	JSONLDType                            vocab.JSONLDTypeProperty
+	W3IDDataIntegrityV1VerificationMethod vocab.W3IDDataIntegrityV1VerificationMethodProperty
+	alias                                 string
+	unknown                               map[string]interface{}
+}
+
+// DataIntegrityProofIsDisjointWith returns true if the other provided type is
+// disjoint with the DataIntegrityProof type.
+func DataIntegrityProofIsDisjointWith(other vocab.Type) bool {
+	// Shortcut implementation: is not disjoint with anything.
+	return false
+}
+
+// DataIntegrityProofIsExtendedBy returns true if the other provided type extends
+// from the DataIntegrityProof type. Note that it returns false if the types
+// are the same; see the "IsOrExtendsDataIntegrityProof" variant instead.
+func DataIntegrityProofIsExtendedBy(other vocab.Type) bool {
+	// Shortcut implementation: is not extended by anything.
+	return false
+}
+
+// DeserializeDataIntegrityProof creates a DataIntegrityProof from a map
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeDataIntegrityProof(m map[string]interface{}, aliasMap map[string]string) (*W3IDDataIntegrityV1DataIntegrityProof, error) {
+	alias := ""
+	aliasPrefix := ""
+	if a, ok := aliasMap["https://w3id.org/security/data-integrity/v1"]; ok {
+		alias = a
+		aliasPrefix = a + ":"
+	}
+	this := &W3IDDataIntegrityV1DataIntegrityProof{
+		alias:   alias,
+		unknown: make(map[string]interface{}),
+	}
+	if typeValue, ok := m["type"]; !ok {
+		return nil, fmt.Errorf("no \"type\" property in map")
+	} else if typeString, ok := typeValue.(string); ok {
+		typeName := strings.TrimPrefix(typeString, aliasPrefix)
+		if typeName != "DataIntegrityProof" {
+			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "DataIntegrityProof", typeName)
+		}
+		// Fall through, success in finding a proper Type
+	} else if arrType, ok := typeValue.([]interface{}); ok {
+		found := false
+		for _, elemVal := range arrType {
+			if typeString, ok := elemVal.(string); ok && strings.TrimPrefix(typeString, aliasPrefix) == "DataIntegrityProof" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "DataIntegrityProof")
+		}
+		// Fall through, success in finding a proper Type
+	} else {
+		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+	}
+	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeCreatedPropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1Created = p
+	}
+	if p, err := mgr.DeserializeCryptosuitePropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1Cryptosuite = p
+	}
+	if p, err := mgr.DeserializeIdPropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDId = p
+	}
+	if p, err := mgr.DeserializeProofPurposePropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1ProofPurpose = p
+	}
+	if p, err := mgr.DeserializeProofValuePropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1ProofValue = p
+	}
+	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDType = p
+	}
+	if p, err := mgr.DeserializeVerificationMethodPropertyW3IDDataIntegrityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDDataIntegrityV1VerificationMethod = p
+	}
+	// End: Known property deserialization
+
+	// Begin: Unknown deserialization
+	for k, v := range m {
+		// Begin: Code that ensures a property name is unknown
+		if k == "created" {
+			continue
+		} else if k == "cryptosuite" {
+			continue
+		} else if k == "id" {
+			continue
+		} else if k == "proofPurpose" {
+			continue
+		} else if k == "proofValue" {
+			continue
+		} else if k == "type" {
+			continue
+		} else if k == "verificationMethod" {
+			continue
+		} // End: Code that ensures a property name is unknown
+
+		this.unknown[k] = v
+	}
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// IsOrExtendsDataIntegrityProof returns true if the other provided type is the
+// DataIntegrityProof type or extends from the DataIntegrityProof type.
+func IsOrExtendsDataIntegrityProof(other vocab.Type) bool {
+	if other.GetTypeName() == "DataIntegrityProof" {
+		return true
+	}
+	return DataIntegrityProofIsExtendedBy(other)
+}
+
+// NewW3IDDataIntegrityV1DataIntegrityProof creates a new DataIntegrityProof type
+func NewW3IDDataIntegrityV1DataIntegrityProof() *W3IDDataIntegrityV1DataIntegrityProof {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("DataIntegrityProof")
+	return &W3IDDataIntegrityV1DataIntegrityProof{
+		JSONLDType: typeProp,
+		alias:      "",
+		unknown:    make(map[string]interface{}),
+	}
+}
+
+// W3IDDataIntegrityV1DataIntegrityProofExtends returns true if the
+// DataIntegrityProof type extends from the other type.
+func W3IDDataIntegrityV1DataIntegrityProofExtends(other vocab.Type) bool {
+	// Shortcut implementation: this does not extend anything.
+	return false
+}
+
+// Clone returns a deep copy of this DataIntegrityProof, which can be modified
+// without changing this one. Its properties, values, IRIs, and unknown
+// properties are copied.
+func (this W3IDDataIntegrityV1DataIntegrityProof) Clone() vocab.W3IDDataIntegrityV1DataIntegrityProof {
+	c := &W3IDDataIntegrityV1DataIntegrityProof{
+		alias:   this.alias,
+		unknown: jsonld.CopyObject(this.unknown),
+	}
+	if this.W3IDDataIntegrityV1Created != nil {
+		c.W3IDDataIntegrityV1Created = this.W3IDDataIntegrityV1Created.Clone()
+	}
+	if this.W3IDDataIntegrityV1Cryptosuite != nil {
+		c.W3IDDataIntegrityV1Cryptosuite = this.W3IDDataIntegrityV1Cryptosuite.Clone()
+	}
+	if this.JSONLDId != nil {
+		c.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.W3IDDataIntegrityV1ProofPurpose != nil {
+		c.W3IDDataIntegrityV1ProofPurpose = this.W3IDDataIntegrityV1ProofPurpose.Clone()
+	}
+	if this.W3IDDataIntegrityV1ProofValue != nil {
+		c.W3IDDataIntegrityV1ProofValue = this.W3IDDataIntegrityV1ProofValue.Clone()
+	}
+	if this.JSONLDType != nil {
+		c.JSONLDType = this.JSONLDType.Clone()
+	}
+	if this.W3IDDataIntegrityV1VerificationMethod != nil {
+		c.W3IDDataIntegrityV1VerificationMethod = this.W3IDDataIntegrityV1VerificationMethod.Clone()
+	}
+	return c
+}
+
+// DeleteUnknownProperty removes the unknown property with the name.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) DeleteUnknownProperty(name string) {
+	delete(this.unknown, name)
+}
+
+// Equals determines whether this DataIntegrityProof has the same properties,
+// values, and unknown properties as the other. Values of non-functional
+// properties are compared in order, and the aliases used for vocabularies are
+// ignored.
+func (this W3IDDataIntegrityV1DataIntegrityProof) Equals(o vocab.W3IDDataIntegrityV1DataIntegrityProof) bool {
+	if o == nil {
+		return false
+	}
+	// Compare property "created"
+	if lhs, rhs := this.W3IDDataIntegrityV1Created, o.GetW3IDDataIntegrityV1Created(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "cryptosuite"
+	if lhs, rhs := this.W3IDDataIntegrityV1Cryptosuite, o.GetW3IDDataIntegrityV1Cryptosuite(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "proofPurpose"
+	if lhs, rhs := this.W3IDDataIntegrityV1ProofPurpose, o.GetW3IDDataIntegrityV1ProofPurpose(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "proofValue"
+	if lhs, rhs := this.W3IDDataIntegrityV1ProofValue, o.GetW3IDDataIntegrityV1ProofValue(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "verificationMethod"
+	if lhs, rhs := this.W3IDDataIntegrityV1VerificationMethod, o.GetW3IDDataIntegrityV1VerificationMethod(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+
+	// Compare unknown properties, except the @context of the document.
+	return jsonld.EqualObjects(this.unknown, o.GetUnknownProperties(), "@context")
+}
+
+// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetJSONLDId() vocab.JSONLDIdProperty {
+	return this.JSONLDId
+}
+
+// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetJSONLDType() vocab.JSONLDTypeProperty {
+	return this.JSONLDType
+}
+
+// GetTypeName returns the name of this type.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetTypeName() string {
+	return "DataIntegrityProof"
+}
+
+// GetUnknownProperties returns the unknown properties for the DataIntegrityProof
+// type. Note that this should not be used by app developers. It is only used
+// to help determine which implementation is LessThan the other. Developers
+// who are creating a different implementation of this type's interface can
+// use this method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GetUnknownProperty returns the value of the unknown property with the name, and
+// whether it is set. The value is as it was deserialized from JSON, such as a
+// string, float64, bool, map[string]interface{} or []interface{}.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetUnknownProperty(name string) (interface{}, bool) {
+	v, ok := this.unknown[name]
+	return v, ok
+}
+
+// GetW3IDDataIntegrityV1Created returns the "created" property if it exists, and
+// nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetW3IDDataIntegrityV1Created() vocab.W3IDDataIntegrityV1CreatedProperty {
+	return this.W3IDDataIntegrityV1Created
+}
+
+// GetW3IDDataIntegrityV1Cryptosuite returns the "cryptosuite" property if it
+// exists, and nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetW3IDDataIntegrityV1Cryptosuite() vocab.W3IDDataIntegrityV1CryptosuiteProperty {
+	return this.W3IDDataIntegrityV1Cryptosuite
+}
+
+// GetW3IDDataIntegrityV1ProofPurpose returns the "proofPurpose" property if it
+// exists, and nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetW3IDDataIntegrityV1ProofPurpose() vocab.W3IDDataIntegrityV1ProofPurposeProperty {
+	return this.W3IDDataIntegrityV1ProofPurpose
+}
+
+// GetW3IDDataIntegrityV1ProofValue returns the "proofValue" property if it
+// exists, and nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetW3IDDataIntegrityV1ProofValue() vocab.W3IDDataIntegrityV1ProofValueProperty {
+	return this.W3IDDataIntegrityV1ProofValue
+}
+
+// GetW3IDDataIntegrityV1VerificationMethod returns the "verificationMethod"
+// property if it exists, and nil otherwise.
+func (this W3IDDataIntegrityV1DataIntegrityProof) GetW3IDDataIntegrityV1VerificationMethod() vocab.W3IDDataIntegrityV1VerificationMethodProperty {
+	return this.W3IDDataIntegrityV1VerificationMethod
+}
+
+// IsExtending returns true if the DataIntegrityProof type extends from the other
+// type.
+func (this W3IDDataIntegrityV1DataIntegrityProof) IsExtending(other vocab.Type) bool {
+	return W3IDDataIntegrityV1DataIntegrityProofExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this W3IDDataIntegrityV1DataIntegrityProof) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/data-integrity/v1": this.alias}
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1Created, m)
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1Cryptosuite, m)
+	m = this.helperJSONLDContext(this.JSONLDId, m)
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1ProofPurpose, m)
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1ProofValue, m)
+	m = this.helperJSONLDContext(this.JSONLDType, m)
+	m = this.helperJSONLDContext(this.W3IDDataIntegrityV1VerificationMethod, m)
+
+	return m
+}
+
+// LessThan computes if this DataIntegrityProof is lesser, with an arbitrary but
+// stable determination.
+func (this W3IDDataIntegrityV1DataIntegrityProof) LessThan(o vocab.W3IDDataIntegrityV1DataIntegrityProof) bool {
+	// Begin: Compare known properties
+	// Compare property "created"
+	if lhs, rhs := this.W3IDDataIntegrityV1Created, o.GetW3IDDataIntegrityV1Created(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "cryptosuite"
+	if lhs, rhs := this.W3IDDataIntegrityV1Cryptosuite, o.GetW3IDDataIntegrityV1Cryptosuite(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "proofPurpose"
+	if lhs, rhs := this.W3IDDataIntegrityV1ProofPurpose, o.GetW3IDDataIntegrityV1ProofPurpose(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "proofValue"
+	if lhs, rhs := this.W3IDDataIntegrityV1ProofValue, o.GetW3IDDataIntegrityV1ProofValue(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "verificationMethod"
+	if lhs, rhs := this.W3IDDataIntegrityV1VerificationMethod, o.GetW3IDDataIntegrityV1VerificationMethod(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// RangeProperties calls the function with the name and values of each property of
+// this DataIntegrityProof that has a value, in the order of their names,
+// until it returns false. Each value is a type, an IRI, or the value of a
+// literal, as returned by the Value method of the property. Unknown
+// properties are not included.
+func (this W3IDDataIntegrityV1DataIntegrityProof) RangeProperties(fn func(name string, values []interface{}) bool) {
+	if this.W3IDDataIntegrityV1Created != nil {
+		if v := this.W3IDDataIntegrityV1Created.Value(); v != nil && !fn("created", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDDataIntegrityV1Cryptosuite != nil {
+		if v := this.W3IDDataIntegrityV1Cryptosuite.Value(); v != nil && !fn("cryptosuite", []interface{}{v}) {
+			return
+		}
+	}
+	if this.JSONLDId != nil {
+		if v := this.JSONLDId.Value(); v != nil && !fn("id", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDDataIntegrityV1ProofPurpose != nil {
+		if v := this.W3IDDataIntegrityV1ProofPurpose.Value(); v != nil && !fn("proofPurpose", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDDataIntegrityV1ProofValue != nil {
+		if v := this.W3IDDataIntegrityV1ProofValue.Value(); v != nil && !fn("proofValue", []interface{}{v}) {
+			return
+		}
+	}
+	if this.JSONLDType != nil && this.JSONLDType.Len() > 0 && !fn("type", this.JSONLDType.Values()) {
+		return
+	}
+	if this.W3IDDataIntegrityV1VerificationMethod != nil {
+		if v := this.W3IDDataIntegrityV1VerificationMethod.Value(); v != nil && !fn("verificationMethod", []interface{}{v}) {
+			return
+		}
+	}
+
+}
+
+// RangeUnknownProperties calls the function with each unknown property in the
+// order of their names, until it returns false. The @context of the document
+// the DataIntegrityProof was deserialized from is skipped.
+func (this W3IDDataIntegrityV1DataIntegrityProof) RangeUnknownProperties(fn func(name string, v interface{}) bool) {
+	names := make([]string, 0, len(this.unknown))
+	for k := range this.unknown {
+		if k != "@context" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !fn(k, this.unknown[k]) {
+			return
+		}
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this W3IDDataIntegrityV1DataIntegrityProof) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "DataIntegrityProof"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "DataIntegrityProof"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "created"
+	if this.W3IDDataIntegrityV1Created != nil {
+		if i, err := this.W3IDDataIntegrityV1Created.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1Created.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "cryptosuite"
+	if this.W3IDDataIntegrityV1Cryptosuite != nil {
+		if i, err := this.W3IDDataIntegrityV1Cryptosuite.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1Cryptosuite.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "id"
+	if this.JSONLDId != nil {
+		if i, err := this.JSONLDId.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDId.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "proofPurpose"
+	if this.W3IDDataIntegrityV1ProofPurpose != nil {
+		if i, err := this.W3IDDataIntegrityV1ProofPurpose.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1ProofPurpose.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "proofValue"
+	if this.W3IDDataIntegrityV1ProofValue != nil {
+		if i, err := this.W3IDDataIntegrityV1ProofValue.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1ProofValue.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "type"
+	if this.JSONLDType != nil {
+		if i, err := this.JSONLDType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDType.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "verificationMethod"
+	if this.W3IDDataIntegrityV1VerificationMethod != nil {
+		if i, err := this.W3IDDataIntegrityV1VerificationMethod.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDDataIntegrityV1VerificationMethod.Name()] = i
+		}
+
+	}
+	// End: Serialize known properties
+
+	// Begin: Serialize unknown properties
+	for k, v := range this.unknown {
+		// To be safe, ensure we aren't overwriting a known property
+		if _, has := m[k]; !has {
+			m[k] = v
+		}
+	}
+	// End: Serialize unknown properties
+
+	return m, nil
+}
+
+// SetJSONLDId sets the "id" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetJSONLDId(i vocab.JSONLDIdProperty) {
+	this.JSONLDId = i
+}
+
+// SetJSONLDType sets the "type" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetJSONLDType(i vocab.JSONLDTypeProperty) {
+	this.JSONLDType = i
+}
+
+// SetUnknownProperty sets the unknown property with the name, which is serialized
+// with the value as is. The value must be marshallable into JSON. It is not
+// serialized if the name is the name of a property known to the
+// DataIntegrityProof type.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetUnknownProperty(name string, v interface{}) {
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
+	this.unknown[name] = v
+}
+
+// SetW3IDDataIntegrityV1Created sets the "created" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetW3IDDataIntegrityV1Created(i vocab.W3IDDataIntegrityV1CreatedProperty) {
+	this.W3IDDataIntegrityV1Created = i
+}
+
+// SetW3IDDataIntegrityV1Cryptosuite sets the "cryptosuite" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetW3IDDataIntegrityV1Cryptosuite(i vocab.W3IDDataIntegrityV1CryptosuiteProperty) {
+	this.W3IDDataIntegrityV1Cryptosuite = i
+}
+
+// SetW3IDDataIntegrityV1ProofPurpose sets the "proofPurpose" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetW3IDDataIntegrityV1ProofPurpose(i vocab.W3IDDataIntegrityV1ProofPurposeProperty) {
+	this.W3IDDataIntegrityV1ProofPurpose = i
+}
+
+// SetW3IDDataIntegrityV1ProofValue sets the "proofValue" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetW3IDDataIntegrityV1ProofValue(i vocab.W3IDDataIntegrityV1ProofValueProperty) {
+	this.W3IDDataIntegrityV1ProofValue = i
+}
+
+// SetW3IDDataIntegrityV1VerificationMethod sets the "verificationMethod" property.
+func (this *W3IDDataIntegrityV1DataIntegrityProof) SetW3IDDataIntegrityV1VerificationMethod(i vocab.W3IDDataIntegrityV1VerificationMethodProperty) {
+	this.W3IDDataIntegrityV1VerificationMethod = i
+}
+
+// Validate returns an error if this DataIntegrityProof violates its vocabulary:
+// if a value is out of the range of its property, if an IRI is not absolute,
+// or if a property required by the specification is missing. The error names
+// the property of the first violation found.
+func (this W3IDDataIntegrityV1DataIntegrityProof) Validate() error {
+	if this.W3IDDataIntegrityV1Created != nil {
+		if err := this.W3IDDataIntegrityV1Created.Validate(); err != nil {
+			return fmt.Errorf("created: %v", err)
+		}
+	}
+	if this.W3IDDataIntegrityV1Cryptosuite != nil {
+		if err := this.W3IDDataIntegrityV1Cryptosuite.Validate(); err != nil {
+			return fmt.Errorf("cryptosuite: %v", err)
+		}
+	}
+	if this.JSONLDId != nil {
+		if err := this.JSONLDId.Validate(); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+	if this.W3IDDataIntegrityV1ProofPurpose != nil {
+		if err := this.W3IDDataIntegrityV1ProofPurpose.Validate(); err != nil {
+			return fmt.Errorf("proofPurpose: %v", err)
+		}
+	}
+	if this.W3IDDataIntegrityV1ProofValue != nil {
+		if err := this.W3IDDataIntegrityV1ProofValue.Validate(); err != nil {
+			return fmt.Errorf("proofValue: %v", err)
+		}
+	}
+	if this.JSONLDType != nil {
+		if err := this.JSONLDType.Validate(); err != nil {
+			return fmt.Errorf("type: %v", err)
+		}
+	}
+	if this.W3IDDataIntegrityV1VerificationMethod != nil {
+		if err := this.W3IDDataIntegrityV1VerificationMethod.Validate(); err != nil {
+			return fmt.Errorf("verificationMethod: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this W3IDDataIntegrityV1DataIntegrityProof) VocabularyURI() string {
+	return "https://w3id.org/security/data-integrity/v1"
+}
+
+// helperJSONLDContext obtains the context uris and their aliases from a property,
+// if it is not nil.
+func (this W3IDDataIntegrityV1DataIntegrityProof) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+	if i == nil {
+		return toMerge
+	}
+	for k, v := range i.JSONLDContext() {
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		toMerge[k] = v
+	}
+	return toMerge
+}
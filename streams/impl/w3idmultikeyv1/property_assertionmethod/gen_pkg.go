@@ -0,0 +1,22 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertyassertionmethod
+
+import vocab "github.com/go-fed/activity/streams/vocab"
+
+var mgr privateManager
+
+// privateManager abstracts the code-generated manager that provides access to
+// concrete implementations.
+type privateManager interface {
+	// DeserializeMultikeyW3IDMultikeyV1 returns the deserialization method
+	// for the "W3IDMultikeyV1Multikey" non-functional property in the
+	// vocabulary "W3IDMultikeyV1"
+	DeserializeMultikeyW3IDMultikeyV1() func(map[string]interface{}, map[string]string) (vocab.W3IDMultikeyV1Multikey, error)
+}
+
+// SetManager sets the manager package-global variable. For internal use only, do
+// not use as part of Application behavior. Must be called at golang init time.
+func SetManager(m privateManager) {
+	mgr = m
+}
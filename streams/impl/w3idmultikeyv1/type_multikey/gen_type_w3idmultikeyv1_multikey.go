@@ -0,0 +1,536 @@
+// Code generated by astool. DO NOT EDIT.
+
+package typemultikey
+
+import (
+	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
+	"strings"
+)
+
+// A public key encoded with its multicodec type in a multibase string
+type W3IDMultikeyV1Multikey struct {
+	W3IDMultikeyV1Controller         vocab.W3IDMultikeyV1ControllerProperty
+	JSONLDId                         vocab.JSONLDIdProperty
+	W3IDMultikeyV1PublicKeyMultibase vocab.W3IDMultikeyV1PublicKeyMultibaseProperty
+	JSONLDType                       vocab.JSONLDTypeProperty
+	alias                            string
+	unknown                          map[string]interface{}
+}
+
+// DeserializeMultikey creates a Multikey from a map representation that has been
+// unmarshalled from a text or binary format.
+func DeserializeMultikey(m map[string]interface{}, aliasMap map[string]string) (*W3IDMultikeyV1Multikey, error) {
+	alias := ""
+	aliasPrefix := ""
+	if a, ok := aliasMap["https://w3id.org/security/multikey/v1"]; ok {
+		alias = a
+		aliasPrefix = a + ":"
+	}
+	this := &W3IDMultikeyV1Multikey{
+		alias:   alias,
+		unknown: make(map[string]interface{}),
+	}
+	if typeValue, ok := m["type"]; !ok {
+		return nil, fmt.Errorf("no \"type\" property in map")
+	} else if typeString, ok := typeValue.(string); ok {
+		typeName := strings.TrimPrefix(typeString, aliasPrefix)
+		if typeName != "Multikey" {
+			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "Multikey", typeName)
+		}
+		// Fall through, success in finding a proper Type
+	} else if arrType, ok := typeValue.([]interface{}); ok {
+		found := false
+		for _, elemVal := range arrType {
+			if typeString, ok := elemVal.(string); ok && strings.TrimPrefix(typeString, aliasPrefix) == "Multikey" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "Multikey")
+		}
+		// Fall through, success in finding a proper Type
+	} else {
+		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+	}
+	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeControllerPropertyW3IDMultikeyV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDMultikeyV1Controller = p
+	}
+	if p, err := mgr.DeserializeIdPropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDId = p
+	}
+	if p, err := mgr.DeserializePublicKeyMultibasePropertyW3IDMultikeyV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDMultikeyV1PublicKeyMultibase = p
+	}
+	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDType = p
+	}
+	// End: Known property deserialization
+
+	// Begin: Unknown deserialization
+	for k, v := range m {
+		// Begin: Code that ensures a property name is unknown
+		if k == "controller" {
+			continue
+		} else if k == "id" {
+			continue
+		} else if k == "publicKeyMultibase" {
+			continue
+		} else if k == "type" {
+			continue
+		} // End: Code that ensures a property name is unknown
+
+		this.unknown[k] = v
+	}
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// IsOrExtendsMultikey returns true if the other provided type is the Multikey
+// type or extends from the Multikey type.
+func IsOrExtendsMultikey(other vocab.Type) bool {
+	if other.GetTypeName() == "Multikey" {
+		return true
+	}
+	return MultikeyIsExtendedBy(other)
+}
+
+// MultikeyIsDisjointWith returns true if the other provided type is disjoint with
+// the Multikey type.
+func MultikeyIsDisjointWith(other vocab.Type) bool {
+	// Shortcut implementation: is not disjoint with anything.
+	return false
+}
+
+// MultikeyIsExtendedBy returns true if the other provided type extends from the
+// Multikey type. Note that it returns false if the types are the same; see
+// the "IsOrExtendsMultikey" variant instead.
+func MultikeyIsExtendedBy(other vocab.Type) bool {
+	// Shortcut implementation: is not extended by anything.
+	return false
+}
+
+// NewW3IDMultikeyV1Multikey creates a new Multikey type
+func NewW3IDMultikeyV1Multikey() *W3IDMultikeyV1Multikey {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("Multikey")
+	return &W3IDMultikeyV1Multikey{
+		JSONLDType: typeProp,
+		alias:      "",
+		unknown:    make(map[string]interface{}),
+	}
+}
+
+// W3IDMultikeyV1MultikeyExtends returns true if the Multikey type extends from
+// the other type.
+func W3IDMultikeyV1MultikeyExtends(other vocab.Type) bool {
+	// Shortcut implementation: this does not extend anything.
+	return false
+}
+
+// Clone returns a deep copy of this Multikey, which can be modified without
+// changing this one. Its properties, values, IRIs, and unknown properties are
+// copied.
+func (this W3IDMultikeyV1Multikey) Clone() vocab.W3IDMultikeyV1Multikey {
+	c := &W3IDMultikeyV1Multikey{
+		alias:   this.alias,
+		unknown: jsonld.CopyObject(this.unknown),
+	}
+	if this.W3IDMultikeyV1Controller != nil {
+		c.W3IDMultikeyV1Controller = this.W3IDMultikeyV1Controller.Clone()
+	}
+	if this.JSONLDId != nil {
+		c.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.W3IDMultikeyV1PublicKeyMultibase != nil {
+		c.W3IDMultikeyV1PublicKeyMultibase = this.W3IDMultikeyV1PublicKeyMultibase.Clone()
+	}
+	if this.JSONLDType != nil {
+		c.JSONLDType = this.JSONLDType.Clone()
+	}
+	return c
+}
+
+// DeleteUnknownProperty removes the unknown property with the name.
+func (this *W3IDMultikeyV1Multikey) DeleteUnknownProperty(name string) {
+	delete(this.unknown, name)
+}
+
+// Equals determines whether this Multikey has the same properties, values, and
+// unknown properties as the other. Values of non-functional properties are
+// compared in order, and the aliases used for vocabularies are ignored.
+func (this W3IDMultikeyV1Multikey) Equals(o vocab.W3IDMultikeyV1Multikey) bool {
+	if o == nil {
+		return false
+	}
+	// Compare property "controller"
+	if lhs, rhs := this.W3IDMultikeyV1Controller, o.GetW3IDMultikeyV1Controller(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "publicKeyMultibase"
+	if lhs, rhs := this.W3IDMultikeyV1PublicKeyMultibase, o.GetW3IDMultikeyV1PublicKeyMultibase(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+
+	// Compare unknown properties, except the @context of the document.
+	return jsonld.EqualObjects(this.unknown, o.GetUnknownProperties(), "@context")
+}
+
+// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+func (this W3IDMultikeyV1Multikey) GetJSONLDId() vocab.JSONLDIdProperty {
+	return this.JSONLDId
+}
+
+// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
+func (this W3IDMultikeyV1Multikey) GetJSONLDType() vocab.JSONLDTypeProperty {
+	return this.JSONLDType
+}
+
+// GetTypeName returns the name of this type.
+func (this W3IDMultikeyV1Multikey) GetTypeName() string {
+	return "Multikey"
+}
+
+// GetUnknownProperties returns the unknown properties for the Multikey type. Note
+// that this should not be used by app developers. It is only used to help
+// determine which implementation is LessThan the other. Developers who are
+// creating a different implementation of this type's interface can use this
+// method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this W3IDMultikeyV1Multikey) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GetUnknownProperty returns the value of the unknown property with the name, and
+// whether it is set. The value is as it was deserialized from JSON, such as a
+// string, float64, bool, map[string]interface{} or []interface{}.
+func (this W3IDMultikeyV1Multikey) GetUnknownProperty(name string) (interface{}, bool) {
+	v, ok := this.unknown[name]
+	return v, ok
+}
+
+// GetW3IDMultikeyV1Controller returns the "controller" property if it exists, and
+// nil otherwise.
+func (this W3IDMultikeyV1Multikey) GetW3IDMultikeyV1Controller() vocab.W3IDMultikeyV1ControllerProperty {
+	return this.W3IDMultikeyV1Controller
+}
+
+// GetW3IDMultikeyV1PublicKeyMultibase returns the "publicKeyMultibase" property
+// if it exists, and nil otherwise.
+func (this W3IDMultikeyV1Multikey) GetW3IDMultikeyV1PublicKeyMultibase() vocab.W3IDMultikeyV1PublicKeyMultibaseProperty {
+	return this.W3IDMultikeyV1PublicKeyMultibase
+}
+
+// IsExtending returns true if the Multikey type extends from the other type.
+func (this W3IDMultikeyV1Multikey) IsExtending(other vocab.Type) bool {
+	return W3IDMultikeyV1MultikeyExtends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this W3IDMultikeyV1Multikey) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/multikey/v1": this.alias}
+	m = this.helperJSONLDContext(this.W3IDMultikeyV1Controller, m)
+	m = this.helperJSONLDContext(this.JSONLDId, m)
+	m = this.helperJSONLDContext(this.W3IDMultikeyV1PublicKeyMultibase, m)
+	m = this.helperJSONLDContext(this.JSONLDType, m)
+
+	return m
+}
+
+// LessThan computes if this Multikey is lesser, with an arbitrary but stable
+// determination.
+func (this W3IDMultikeyV1Multikey) LessThan(o vocab.W3IDMultikeyV1Multikey) bool {
+	// Begin: Compare known properties
+	// Compare property "controller"
+	if lhs, rhs := this.W3IDMultikeyV1Controller, o.GetW3IDMultikeyV1Controller(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "publicKeyMultibase"
+	if lhs, rhs := this.W3IDMultikeyV1PublicKeyMultibase, o.GetW3IDMultikeyV1PublicKeyMultibase(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// RangeProperties calls the function with the name and values of each property of
+// this Multikey that has a value, in the order of their names, until it
+// returns false. Each value is a type, an IRI, or the value of a literal, as
+// returned by the Value method of the property. Unknown properties are not
+// included.
+func (this W3IDMultikeyV1Multikey) RangeProperties(fn func(name string, values []interface{}) bool) {
+	if this.W3IDMultikeyV1Controller != nil {
+		if v := this.W3IDMultikeyV1Controller.Value(); v != nil && !fn("controller", []interface{}{v}) {
+			return
+		}
+	}
+	if this.JSONLDId != nil {
+		if v := this.JSONLDId.Value(); v != nil && !fn("id", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDMultikeyV1PublicKeyMultibase != nil {
+		if v := this.W3IDMultikeyV1PublicKeyMultibase.Value(); v != nil && !fn("publicKeyMultibase", []interface{}{v}) {
+			return
+		}
+	}
+	if this.JSONLDType != nil && this.JSONLDType.Len() > 0 && !fn("type", this.JSONLDType.Values()) {
+		return
+	}
+
+}
+
+// RangeUnknownProperties calls the function with each unknown property in the
+// order of their names, until it returns false. The @context of the document
+// the Multikey was deserialized from is skipped.
+func (this W3IDMultikeyV1Multikey) RangeUnknownProperties(fn func(name string, v interface{}) bool) {
+	names := make([]string, 0, len(this.unknown))
+	for k := range this.unknown {
+		if k != "@context" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !fn(k, this.unknown[k]) {
+			return
+		}
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this W3IDMultikeyV1Multikey) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "Multikey"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "Multikey"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "controller"
+	if this.W3IDMultikeyV1Controller != nil {
+		if i, err := this.W3IDMultikeyV1Controller.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDMultikeyV1Controller.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "id"
+	if this.JSONLDId != nil {
+		if i, err := this.JSONLDId.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDId.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "publicKeyMultibase"
+	if this.W3IDMultikeyV1PublicKeyMultibase != nil {
+		if i, err := this.W3IDMultikeyV1PublicKeyMultibase.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDMultikeyV1PublicKeyMultibase.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "type"
+	if this.JSONLDType != nil {
+		if i, err := this.JSONLDType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDType.Name()] = i
+		}
+
+	}
+	// End: Serialize known properties
+
+	// Begin: Serialize unknown properties
+	for k, v := range this.unknown {
+		// To be safe, ensure we aren't overwriting a known property
+		if _, has := m[k]; !has {
+			m[k] = v
+		}
+	}
+	// End: Serialize unknown properties
+
+	return m, nil
+}
+
+// SetJSONLDId sets the "id" property.
+func (this *W3IDMultikeyV1Multikey) SetJSONLDId(i vocab.JSONLDIdProperty) {
+	this.JSONLDId = i
+}
+
+// SetJSONLDType sets the "type" property.
+func (this *W3IDMultikeyV1Multikey) SetJSONLDType(i vocab.JSONLDTypeProperty) {
+	this.JSONLDType = i
+}
+
+// SetUnknownProperty sets the unknown property with the name, which is serialized
+// with the value as is. The value must be marshallable into JSON. It is not
+// serialized if the name is the name of a property known to the Multikey type.
+func (this *W3IDMultikeyV1Multikey) SetUnknownProperty(name string, v interface{}) {
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
+	this.unknown[name] = v
+}
+
+// SetW3IDMultikeyV1Controller sets the "controller" property.
+func (this *W3IDMultikeyV1Multikey) SetW3IDMultikeyV1Controller(i vocab.W3IDMultikeyV1ControllerProperty) {
+	this.W3IDMultikeyV1Controller = i
+}
+
+// SetW3IDMultikeyV1PublicKeyMultibase sets the "publicKeyMultibase" property.
+func (this *W3IDMultikeyV1Multikey) SetW3IDMultikeyV1PublicKeyMultibase(i vocab.W3IDMultikeyV1PublicKeyMultibaseProperty) {
+	this.W3IDMultikeyV1PublicKeyMultibase = i
+}
+
+// Validate returns an error if this Multikey violates its vocabulary: if a value
+// is out of the range of its property, if an IRI is not absolute, or if a
+// property required by the specification is missing. The error names the
+// property of the first violation found.
+func (this W3IDMultikeyV1Multikey) Validate() error {
+	if this.W3IDMultikeyV1Controller != nil {
+		if err := this.W3IDMultikeyV1Controller.Validate(); err != nil {
+			return fmt.Errorf("controller: %v", err)
+		}
+	}
+	if this.JSONLDId != nil {
+		if err := this.JSONLDId.Validate(); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+	if this.W3IDMultikeyV1PublicKeyMultibase != nil {
+		if err := this.W3IDMultikeyV1PublicKeyMultibase.Validate(); err != nil {
+			return fmt.Errorf("publicKeyMultibase: %v", err)
+		}
+	}
+	if this.JSONLDType != nil {
+		if err := this.JSONLDType.Validate(); err != nil {
+			return fmt.Errorf("type: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this W3IDMultikeyV1Multikey) VocabularyURI() string {
+	return "https://w3id.org/security/multikey/v1"
+}
+
+// helperJSONLDContext obtains the context uris and their aliases from a property,
+// if it is not nil.
+func (this W3IDMultikeyV1Multikey) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+	if i == nil {
+		return toMerge
+	}
+	for k, v := range i.JSONLDContext() {
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		toMerge[k] = v
+	}
+	return toMerge
+}
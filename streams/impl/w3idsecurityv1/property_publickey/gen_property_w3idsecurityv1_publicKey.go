@@ -4,6 +4,7 @@ package propertypublickey
 
 import (
 	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
 )
@@ -60,6 +61,21 @@ func deserializeW3IDSecurityV1PublicKeyPropertyIterator(i interface{}, aliasMap
 	return this, nil
 }
 
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) Equals(o vocab.W3IDSecurityV1PublicKeyPropertyIterator) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsW3IDSecurityV1PublicKey() {
+		return this.Get().Equals(o.Get())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, ok := o.(*W3IDSecurityV1PublicKeyPropertyIterator)
+	return ok && jsonld.EqualValues(this.unknown, rhs.unknown)
+}
+
 // Get returns the value of this property. When IsW3IDSecurityV1PublicKey returns
 // false, Get will return any arbitrary value.
 func (this W3IDSecurityV1PublicKeyPropertyIterator) Get() vocab.W3IDSecurityV1PublicKey {
@@ -213,6 +229,41 @@ func (this *W3IDSecurityV1PublicKeyPropertyIterator) SetType(t vocab.Type) error
 	return fmt.Errorf("illegal type to set on W3IDSecurityV1PublicKey property: %T", t)
 }
 
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) Validate() error {
+	if this.IsW3IDSecurityV1PublicKey() {
+		return this.Get().Validate()
+	}
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if _, ok := this.unknown.(map[string]interface{}); this.unknown != nil && !ok {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) Value() interface{} {
+	if this.IsW3IDSecurityV1PublicKey() {
+		return this.Get()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
+
 // clear ensures no value of this property is set. Calling
 // IsW3IDSecurityV1PublicKey afterwards will return false.
 func (this *W3IDSecurityV1PublicKeyPropertyIterator) clear() {
@@ -221,6 +272,24 @@ func (this *W3IDSecurityV1PublicKeyPropertyIterator) clear() {
 	this.w3idsecurityv1PublicKeyMember = nil
 }
 
+// clone returns a deep copy of this iterator, belonging to the parent property.
+func (this W3IDSecurityV1PublicKeyPropertyIterator) clone(parent vocab.W3IDSecurityV1PublicKeyProperty) *W3IDSecurityV1PublicKeyPropertyIterator {
+	c := &W3IDSecurityV1PublicKeyPropertyIterator{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	if this.w3idsecurityv1PublicKeyMember != nil {
+		c.w3idsecurityv1PublicKeyMember = this.w3idsecurityv1PublicKeyMember.Clone()
+	}
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	c.myIdx = this.myIdx
+	c.parent = parent
+	return c
+}
+
 // serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format. Applications should not need this
 // function as most typical use cases serialize types instead of individual
@@ -344,6 +413,19 @@ func (this W3IDSecurityV1PublicKeyProperty) Begin() vocab.W3IDSecurityV1PublicKe
 	}
 }
 
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Each of its values is copied.
+func (this W3IDSecurityV1PublicKeyProperty) Clone() vocab.W3IDSecurityV1PublicKeyProperty {
+	c := &W3IDSecurityV1PublicKeyProperty{alias: this.alias}
+	if this.properties != nil {
+		c.properties = make([]*W3IDSecurityV1PublicKeyPropertyIterator, len(this.properties))
+		for i, it := range this.properties {
+			c.properties[i] = it.clone(c)
+		}
+	}
+	return c
+}
+
 // Empty returns returns true if there are no elements.
 func (this W3IDSecurityV1PublicKeyProperty) Empty() bool {
 	return this.Len() == 0
@@ -356,6 +438,20 @@ func (this W3IDSecurityV1PublicKeyProperty) End() vocab.W3IDSecurityV1PublicKeyP
 	return nil
 }
 
+// Equals determines whether this property has the same values as the other, in
+// the same order.
+func (this W3IDSecurityV1PublicKeyProperty) Equals(o vocab.W3IDSecurityV1PublicKeyProperty) bool {
+	if o == nil || this.Len() != o.Len() {
+		return false
+	}
+	for i, it := range this.properties {
+		if !it.Equals(o.At(i)) {
+			return false
+		}
+	}
+	return true
+}
+
 // Insert inserts an IRI value at the specified index for a property "publicKey".
 // Existing elements at that index and higher are shifted back once.
 // Invalidates all iterators.
@@ -560,7 +656,9 @@ func (this *W3IDSecurityV1PublicKeyProperty) Remove(idx int) {
 // properties. It is exposed for alternatives to go-fed implementations to use.
 func (this W3IDSecurityV1PublicKeyProperty) Serialize() (interface{}, error) {
 	s := make([]interface{}, 0, len(this.properties))
+
 	for _, iterator := range this.properties {
+
 		if b, err := iterator.serialize(); err != nil {
 			return s, err
 		} else {
@@ -619,3 +717,26 @@ func (this *W3IDSecurityV1PublicKeyProperty) SetType(idx int, t vocab.Type) erro
 func (this W3IDSecurityV1PublicKeyProperty) Swap(i, j int) {
 	this.properties[i], this.properties[j] = this.properties[j], this.properties[i]
 }
+
+// Validate returns an error naming the first value of this property that is out
+// of its range, or is an IRI that is not absolute.
+func (this W3IDSecurityV1PublicKeyProperty) Validate() error {
+	for i, it := range this.properties {
+		if err := it.Validate(); err != nil {
+			return fmt.Errorf("value %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Values returns the values of this property in order, as returned by the Value
+// method of each of them.
+func (this W3IDSecurityV1PublicKeyProperty) Values() []interface{} {
+	v := make([]interface{}, 0, len(this.properties))
+	for _, it := range this.properties {
+		if value := it.Value(); value != nil {
+			v = append(v, value)
+		}
+	}
+	return v
+}
@@ -0,0 +1,22 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertysignature
+
+import vocab "github.com/go-fed/activity/streams/vocab"
+
+var mgr privateManager
+
+// privateManager abstracts the code-generated manager that provides access to
+// concrete implementations.
+type privateManager interface {
+	// DeserializeRsaSignature2017W3IDSecurityV1 returns the deserialization
+	// method for the "W3IDSecurityV1RsaSignature2017" non-functional
+	// property in the vocabulary "W3IDSecurityV1"
+	DeserializeRsaSignature2017W3IDSecurityV1() func(map[string]interface{}, map[string]string) (vocab.W3IDSecurityV1RsaSignature2017, error)
+}
+
+// SetManager sets the manager package-global variable. For internal use only, do
+// not use as part of Application behavior. Must be called at golang init time.
+func SetManager(m privateManager) {
+	mgr = m
+}
@@ -0,0 +1,293 @@
+// Code generated by astool. DO NOT EDIT.
+
+package propertysignature
+
+import (
+	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"net/url"
+)
+
+// W3IDSecurityV1SignatureProperty is the functional property "signature". It is
+// permitted to be a single nilable value type.
+type W3IDSecurityV1SignatureProperty struct {
+	w3idsecurityv1RsaSignature2017Member vocab.W3IDSecurityV1RsaSignature2017
+	unknown                              interface{}
+	iri                                  *url.URL
+	alias                                string
+}
+
+// DeserializeSignatureProperty creates a "signature" property from an interface
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeSignatureProperty(m map[string]interface{}, aliasMap map[string]string) (*W3IDSecurityV1SignatureProperty, error) {
+	alias := ""
+	if a, ok := aliasMap["https://w3id.org/security/v1"]; ok {
+		alias = a
+	}
+	propName := "signature"
+	if len(alias) > 0 {
+		// Use alias both to find the property, and set within the property.
+		propName = fmt.Sprintf("%s:%s", alias, "signature")
+	}
+	i, ok := m[propName]
+
+	if ok {
+		if s, ok := i.(string); ok {
+			u, err := url.Parse(s)
+			// If error exists, don't error out -- skip this and treat as unknown string ([]byte) at worst
+			// Also, if no scheme exists, don't treat it as a URL -- net/url is greedy
+			if err == nil && len(u.Scheme) > 0 {
+				this := &W3IDSecurityV1SignatureProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
+		if m, ok := i.(map[string]interface{}); ok {
+			if v, err := mgr.DeserializeRsaSignature2017W3IDSecurityV1()(m, aliasMap); err == nil {
+				this := &W3IDSecurityV1SignatureProperty{
+					alias:                                alias,
+					w3idsecurityv1RsaSignature2017Member: v,
+				}
+				return this, nil
+			}
+		}
+		this := &W3IDSecurityV1SignatureProperty{
+			alias:   alias,
+			unknown: i,
+		}
+		return this, nil
+	}
+	return nil, nil
+}
+
+// NewW3IDSecurityV1SignatureProperty creates a new signature property.
+func NewW3IDSecurityV1SignatureProperty() *W3IDSecurityV1SignatureProperty {
+	return &W3IDSecurityV1SignatureProperty{alias: ""}
+}
+
+// Clear ensures no value of this property is set. Calling
+// IsW3IDSecurityV1RsaSignature2017 afterwards will return false.
+func (this *W3IDSecurityV1SignatureProperty) Clear() {
+	this.unknown = nil
+	this.iri = nil
+	this.w3idsecurityv1RsaSignature2017Member = nil
+}
+
+// Clone returns a deep copy of this property, which can be modified without
+// changing this one. Its values, IRI, and types are copied.
+func (this W3IDSecurityV1SignatureProperty) Clone() vocab.W3IDSecurityV1SignatureProperty {
+	c := &W3IDSecurityV1SignatureProperty{
+		alias:   this.alias,
+		unknown: jsonld.CopyValue(this.unknown),
+	}
+	if this.w3idsecurityv1RsaSignature2017Member != nil {
+		c.w3idsecurityv1RsaSignature2017Member = this.w3idsecurityv1RsaSignature2017Member.Clone()
+	}
+	if this.iri != nil {
+		u := *this.iri
+		c.iri = &u
+	}
+	return c
+}
+
+// Equals determines whether this property has the same value as the other. Types
+// are compared with their Equals method, and IRIs by their string form.
+func (this W3IDSecurityV1SignatureProperty) Equals(o vocab.W3IDSecurityV1SignatureProperty) bool {
+	if o == nil || this.KindIndex() != o.KindIndex() {
+		return false
+	} else if this.IsW3IDSecurityV1RsaSignature2017() {
+		return this.Get().Equals(o.Get())
+	} else if this.IsIRI() {
+		return this.iri.String() == o.GetIRI().String()
+	}
+	// Neither has a value, so compare the unknown values.
+	rhs, err := o.Serialize()
+	return err == nil && jsonld.EqualValues(this.unknown, rhs)
+}
+
+// Get returns the value of this property. When IsW3IDSecurityV1RsaSignature2017
+// returns false, Get will return any arbitrary value.
+func (this W3IDSecurityV1SignatureProperty) Get() vocab.W3IDSecurityV1RsaSignature2017 {
+	return this.w3idsecurityv1RsaSignature2017Member
+}
+
+// GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
+// return any arbitrary value.
+func (this W3IDSecurityV1SignatureProperty) GetIRI() *url.URL {
+	return this.iri
+}
+
+// GetType returns the value in this property as a Type. Returns nil if the value
+// is not an ActivityStreams type, such as an IRI or another value.
+func (this W3IDSecurityV1SignatureProperty) GetType() vocab.Type {
+	if this.IsW3IDSecurityV1RsaSignature2017() {
+		return this.Get()
+	}
+
+	return nil
+}
+
+// HasAny returns true if the value or IRI is set.
+func (this W3IDSecurityV1SignatureProperty) HasAny() bool {
+	return this.IsW3IDSecurityV1RsaSignature2017() || this.iri != nil
+}
+
+// IsIRI returns true if this property is an IRI.
+func (this W3IDSecurityV1SignatureProperty) IsIRI() bool {
+	return this.iri != nil
+}
+
+// IsW3IDSecurityV1RsaSignature2017 returns true if this property is set and not
+// an IRI.
+func (this W3IDSecurityV1SignatureProperty) IsW3IDSecurityV1RsaSignature2017() bool {
+	return this.w3idsecurityv1RsaSignature2017Member != nil
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// property and the specific values that are set. The value in the map is the
+// alias used to import the property's value or values.
+func (this W3IDSecurityV1SignatureProperty) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/v1": this.alias}
+	var child map[string]string
+	if this.IsW3IDSecurityV1RsaSignature2017() {
+		child = this.Get().JSONLDContext()
+	}
+	/*
+	   Since the literal maps in this function are determined at
+	   code-generation time, this loop should not overwrite an existing key with a
+	   new value.
+	*/
+	for k, v := range child {
+		m[k] = v
+	}
+	return m
+}
+
+// KindIndex computes an arbitrary value for indexing this kind of value. This is
+// a leaky API detail only for folks looking to replace the go-fed
+// implementation. Applications should not use this method.
+func (this W3IDSecurityV1SignatureProperty) KindIndex() int {
+	if this.IsW3IDSecurityV1RsaSignature2017() {
+		return 0
+	}
+	if this.IsIRI() {
+		return -2
+	}
+	return -1
+}
+
+// LessThan compares two instances of this property with an arbitrary but stable
+// comparison. Applications should not use this because it is only meant to
+// help alternative implementations to go-fed to be able to normalize
+// nonfunctional properties.
+func (this W3IDSecurityV1SignatureProperty) LessThan(o vocab.W3IDSecurityV1SignatureProperty) bool {
+	// LessThan comparison for if either or both are IRIs.
+	if this.IsIRI() && o.IsIRI() {
+		return this.iri.String() < o.GetIRI().String()
+	} else if this.IsIRI() {
+		// IRIs are always less than other values, none, or unknowns
+		return true
+	} else if o.IsIRI() {
+		// This other, none, or unknown value is always greater than IRIs
+		return false
+	}
+	// LessThan comparison for the single value or unknown value.
+	if !this.IsW3IDSecurityV1RsaSignature2017() && !o.IsW3IDSecurityV1RsaSignature2017() {
+		// Both are unknowns.
+		return false
+	} else if this.IsW3IDSecurityV1RsaSignature2017() && !o.IsW3IDSecurityV1RsaSignature2017() {
+		// Values are always greater than unknown values.
+		return false
+	} else if !this.IsW3IDSecurityV1RsaSignature2017() && o.IsW3IDSecurityV1RsaSignature2017() {
+		// Unknowns are always less than known values.
+		return true
+	} else {
+		// Actual comparison.
+		return this.Get().LessThan(o.Get())
+	}
+}
+
+// Name returns the name of this property: "signature".
+func (this W3IDSecurityV1SignatureProperty) Name() string {
+	if len(this.alias) > 0 {
+		return this.alias + ":" + "signature"
+	} else {
+		return "signature"
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format. Applications should not need this
+// function as most typical use cases serialize types instead of individual
+// properties. It is exposed for alternatives to go-fed implementations to use.
+func (this W3IDSecurityV1SignatureProperty) Serialize() (interface{}, error) {
+	if this.IsW3IDSecurityV1RsaSignature2017() {
+		return this.Get().Serialize()
+	} else if this.IsIRI() {
+		return this.iri.String(), nil
+	}
+	return this.unknown, nil
+}
+
+// Set sets the value of this property. Calling IsW3IDSecurityV1RsaSignature2017
+// afterwards will return true.
+func (this *W3IDSecurityV1SignatureProperty) Set(v vocab.W3IDSecurityV1RsaSignature2017) {
+	this.Clear()
+	this.w3idsecurityv1RsaSignature2017Member = v
+}
+
+// SetIRI sets the value of this property. Calling IsIRI afterwards will return
+// true.
+func (this *W3IDSecurityV1SignatureProperty) SetIRI(v *url.URL) {
+	this.Clear()
+	this.iri = v
+}
+
+// SetType attempts to set the property for the arbitrary type. Returns an error
+// if it is not a valid type to set on this property.
+func (this *W3IDSecurityV1SignatureProperty) SetType(t vocab.Type) error {
+	if v, ok := t.(vocab.W3IDSecurityV1RsaSignature2017); ok {
+		this.Set(v)
+		return nil
+	}
+
+	return fmt.Errorf("illegal type to set on signature property: %T", t)
+}
+
+// Validate returns an error if the value of this property is out of its range, or
+// is an IRI that is not absolute. Types are validated with their Validate
+// method.
+func (this W3IDSecurityV1SignatureProperty) Validate() error {
+	if this.IsW3IDSecurityV1RsaSignature2017() {
+		return this.Get().Validate()
+	}
+	if this.IsIRI() {
+		if !this.iri.IsAbs() {
+			return fmt.Errorf("%q is not an absolute IRI", this.iri)
+		}
+		return nil
+	}
+	if _, ok := this.unknown.(map[string]interface{}); this.unknown != nil && !ok {
+		return fmt.Errorf("%v is not in the range of the property", this.unknown)
+	}
+
+	return nil
+}
+
+// Value returns the value of this property: a type, a *url.URL for an IRI, or the
+// value of a literal as returned by its getter. A value out of the range of
+// the property is returned as it was deserialized from JSON. It returns nil
+// if there is no value.
+func (this W3IDSecurityV1SignatureProperty) Value() interface{} {
+	if this.IsW3IDSecurityV1RsaSignature2017() {
+		return this.Get()
+	}
+	if this.IsIRI() {
+		return this.iri
+	}
+
+	return this.unknown
+}
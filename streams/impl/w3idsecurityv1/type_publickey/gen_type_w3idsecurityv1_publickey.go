@@ -2,7 +2,12 @@
 
 package typepublickey
 
-import vocab "github.com/go-fed/activity/streams/vocab"
+import (
+	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
+)
 
 // A public key represents a public cryptographical key for a user
 type W3IDSecurityV1PublicKey struct {
@@ -100,6 +105,67 @@ func W3IDSecurityV1PublicKeyExtends(other vocab.Type) bool {
 	return false
 }
 
+// Clone returns a deep copy of this PublicKey, which can be modified without
+// changing this one. Its properties, values, IRIs, and unknown properties are
+// copied.
+func (this W3IDSecurityV1PublicKey) Clone() vocab.W3IDSecurityV1PublicKey {
+	c := &W3IDSecurityV1PublicKey{
+		alias:   this.alias,
+		unknown: jsonld.CopyObject(this.unknown),
+	}
+	if this.JSONLDId != nil {
+		c.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.W3IDSecurityV1Owner != nil {
+		c.W3IDSecurityV1Owner = this.W3IDSecurityV1Owner.Clone()
+	}
+	if this.W3IDSecurityV1PublicKeyPem != nil {
+		c.W3IDSecurityV1PublicKeyPem = this.W3IDSecurityV1PublicKeyPem.Clone()
+	}
+	return c
+}
+
+// DeleteUnknownProperty removes the unknown property with the name.
+func (this *W3IDSecurityV1PublicKey) DeleteUnknownProperty(name string) {
+	delete(this.unknown, name)
+}
+
+// Equals determines whether this PublicKey has the same properties, values, and
+// unknown properties as the other. Values of non-functional properties are
+// compared in order, and the aliases used for vocabularies are ignored.
+func (this W3IDSecurityV1PublicKey) Equals(o vocab.W3IDSecurityV1PublicKey) bool {
+	if o == nil {
+		return false
+	}
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "owner"
+	if lhs, rhs := this.W3IDSecurityV1Owner, o.GetW3IDSecurityV1Owner(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "publicKeyPem"
+	if lhs, rhs := this.W3IDSecurityV1PublicKeyPem, o.GetW3IDSecurityV1PublicKeyPem(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+
+	// Compare unknown properties, except the @context of the document.
+	return jsonld.EqualObjects(this.unknown, o.GetUnknownProperties(), "@context")
+}
+
 // GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 func (this W3IDSecurityV1PublicKey) GetJSONLDId() vocab.JSONLDIdProperty {
 	return this.JSONLDId
@@ -120,6 +186,14 @@ func (this W3IDSecurityV1PublicKey) GetUnknownProperties() map[string]interface{
 	return this.unknown
 }
 
+// GetUnknownProperty returns the value of the unknown property with the name, and
+// whether it is set. The value is as it was deserialized from JSON, such as a
+// string, float64, bool, map[string]interface{} or []interface{}.
+func (this W3IDSecurityV1PublicKey) GetUnknownProperty(name string) (interface{}, bool) {
+	v, ok := this.unknown[name]
+	return v, ok
+}
+
 // GetW3IDSecurityV1Owner returns the "owner" property if it exists, and nil
 // otherwise.
 func (this W3IDSecurityV1PublicKey) GetW3IDSecurityV1Owner() vocab.W3IDSecurityV1OwnerProperty {
@@ -208,6 +282,48 @@ func (this W3IDSecurityV1PublicKey) LessThan(o vocab.W3IDSecurityV1PublicKey) bo
 	return false
 }
 
+// RangeProperties calls the function with the name and values of each property of
+// this PublicKey that has a value, in the order of their names, until it
+// returns false. Each value is a type, an IRI, or the value of a literal, as
+// returned by the Value method of the property. Unknown properties are not
+// included.
+func (this W3IDSecurityV1PublicKey) RangeProperties(fn func(name string, values []interface{}) bool) {
+	if this.JSONLDId != nil {
+		if v := this.JSONLDId.Value(); v != nil && !fn("id", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDSecurityV1Owner != nil {
+		if v := this.W3IDSecurityV1Owner.Value(); v != nil && !fn("owner", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDSecurityV1PublicKeyPem != nil {
+		if v := this.W3IDSecurityV1PublicKeyPem.Value(); v != nil && !fn("publicKeyPem", []interface{}{v}) {
+			return
+		}
+	}
+
+}
+
+// RangeUnknownProperties calls the function with each unknown property in the
+// order of their names, until it returns false. The @context of the document
+// the PublicKey was deserialized from is skipped.
+func (this W3IDSecurityV1PublicKey) RangeUnknownProperties(fn func(name string, v interface{}) bool) {
+	names := make([]string, 0, len(this.unknown))
+	for k := range this.unknown {
+		if k != "@context" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !fn(k, this.unknown[k]) {
+			return
+		}
+	}
+}
+
 // Serialize converts this into an interface representation suitable for
 // marshalling into a text or binary format.
 func (this W3IDSecurityV1PublicKey) Serialize() (map[string]interface{}, error) {
@@ -220,6 +336,7 @@ func (this W3IDSecurityV1PublicKey) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.JSONLDId.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "owner"
 	if this.W3IDSecurityV1Owner != nil {
@@ -228,6 +345,7 @@ func (this W3IDSecurityV1PublicKey) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.W3IDSecurityV1Owner.Name()] = i
 		}
+
 	}
 	// Maybe serialize property "publicKeyPem"
 	if this.W3IDSecurityV1PublicKeyPem != nil {
@@ -236,6 +354,7 @@ func (this W3IDSecurityV1PublicKey) Serialize() (map[string]interface{}, error)
 		} else if i != nil {
 			m[this.W3IDSecurityV1PublicKeyPem.Name()] = i
 		}
+
 	}
 	// End: Serialize known properties
 
@@ -256,6 +375,17 @@ func (this *W3IDSecurityV1PublicKey) SetJSONLDId(i vocab.JSONLDIdProperty) {
 	this.JSONLDId = i
 }
 
+// SetUnknownProperty sets the unknown property with the name, which is serialized
+// with the value as is. The value must be marshallable into JSON. It is not
+// serialized if the name is the name of a property known to the PublicKey
+// type.
+func (this *W3IDSecurityV1PublicKey) SetUnknownProperty(name string, v interface{}) {
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
+	this.unknown[name] = v
+}
+
 // SetW3IDSecurityV1Owner sets the "owner" property.
 func (this *W3IDSecurityV1PublicKey) SetW3IDSecurityV1Owner(i vocab.W3IDSecurityV1OwnerProperty) {
 	this.W3IDSecurityV1Owner = i
@@ -266,6 +396,30 @@ func (this *W3IDSecurityV1PublicKey) SetW3IDSecurityV1PublicKeyPem(i vocab.W3IDS
 	this.W3IDSecurityV1PublicKeyPem = i
 }
 
+// Validate returns an error if this PublicKey violates its vocabulary: if a value
+// is out of the range of its property, if an IRI is not absolute, or if a
+// property required by the specification is missing. The error names the
+// property of the first violation found.
+func (this W3IDSecurityV1PublicKey) Validate() error {
+	if this.JSONLDId != nil {
+		if err := this.JSONLDId.Validate(); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1Owner != nil {
+		if err := this.W3IDSecurityV1Owner.Validate(); err != nil {
+			return fmt.Errorf("owner: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1PublicKeyPem != nil {
+		if err := this.W3IDSecurityV1PublicKeyPem.Validate(); err != nil {
+			return fmt.Errorf("publicKeyPem: %v", err)
+		}
+	}
+
+	return nil
+}
+
 // VocabularyURI returns the vocabulary's URI as a string.
 func (this W3IDSecurityV1PublicKey) VocabularyURI() string {
 	return "https://w3id.org/security/v1"
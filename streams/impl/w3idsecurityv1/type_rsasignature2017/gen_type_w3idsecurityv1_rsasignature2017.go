@@ -0,0 +1,604 @@
+// Code generated by astool. DO NOT EDIT.
+
+package typersasignature2017
+
+import (
+	"fmt"
+	jsonld "github.com/go-fed/activity/streams/jsonld"
+	vocab "github.com/go-fed/activity/streams/vocab"
+	"sort"
+	"strings"
+)
+
+// A Linked Data Signature of a document, using the URDNA2015 canonicalization
+// algorithm, the SHA-256 digest algorithm, and an RSA key
+type W3IDSecurityV1RsaSignature2017 struct {
+	W3IDSecurityV1Created        vocab.W3IDSecurityV1CreatedProperty
+	W3IDSecurityV1Creator        vocab.W3IDSecurityV1CreatorProperty
+	JSONLDId                     vocab.JSONLDIdProperty
+	W3IDSecurityV1SignatureValue vocab.W3IDSecurityV1SignatureValueProperty
+	JSONLDType                   vocab.JSONLDTypeProperty
+	alias                        string
+	unknown                      map[string]interface{}
+}
+
+// DeserializeRsaSignature2017 creates a RsaSignature2017 from a map
+// representation that has been unmarshalled from a text or binary format.
+func DeserializeRsaSignature2017(m map[string]interface{}, aliasMap map[string]string) (*W3IDSecurityV1RsaSignature2017, error) {
+	alias := ""
+	aliasPrefix := ""
+	if a, ok := aliasMap["https://w3id.org/security/v1"]; ok {
+		alias = a
+		aliasPrefix = a + ":"
+	}
+	this := &W3IDSecurityV1RsaSignature2017{
+		alias:   alias,
+		unknown: make(map[string]interface{}),
+	}
+	if typeValue, ok := m["type"]; !ok {
+		return nil, fmt.Errorf("no \"type\" property in map")
+	} else if typeString, ok := typeValue.(string); ok {
+		typeName := strings.TrimPrefix(typeString, aliasPrefix)
+		if typeName != "RsaSignature2017" {
+			return nil, fmt.Errorf("\"type\" property is not of %q type: %s", "RsaSignature2017", typeName)
+		}
+		// Fall through, success in finding a proper Type
+	} else if arrType, ok := typeValue.([]interface{}); ok {
+		found := false
+		for _, elemVal := range arrType {
+			if typeString, ok := elemVal.(string); ok && strings.TrimPrefix(typeString, aliasPrefix) == "RsaSignature2017" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find a \"type\" property of value %q", "RsaSignature2017")
+		}
+		// Fall through, success in finding a proper Type
+	} else {
+		return nil, fmt.Errorf("\"type\" property is unrecognized type: %T", typeValue)
+	}
+	// Begin: Known property deserialization
+	if p, err := mgr.DeserializeCreatedPropertyW3IDSecurityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDSecurityV1Created = p
+	}
+	if p, err := mgr.DeserializeCreatorPropertyW3IDSecurityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDSecurityV1Creator = p
+	}
+	if p, err := mgr.DeserializeIdPropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDId = p
+	}
+	if p, err := mgr.DeserializeSignatureValuePropertyW3IDSecurityV1()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.W3IDSecurityV1SignatureValue = p
+	}
+	if p, err := mgr.DeserializeTypePropertyJSONLD()(m, aliasMap); err != nil {
+		return nil, err
+	} else if p != nil {
+		this.JSONLDType = p
+	}
+	// End: Known property deserialization
+
+	// Begin: Unknown deserialization
+	for k, v := range m {
+		// Begin: Code that ensures a property name is unknown
+		if k == "created" {
+			continue
+		} else if k == "creator" {
+			continue
+		} else if k == "id" {
+			continue
+		} else if k == "signatureValue" {
+			continue
+		} else if k == "type" {
+			continue
+		} // End: Code that ensures a property name is unknown
+
+		this.unknown[k] = v
+	}
+	// End: Unknown deserialization
+
+	return this, nil
+}
+
+// IsOrExtendsRsaSignature2017 returns true if the other provided type is the
+// RsaSignature2017 type or extends from the RsaSignature2017 type.
+func IsOrExtendsRsaSignature2017(other vocab.Type) bool {
+	if other.GetTypeName() == "RsaSignature2017" {
+		return true
+	}
+	return RsaSignature2017IsExtendedBy(other)
+}
+
+// NewW3IDSecurityV1RsaSignature2017 creates a new RsaSignature2017 type
+func NewW3IDSecurityV1RsaSignature2017() *W3IDSecurityV1RsaSignature2017 {
+	typeProp := typePropertyConstructor()
+	typeProp.AppendXMLSchemaString("RsaSignature2017")
+	return &W3IDSecurityV1RsaSignature2017{
+		JSONLDType: typeProp,
+		alias:      "",
+		unknown:    make(map[string]interface{}),
+	}
+}
+
+// RsaSignature2017IsDisjointWith returns true if the other provided type is
+// disjoint with the RsaSignature2017 type.
+func RsaSignature2017IsDisjointWith(other vocab.Type) bool {
+	// Shortcut implementation: is not disjoint with anything.
+	return false
+}
+
+// RsaSignature2017IsExtendedBy returns true if the other provided type extends
+// from the RsaSignature2017 type. Note that it returns false if the types are
+// the same; see the "IsOrExtendsRsaSignature2017" variant instead.
+func RsaSignature2017IsExtendedBy(other vocab.Type) bool {
+	// Shortcut implementation: is not extended by anything.
+	return false
+}
+
+// W3IDSecurityV1RsaSignature2017Extends returns true if the RsaSignature2017 type
+// extends from the other type.
+func W3IDSecurityV1RsaSignature2017Extends(other vocab.Type) bool {
+	// Shortcut implementation: this does not extend anything.
+	return false
+}
+
+// Clone returns a deep copy of this RsaSignature2017, which can be modified
+// without changing this one. Its properties, values, IRIs, and unknown
+// properties are copied.
+func (this W3IDSecurityV1RsaSignature2017) Clone() vocab.W3IDSecurityV1RsaSignature2017 {
+	c := &W3IDSecurityV1RsaSignature2017{
+		alias:   this.alias,
+		unknown: jsonld.CopyObject(this.unknown),
+	}
+	if this.W3IDSecurityV1Created != nil {
+		c.W3IDSecurityV1Created = this.W3IDSecurityV1Created.Clone()
+	}
+	if this.W3IDSecurityV1Creator != nil {
+		c.W3IDSecurityV1Creator = this.W3IDSecurityV1Creator.Clone()
+	}
+	if this.JSONLDId != nil {
+		c.JSONLDId = this.JSONLDId.Clone()
+	}
+	if this.W3IDSecurityV1SignatureValue != nil {
+		c.W3IDSecurityV1SignatureValue = this.W3IDSecurityV1SignatureValue.Clone()
+	}
+	if this.JSONLDType != nil {
+		c.JSONLDType = this.JSONLDType.Clone()
+	}
+	return c
+}
+
+// DeleteUnknownProperty removes the unknown property with the name.
+func (this *W3IDSecurityV1RsaSignature2017) DeleteUnknownProperty(name string) {
+	delete(this.unknown, name)
+}
+
+// Equals determines whether this RsaSignature2017 has the same properties,
+// values, and unknown properties as the other. Values of non-functional
+// properties are compared in order, and the aliases used for vocabularies are
+// ignored.
+func (this W3IDSecurityV1RsaSignature2017) Equals(o vocab.W3IDSecurityV1RsaSignature2017) bool {
+	if o == nil {
+		return false
+	}
+	// Compare property "created"
+	if lhs, rhs := this.W3IDSecurityV1Created, o.GetW3IDSecurityV1Created(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "creator"
+	if lhs, rhs := this.W3IDSecurityV1Creator, o.GetW3IDSecurityV1Creator(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "signatureValue"
+	if lhs, rhs := this.W3IDSecurityV1SignatureValue, o.GetW3IDSecurityV1SignatureValue(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs == nil || rhs == nil {
+		if lhs != nil || rhs != nil {
+			return false
+		}
+	} else if !lhs.Equals(rhs) {
+		return false
+	}
+
+	// Compare unknown properties, except the @context of the document.
+	return jsonld.EqualObjects(this.unknown, o.GetUnknownProperties(), "@context")
+}
+
+// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+func (this W3IDSecurityV1RsaSignature2017) GetJSONLDId() vocab.JSONLDIdProperty {
+	return this.JSONLDId
+}
+
+// GetJSONLDType returns the "type" property if it exists, and nil otherwise.
+func (this W3IDSecurityV1RsaSignature2017) GetJSONLDType() vocab.JSONLDTypeProperty {
+	return this.JSONLDType
+}
+
+// GetTypeName returns the name of this type.
+func (this W3IDSecurityV1RsaSignature2017) GetTypeName() string {
+	return "RsaSignature2017"
+}
+
+// GetUnknownProperties returns the unknown properties for the RsaSignature2017
+// type. Note that this should not be used by app developers. It is only used
+// to help determine which implementation is LessThan the other. Developers
+// who are creating a different implementation of this type's interface can
+// use this method in their LessThan implementation, but routine ActivityPub
+// applications should not use this to bypass the code generation tool.
+func (this W3IDSecurityV1RsaSignature2017) GetUnknownProperties() map[string]interface{} {
+	return this.unknown
+}
+
+// GetUnknownProperty returns the value of the unknown property with the name, and
+// whether it is set. The value is as it was deserialized from JSON, such as a
+// string, float64, bool, map[string]interface{} or []interface{}.
+func (this W3IDSecurityV1RsaSignature2017) GetUnknownProperty(name string) (interface{}, bool) {
+	v, ok := this.unknown[name]
+	return v, ok
+}
+
+// GetW3IDSecurityV1Created returns the "created" property if it exists, and nil
+// otherwise.
+func (this W3IDSecurityV1RsaSignature2017) GetW3IDSecurityV1Created() vocab.W3IDSecurityV1CreatedProperty {
+	return this.W3IDSecurityV1Created
+}
+
+// GetW3IDSecurityV1Creator returns the "creator" property if it exists, and nil
+// otherwise.
+func (this W3IDSecurityV1RsaSignature2017) GetW3IDSecurityV1Creator() vocab.W3IDSecurityV1CreatorProperty {
+	return this.W3IDSecurityV1Creator
+}
+
+// GetW3IDSecurityV1SignatureValue returns the "signatureValue" property if it
+// exists, and nil otherwise.
+func (this W3IDSecurityV1RsaSignature2017) GetW3IDSecurityV1SignatureValue() vocab.W3IDSecurityV1SignatureValueProperty {
+	return this.W3IDSecurityV1SignatureValue
+}
+
+// IsExtending returns true if the RsaSignature2017 type extends from the other
+// type.
+func (this W3IDSecurityV1RsaSignature2017) IsExtending(other vocab.Type) bool {
+	return W3IDSecurityV1RsaSignature2017Extends(other)
+}
+
+// JSONLDContext returns the JSONLD URIs required in the context string for this
+// type and the specific properties that are set. The value in the map is the
+// alias used to import the type and its properties.
+func (this W3IDSecurityV1RsaSignature2017) JSONLDContext() map[string]string {
+	m := map[string]string{"https://w3id.org/security/v1": this.alias}
+	m = this.helperJSONLDContext(this.W3IDSecurityV1Created, m)
+	m = this.helperJSONLDContext(this.W3IDSecurityV1Creator, m)
+	m = this.helperJSONLDContext(this.JSONLDId, m)
+	m = this.helperJSONLDContext(this.W3IDSecurityV1SignatureValue, m)
+	m = this.helperJSONLDContext(this.JSONLDType, m)
+
+	return m
+}
+
+// LessThan computes if this RsaSignature2017 is lesser, with an arbitrary but
+// stable determination.
+func (this W3IDSecurityV1RsaSignature2017) LessThan(o vocab.W3IDSecurityV1RsaSignature2017) bool {
+	// Begin: Compare known properties
+	// Compare property "created"
+	if lhs, rhs := this.W3IDSecurityV1Created, o.GetW3IDSecurityV1Created(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "creator"
+	if lhs, rhs := this.W3IDSecurityV1Creator, o.GetW3IDSecurityV1Creator(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "id"
+	if lhs, rhs := this.JSONLDId, o.GetJSONLDId(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "signatureValue"
+	if lhs, rhs := this.W3IDSecurityV1SignatureValue, o.GetW3IDSecurityV1SignatureValue(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// Compare property "type"
+	if lhs, rhs := this.JSONLDType, o.GetJSONLDType(); lhs != nil && rhs != nil {
+		if lhs.LessThan(rhs) {
+			return true
+		} else if rhs.LessThan(lhs) {
+			return false
+		}
+	} else if lhs == nil && rhs != nil {
+		// Nil is less than anything else
+		return true
+	} else if rhs != nil && rhs == nil {
+		// Anything else is greater than nil
+		return false
+	} // Else: Both are nil
+	// End: Compare known properties
+
+	// Begin: Compare unknown properties (only by number of them)
+	if len(this.unknown) < len(o.GetUnknownProperties()) {
+		return true
+	} else if len(o.GetUnknownProperties()) < len(this.unknown) {
+		return false
+	} // End: Compare unknown properties (only by number of them)
+
+	// All properties are the same.
+	return false
+}
+
+// RangeProperties calls the function with the name and values of each property of
+// this RsaSignature2017 that has a value, in the order of their names, until
+// it returns false. Each value is a type, an IRI, or the value of a literal,
+// as returned by the Value method of the property. Unknown properties are not
+// included.
+func (this W3IDSecurityV1RsaSignature2017) RangeProperties(fn func(name string, values []interface{}) bool) {
+	if this.W3IDSecurityV1Created != nil {
+		if v := this.W3IDSecurityV1Created.Value(); v != nil && !fn("created", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDSecurityV1Creator != nil {
+		if v := this.W3IDSecurityV1Creator.Value(); v != nil && !fn("creator", []interface{}{v}) {
+			return
+		}
+	}
+	if this.JSONLDId != nil {
+		if v := this.JSONLDId.Value(); v != nil && !fn("id", []interface{}{v}) {
+			return
+		}
+	}
+	if this.W3IDSecurityV1SignatureValue != nil {
+		if v := this.W3IDSecurityV1SignatureValue.Value(); v != nil && !fn("signatureValue", []interface{}{v}) {
+			return
+		}
+	}
+	if this.JSONLDType != nil && this.JSONLDType.Len() > 0 && !fn("type", this.JSONLDType.Values()) {
+		return
+	}
+
+}
+
+// RangeUnknownProperties calls the function with each unknown property in the
+// order of their names, until it returns false. The @context of the document
+// the RsaSignature2017 was deserialized from is skipped.
+func (this W3IDSecurityV1RsaSignature2017) RangeUnknownProperties(fn func(name string, v interface{}) bool) {
+	names := make([]string, 0, len(this.unknown))
+	for k := range this.unknown {
+		if k != "@context" {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if !fn(k, this.unknown[k]) {
+			return
+		}
+	}
+}
+
+// Serialize converts this into an interface representation suitable for
+// marshalling into a text or binary format.
+func (this W3IDSecurityV1RsaSignature2017) Serialize() (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	typeName := "RsaSignature2017"
+	if len(this.alias) > 0 {
+		typeName = this.alias + ":" + "RsaSignature2017"
+	}
+	m["type"] = typeName
+	// Begin: Serialize known properties
+	// Maybe serialize property "created"
+	if this.W3IDSecurityV1Created != nil {
+		if i, err := this.W3IDSecurityV1Created.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDSecurityV1Created.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "creator"
+	if this.W3IDSecurityV1Creator != nil {
+		if i, err := this.W3IDSecurityV1Creator.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDSecurityV1Creator.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "id"
+	if this.JSONLDId != nil {
+		if i, err := this.JSONLDId.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDId.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "signatureValue"
+	if this.W3IDSecurityV1SignatureValue != nil {
+		if i, err := this.W3IDSecurityV1SignatureValue.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.W3IDSecurityV1SignatureValue.Name()] = i
+		}
+
+	}
+	// Maybe serialize property "type"
+	if this.JSONLDType != nil {
+		if i, err := this.JSONLDType.Serialize(); err != nil {
+			return nil, err
+		} else if i != nil {
+			m[this.JSONLDType.Name()] = i
+		}
+
+	}
+	// End: Serialize known properties
+
+	// Begin: Serialize unknown properties
+	for k, v := range this.unknown {
+		// To be safe, ensure we aren't overwriting a known property
+		if _, has := m[k]; !has {
+			m[k] = v
+		}
+	}
+	// End: Serialize unknown properties
+
+	return m, nil
+}
+
+// SetJSONLDId sets the "id" property.
+func (this *W3IDSecurityV1RsaSignature2017) SetJSONLDId(i vocab.JSONLDIdProperty) {
+	this.JSONLDId = i
+}
+
+// SetJSONLDType sets the "type" property.
+func (this *W3IDSecurityV1RsaSignature2017) SetJSONLDType(i vocab.JSONLDTypeProperty) {
+	this.JSONLDType = i
+}
+
+// SetUnknownProperty sets the unknown property with the name, which is serialized
+// with the value as is. The value must be marshallable into JSON. It is not
+// serialized if the name is the name of a property known to the
+// RsaSignature2017 type.
+func (this *W3IDSecurityV1RsaSignature2017) SetUnknownProperty(name string, v interface{}) {
+	if this.unknown == nil {
+		this.unknown = make(map[string]interface{})
+	}
+	this.unknown[name] = v
+}
+
+// SetW3IDSecurityV1Created sets the "created" property.
+func (this *W3IDSecurityV1RsaSignature2017) SetW3IDSecurityV1Created(i vocab.W3IDSecurityV1CreatedProperty) {
+	this.W3IDSecurityV1Created = i
+}
+
+// SetW3IDSecurityV1Creator sets the "creator" property.
+func (this *W3IDSecurityV1RsaSignature2017) SetW3IDSecurityV1Creator(i vocab.W3IDSecurityV1CreatorProperty) {
+	this.W3IDSecurityV1Creator = i
+}
+
+// SetW3IDSecurityV1SignatureValue sets the "signatureValue" property.
+func (this *W3IDSecurityV1RsaSignature2017) SetW3IDSecurityV1SignatureValue(i vocab.W3IDSecurityV1SignatureValueProperty) {
+	this.W3IDSecurityV1SignatureValue = i
+}
+
+// Validate returns an error if this RsaSignature2017 violates its vocabulary: if
+// a value is out of the range of its property, if an IRI is not absolute, or
+// if a property required by the specification is missing. The error names the
+// property of the first violation found.
+func (this W3IDSecurityV1RsaSignature2017) Validate() error {
+	if this.W3IDSecurityV1Created != nil {
+		if err := this.W3IDSecurityV1Created.Validate(); err != nil {
+			return fmt.Errorf("created: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1Creator != nil {
+		if err := this.W3IDSecurityV1Creator.Validate(); err != nil {
+			return fmt.Errorf("creator: %v", err)
+		}
+	}
+	if this.JSONLDId != nil {
+		if err := this.JSONLDId.Validate(); err != nil {
+			return fmt.Errorf("id: %v", err)
+		}
+	}
+	if this.W3IDSecurityV1SignatureValue != nil {
+		if err := this.W3IDSecurityV1SignatureValue.Validate(); err != nil {
+			return fmt.Errorf("signatureValue: %v", err)
+		}
+	}
+	if this.JSONLDType != nil {
+		if err := this.JSONLDType.Validate(); err != nil {
+			return fmt.Errorf("type: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// VocabularyURI returns the vocabulary's URI as a string.
+func (this W3IDSecurityV1RsaSignature2017) VocabularyURI() string {
+	return "https://w3id.org/security/v1"
+}
+
+// helperJSONLDContext obtains the context uris and their aliases from a property,
+// if it is not nil.
+func (this W3IDSecurityV1RsaSignature2017) helperJSONLDContext(i jsonldContexter, toMerge map[string]string) map[string]string {
+	if i == nil {
+		return toMerge
+	}
+	for k, v := range i.JSONLDContext() {
+		/*
+		   Since the literal maps in this function are determined at
+		   code-generation time, this loop should not overwrite an existing key with a
+		   new value.
+		*/
+		toMerge[k] = v
+	}
+	return toMerge
+}
@@ -0,0 +1,332 @@
+package jsonld
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxCanonizeSteps bounds the work of canonicalizing blank nodes that
+	// cannot be told apart by their own statements, which grows
+	// factorially with their number, so that a hostile document cannot
+	// exhaust the CPU.
+	maxCanonizeSteps = 100000
+)
+
+// ErrCanonizeTooComplex indicates that a document has too many blank nodes
+// that are alike for it to be canonicalized.
+var ErrCanonizeTooComplex = errors.New("json-ld document is too complex to canonicalize")
+
+// Canonize converts a JSON-LD document to RDF and returns it as canonical
+// N-Quads, following the URDNA2015 algorithm: blank nodes are labeled
+// "_:c14n0", "_:c14n1", and so on, in an order only depending on the
+// statements, which are then sorted. Two documents with the same meaning, such
+// as ones using different terms, compact IRIs or orders, have the same
+// canonical form, which is what Linked Data Signatures sign.
+//
+// The @context values are processed with the DocumentLoader set by
+// SetDocumentLoader. Statements whose property or IRIs are not absolute IRIs,
+// such as properties undefined by the contexts, are dropped as JSON-LD
+// requires. Literals escape the quotation mark, the reverse solidus, and the
+// line feed and carriage return characters.
+func Canonize(c context.Context, m map[string]interface{}) (string, error) {
+	quads, err := toRDF(c, m)
+	if err != nil {
+		return "", err
+	}
+	return canonize(quads)
+}
+
+// canonize labels the blank nodes of the statements with URDNA2015, and
+// returns the sorted N-Quads.
+func canonize(quads []quad) (string, error) {
+	cz := &canonizer{
+		blankToQuads: make(map[string][]quad),
+		canonical:    newIdentifierIssuer("_:c14n"),
+	}
+	for _, q := range quads {
+		for _, t := range []rdfTerm{q.subject, q.object} {
+			if t.kind == blankTerm {
+				cz.blankToQuads[t.value] = append(cz.blankToQuads[t.value], q)
+			}
+		}
+	}
+	hashToBlanks := make(map[string][]string)
+	blanks := make([]string, 0, len(cz.blankToQuads))
+	for id := range cz.blankToQuads {
+		blanks = append(blanks, id)
+	}
+	sort.Strings(blanks)
+	for _, id := range blanks {
+		h := cz.hashFirstDegreeQuads(id)
+		hashToBlanks[h] = append(hashToBlanks[h], id)
+	}
+	hashes := make([]string, 0, len(hashToBlanks))
+	for h := range hashToBlanks {
+		hashes = append(hashes, h)
+	}
+	sort.Strings(hashes)
+	// Blank nodes with a unique hash are labeled first, in the order of
+	// their hash.
+	var shared []string
+	for _, h := range hashes {
+		if ids := hashToBlanks[h]; len(ids) == 1 {
+			cz.canonical.issue(ids[0])
+		} else {
+			shared = append(shared, h)
+		}
+	}
+	// The others are told apart by the blank nodes they relate to.
+	for _, h := range shared {
+		var results []nDegreeResult
+		for _, id := range hashToBlanks[h] {
+			if cz.canonical.has(id) {
+				continue
+			}
+			issuer := newIdentifierIssuer("_:b")
+			issuer.issue(id)
+			r, err := cz.hashNDegreeQuads(id, issuer)
+			if err != nil {
+				return "", err
+			}
+			results = append(results, r)
+		}
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].hash < results[j].hash
+		})
+		for _, r := range results {
+			for _, id := range r.issuer.order {
+				cz.canonical.issue(id)
+			}
+		}
+	}
+	lines := make([]string, len(quads))
+	for i, q := range quads {
+		q.subject = cz.relabel(q.subject)
+		q.object = cz.relabel(q.object)
+		lines[i] = q.nquad()
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, ""), nil
+}
+
+// identifierIssuer issues new labels for blank nodes, in order.
+type identifierIssuer struct {
+	prefix  string
+	counter int
+	issued  map[string]string
+	// order are the blank nodes in the order they were issued a label.
+	order []string
+}
+
+func newIdentifierIssuer(prefix string) *identifierIssuer {
+	return &identifierIssuer{
+		prefix: prefix,
+		issued: make(map[string]string),
+	}
+}
+
+// issue returns the label of the blank node, issuing a new one if needed.
+func (i *identifierIssuer) issue(id string) string {
+	if l, ok := i.issued[id]; ok {
+		return l
+	}
+	l := fmt.Sprintf("%s%d", i.prefix, i.counter)
+	i.counter++
+	i.issued[id] = l
+	i.order = append(i.order, id)
+	return l
+}
+
+// has returns true if the blank node has a label.
+func (i *identifierIssuer) has(id string) bool {
+	_, ok := i.issued[id]
+	return ok
+}
+
+// clone returns a copy that can issue labels without changing this one.
+func (i *identifierIssuer) clone() *identifierIssuer {
+	n := &identifierIssuer{
+		prefix:  i.prefix,
+		counter: i.counter,
+		issued:  make(map[string]string, len(i.issued)),
+		order:   append([]string(nil), i.order...),
+	}
+	for k, v := range i.issued {
+		n.issued[k] = v
+	}
+	return n
+}
+
+// nDegreeResult is the hash of a blank node and the labels issued to tell it
+// apart from others.
+type nDegreeResult struct {
+	hash   string
+	issuer *identifierIssuer
+}
+
+// canonizer holds the state of the URDNA2015 algorithm.
+type canonizer struct {
+	blankToQuads map[string][]quad
+	canonical    *identifierIssuer
+	steps        int
+}
+
+// relabel returns the term with its canonical label, if it is a blank node.
+func (cz *canonizer) relabel(t rdfTerm) rdfTerm {
+	if t.kind == blankTerm {
+		t.value = cz.canonical.issue(t.value)
+	}
+	return t
+}
+
+// hashFirstDegreeQuads hashes the statements of a blank node, where it is
+// "_:a" and any other blank node is "_:z".
+func (cz *canonizer) hashFirstDegreeQuads(id string) string {
+	mask := func(t rdfTerm) rdfTerm {
+		if t.kind == blankTerm {
+			if t.value == id {
+				t.value = "_:a"
+			} else {
+				t.value = "_:z"
+			}
+		}
+		return t
+	}
+	quads := cz.blankToQuads[id]
+	lines := make([]string, len(quads))
+	for i, q := range quads {
+		q.subject = mask(q.subject)
+		q.object = mask(q.object)
+		lines[i] = q.nquad()
+	}
+	sort.Strings(lines)
+	return sha256Hex(strings.Join(lines, ""))
+}
+
+// hashRelatedBlankNode hashes a blank node related to another by a
+// statement, where position is "s" if it is the subject and "o" if it is the
+// object.
+func (cz *canonizer) hashRelatedBlankNode(related string, q quad, issuer *identifierIssuer, position string) string {
+	var id string
+	if cz.canonical.has(related) {
+		id = cz.canonical.issue(related)
+	} else if issuer.has(related) {
+		id = issuer.issue(related)
+	} else {
+		id = cz.hashFirstDegreeQuads(related)
+	}
+	return sha256Hex(position + "<" + q.predicate.value + ">" + id)
+}
+
+// hashNDegreeQuads hashes a blank node by the paths to the blank nodes it
+// relates to, choosing the labeling of those that results in the smallest
+// path.
+func (cz *canonizer) hashNDegreeQuads(id string, issuer *identifierIssuer) (nDegreeResult, error) {
+	if cz.steps++; cz.steps > maxCanonizeSteps {
+		return nDegreeResult{}, ErrCanonizeTooComplex
+	}
+	hashToRelated := make(map[string][]string)
+	for _, q := range cz.blankToQuads[id] {
+		for _, rel := range []struct {
+			t        rdfTerm
+			position string
+		}{{q.subject, "s"}, {q.object, "o"}} {
+			if rel.t.kind == blankTerm && rel.t.value != id {
+				h := cz.hashRelatedBlankNode(rel.t.value, q, issuer, rel.position)
+				hashToRelated[h] = append(hashToRelated[h], rel.t.value)
+			}
+		}
+	}
+	hashes := make([]string, 0, len(hashToRelated))
+	for h := range hashToRelated {
+		hashes = append(hashes, h)
+	}
+	sort.Strings(hashes)
+	var data strings.Builder
+	for _, h := range hashes {
+		data.WriteString(h)
+		var chosenPath string
+		var chosenIssuer *identifierIssuer
+		err := permute(hashToRelated[h], func(perm []string) error {
+			if cz.steps++; cz.steps > maxCanonizeSteps {
+				return ErrCanonizeTooComplex
+			}
+			issuerCopy := issuer.clone()
+			path := ""
+			var recursion []string
+			worse := func() bool {
+				return len(chosenPath) > 0 && len(path) >= len(chosenPath) && path > chosenPath
+			}
+			for _, related := range perm {
+				if cz.canonical.has(related) {
+					path += cz.canonical.issue(related)
+				} else {
+					if !issuerCopy.has(related) {
+						recursion = append(recursion, related)
+					}
+					path += issuerCopy.issue(related)
+				}
+				if worse() {
+					return nil
+				}
+			}
+			for _, related := range recursion {
+				r, err := cz.hashNDegreeQuads(related, issuerCopy)
+				if err != nil {
+					return err
+				}
+				path += issuerCopy.issue(related)
+				path += "<" + r.hash + ">"
+				issuerCopy = r.issuer
+				if worse() {
+					return nil
+				}
+			}
+			if len(chosenPath) == 0 || path < chosenPath {
+				chosenPath = path
+				chosenIssuer = issuerCopy
+			}
+			return nil
+		})
+		if err != nil {
+			return nDegreeResult{}, err
+		}
+		data.WriteString(chosenPath)
+		issuer = chosenIssuer
+	}
+	return nDegreeResult{hash: sha256Hex(data.String()), issuer: issuer}, nil
+}
+
+// permute calls the function with every permutation of the elements, stopping
+// at the first error.
+func permute(elems []string, fn func([]string) error) error {
+	perm := append([]string(nil), elems...)
+	sort.Strings(perm)
+	var recur func(k int) error
+	recur = func(k int) error {
+		if k == len(perm) {
+			return fn(perm)
+		}
+		for i := k; i < len(perm); i++ {
+			perm[k], perm[i] = perm[i], perm[k]
+			if err := recur(k + 1); err != nil {
+				return err
+			}
+			perm[k], perm[i] = perm[i], perm[k]
+		}
+		return nil
+	}
+	return recur(0)
+}
+
+// sha256Hex returns the hexadecimal SHA-256 digest of the string.
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
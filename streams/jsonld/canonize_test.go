@@ -0,0 +1,190 @@
+package jsonld
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// parseTestNQuads parses N-Quads of IRIs and blank nodes only.
+func parseTestNQuads(t *testing.T, s string) []quad {
+	var quads []quad
+	for _, line := range strings.Split(strings.TrimSpace(s), "\n") {
+		f := strings.Fields(line)
+		if len(f) != 4 || f[3] != "." {
+			t.Fatalf("unsupported N-Quads line: %q", line)
+		}
+		var terms []rdfTerm
+		for _, v := range f[:3] {
+			if strings.HasPrefix(v, "_:") {
+				terms = append(terms, rdfTerm{kind: blankTerm, value: v})
+			} else {
+				terms = append(terms, rdfTerm{kind: iriTerm, value: strings.Trim(v, "<>")})
+			}
+		}
+		quads = append(quads, quad{subject: terms[0], predicate: terms[1], object: terms[2]})
+	}
+	return quads
+}
+
+func TestCanonizeQuads(t *testing.T) {
+	tables := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "Unique hashes",
+			input: `
+<http://example.com/#p> <http://example.com/#q> _:e0 .
+<http://example.com/#p> <http://example.com/#r> _:e1 .
+_:e0 <http://example.com/#s> <http://example.com/#u> .
+_:e1 <http://example.com/#t> <http://example.com/#u> .`,
+			expected: `<http://example.com/#p> <http://example.com/#q> _:c14n0 .
+<http://example.com/#p> <http://example.com/#r> _:c14n1 .
+_:c14n0 <http://example.com/#s> <http://example.com/#u> .
+_:c14n1 <http://example.com/#t> <http://example.com/#u> .
+`,
+		},
+		{
+			name: "Shared hashes",
+			input: `
+<http://example.com/#p> <http://example.com/#q> _:e0 .
+<http://example.com/#p> <http://example.com/#q> _:e1 .
+_:e0 <http://example.com/#p> _:e2 .
+_:e1 <http://example.com/#p> _:e3 .
+_:e2 <http://example.com/#r> _:e3 .`,
+			expected: `<http://example.com/#p> <http://example.com/#q> _:c14n2 .
+<http://example.com/#p> <http://example.com/#q> _:c14n3 .
+_:c14n0 <http://example.com/#r> _:c14n1 .
+_:c14n2 <http://example.com/#p> _:c14n1 .
+_:c14n3 <http://example.com/#p> _:c14n0 .
+`,
+		},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			quads := parseTestNQuads(t, r.input)
+			actual, err := canonize(quads)
+			if err != nil {
+				t.Fatalf("canonize returned error: %v", err)
+			}
+			if actual != r.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", r.expected, actual)
+			}
+			// Neither the labels nor the order of the input matter.
+			relabeled := strings.NewReplacer("_:e0", "_:x3", "_:e1", "_:x2", "_:e2", "_:x1", "_:e3", "_:x0").Replace(r.input)
+			reversed := parseTestNQuads(t, relabeled)
+			for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+				reversed[i], reversed[j] = reversed[j], reversed[i]
+			}
+			actual, err = canonize(reversed)
+			if err != nil {
+				t.Fatalf("canonize returned error: %v", err)
+			}
+			if actual != r.expected {
+				t.Errorf("expected the same form when relabeled, got:\n%s", actual)
+			}
+		})
+	}
+}
+
+func TestCanonizeTooComplex(t *testing.T) {
+	// A ring of blank nodes that are all alike.
+	var b strings.Builder
+	const n = 40
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j {
+				b.WriteString("_:n" + string(rune('A'+i)) + " <http://example.com/#p> _:n" + string(rune('A'+j)) + " .\n")
+			}
+		}
+	}
+	if _, err := canonize(parseTestNQuads(t, b.String())); err != ErrCanonizeTooComplex {
+		t.Errorf("expected ErrCanonizeTooComplex, got %v", err)
+	}
+}
+
+func TestCanonize(t *testing.T) {
+	tables := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "ActivityStreams terms and values",
+			input: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"sensitive": "as:sensitive"}],
+  "id": "https://example.com/note/1",
+  "type": "Note",
+  "attributedTo": "https://example.com/sally",
+  "published": "2020-01-02T03:04:05Z",
+  "sensitive": false,
+  "contentMap": {"EN": "hello \"world\"\n"},
+  "summary": "hi",
+  "width": 100,
+  "accuracy": 1.5,
+  "unknown": "dropped",
+  "inReplyTo": null
+}`,
+			expected: `<https://example.com/note/1> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://www.w3.org/ns/activitystreams#Note> .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#accuracy> "1.5E0"^^<http://www.w3.org/2001/XMLSchema#float> .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#attributedTo> <https://example.com/sally> .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#content> "hello \"world\"\n"@en .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#published> "2020-01-02T03:04:05Z"^^<http://www.w3.org/2001/XMLSchema#dateTime> .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#sensitive> "false"^^<http://www.w3.org/2001/XMLSchema#boolean> .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#summary> "hi" .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#width> "100"^^<http://www.w3.org/2001/XMLSchema#nonNegativeInteger> .
+`,
+		},
+		{
+			name: "Full IRIs have the same form as terms",
+			input: `{
+  "@context": {"as": "https://www.w3.org/ns/activitystreams#"},
+  "@id": "https://example.com/note/1",
+  "@type": "as:Note",
+  "https://www.w3.org/ns/activitystreams#summary": "hi"
+}`,
+			expected: `<https://example.com/note/1> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://www.w3.org/ns/activitystreams#Note> .
+<https://example.com/note/1> <https://www.w3.org/ns/activitystreams#summary> "hi" .
+`,
+		},
+		{
+			name: "Nested nodes and lists",
+			input: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "OrderedCollection",
+  "orderedItems": ["https://example.com/b", "https://example.com/a"],
+  "attachment": {"type": "Image", "url": "https://example.com/a.png"}
+}`,
+			expected: `_:c14n0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <https://example.com/a> .
+_:c14n0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+_:c14n1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://www.w3.org/ns/activitystreams#Image> .
+_:c14n1 <https://www.w3.org/ns/activitystreams#url> <https://example.com/a.png> .
+_:c14n2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <https://example.com/b> .
+_:c14n2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> _:c14n0 .
+_:c14n3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <https://www.w3.org/ns/activitystreams#OrderedCollection> .
+_:c14n3 <https://www.w3.org/ns/activitystreams#attachment> _:c14n1 .
+_:c14n3 <https://www.w3.org/ns/activitystreams#items> _:c14n2 .
+`,
+		},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			var m map[string]interface{}
+			if err := json.Unmarshal([]byte(r.input), &m); err != nil {
+				t.Fatal(err)
+			}
+			actual, err := Canonize(context.Background(), m)
+			if err != nil {
+				t.Fatalf("Canonize returned error: %v", err)
+			}
+			if actual != r.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", r.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package jsonld
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	// maxRemoteContexts is how deep remote contexts may reference other
+	// remote contexts, to stop on cycles.
+	maxRemoteContexts = 10
+)
+
+// term is the definition of a term in a context.
+type term struct {
+	// id is the IRI or keyword the term expands to. It is empty if the term
+	// was defined as null, to stop it from expanding.
+	id string
+	// typ is the expanded @type of the values of the term, such as "@id"
+	// for IRIs.
+	typ string
+	// container is the @container of the values of the term, such as
+	// "@language" for language maps.
+	container string
+}
+
+// activeContext is the result of processing the @context values in scope of
+// a node of a document.
+type activeContext struct {
+	vocab string
+	terms map[string]term
+}
+
+func newActiveContext() *activeContext {
+	return &activeContext{terms: make(map[string]term)}
+}
+
+// clone returns a copy that can be modified without changing this one.
+func (a *activeContext) clone() *activeContext {
+	n := &activeContext{
+		vocab: a.vocab,
+		terms: make(map[string]term, len(a.terms)),
+	}
+	for k, t := range a.terms {
+		n.terms[k] = t
+	}
+	return n
+}
+
+// merge returns a copy with the definitions of the other context applied on
+// top.
+func (a *activeContext) merge(o *activeContext) *activeContext {
+	n := a.clone()
+	if len(o.vocab) > 0 {
+		n.vocab = o.vocab
+	}
+	for k, t := range o.terms {
+		n.terms[k] = t
+	}
+	return n
+}
+
+// expandVocab expands a key or a type value into an IRI or keyword. It
+// returns false if the value is neither a term, a compact IRI nor an IRI and
+// there is no @vocab.
+func (a *activeContext) expandVocab(s string) (string, bool) {
+	if strings.HasPrefix(s, "@") {
+		return s, true
+	} else if t, ok := a.terms[s]; ok {
+		return t.id, len(t.id) > 0
+	} else if strings.Contains(s, ":") {
+		return a.expandCompact(s), true
+	} else if len(a.vocab) > 0 {
+		return a.vocab + s, true
+	}
+	return "", false
+}
+
+// expandCompact expands a compact IRI whose prefix is defined, leaving any
+// other value as is.
+func (a *activeContext) expandCompact(s string) string {
+	i := strings.Index(s, ":")
+	if i <= 0 {
+		return s
+	}
+	prefix, suffix := s[:i], s[i+1:]
+	if prefix == "_" || strings.HasPrefix(suffix, "//") {
+		return s
+	}
+	t, ok := a.terms[prefix]
+	if !ok || len(t.id) == 0 || strings.HasPrefix(t.id, "@") {
+		return s
+	}
+	// Prefixes are often aliased to the vocabulary IRI itself, such as
+	// "as" to "https://www.w3.org/ns/activitystreams", so the fragment
+	// delimiter is added when the IRI has none.
+	if !strings.ContainsAny(t.id[len(t.id)-1:], ":/?#[]@") {
+		return t.id + "#" + suffix
+	}
+	return t.id + suffix
+}
+
+// contextCache holds the contexts of remote documents, processed on their own.
+type contextCache struct {
+	mu       sync.Mutex
+	contexts map[string]*activeContext
+	known    map[string]*knownTerms
+}
+
+func newContextCache() *contextCache {
+	return &contextCache{
+		contexts: make(map[string]*activeContext),
+		known:    make(map[string]*knownTerms),
+	}
+}
+
+// processor processes @context values using a DocumentLoader.
+type processor struct {
+	loader DocumentLoader
+	cache  *contextCache
+}
+
+// process returns the active context after applying the raw @context value.
+func (p processor) process(c context.Context, a *activeContext, raw interface{}, depth int) (*activeContext, error) {
+	switch v := raw.(type) {
+	case nil:
+		return newActiveContext(), nil
+	case string:
+		r, err := p.remote(c, v, depth)
+		if err == ErrContextNotFound {
+			return a, nil
+		} else if err != nil {
+			return nil, err
+		}
+		return a.merge(r), nil
+	case []interface{}:
+		var err error
+		for _, elem := range v {
+			if a, err = p.process(c, a, elem, depth); err != nil {
+				return nil, err
+			}
+		}
+		return a, nil
+	case map[string]interface{}:
+		return define(a, v), nil
+	default:
+		return a, nil
+	}
+}
+
+// remote returns the context defined by the document at the IRI, processed on
+// its own.
+func (p processor) remote(c context.Context, iri string, depth int) (*activeContext, error) {
+	if depth >= maxRemoteContexts {
+		return nil, fmt.Errorf("json-ld remote contexts nested deeper than %d at %s", maxRemoteContexts, iri)
+	}
+	key := documentKey(iri)
+	p.cache.mu.Lock()
+	a, ok := p.cache.contexts[key]
+	p.cache.mu.Unlock()
+	if ok {
+		return a, nil
+	}
+	doc, err := p.loader.LoadDocument(c, iri)
+	if err != nil {
+		return nil, err
+	}
+	a, err = p.process(c, newActiveContext(), doc["@context"], depth+1)
+	if err != nil {
+		return nil, err
+	}
+	p.cache.mu.Lock()
+	p.cache.contexts[key] = a
+	p.cache.mu.Unlock()
+	return a, nil
+}
+
+// define returns the active context after applying the term definitions of a
+// local context. Invalid definitions are ignored.
+func define(a *activeContext, m map[string]interface{}) *activeContext {
+	n := a.clone()
+	if v, ok := m["@vocab"]; ok {
+		if s, ok := v.(string); ok {
+			n.vocab = n.expandCompact(s)
+		} else if v == nil {
+			n.vocab = ""
+		}
+	}
+	// Terms may be defined using other terms of the same context, in any
+	// order.
+	defined := make(map[string]bool, len(m))
+	var defineFn func(k string)
+	// defineDep defines the term or prefix an IRI is expanded with.
+	defineDep := func(iri string) {
+		dep := iri
+		if i := strings.Index(iri, ":"); i > 0 {
+			dep = iri[:i]
+		}
+		if _, ok := m[dep]; ok {
+			defineFn(dep)
+		}
+	}
+	defineFn = func(k string) {
+		if defined[k] {
+			return
+		}
+		defined[k] = true
+		var t term
+		var id string
+		hasId := false
+		switch v := m[k].(type) {
+		case nil:
+			n.terms[k] = t
+			return
+		case string:
+			id, hasId = v, true
+		case map[string]interface{}:
+			id, hasId = v["@id"].(string)
+			if s, ok := v["@type"].(string); ok {
+				if strings.HasPrefix(s, "@") {
+					t.typ = s
+				} else {
+					defineDep(s)
+					t.typ, _ = n.expandVocab(s)
+				}
+			}
+			t.container, _ = v["@container"].(string)
+		default:
+			return
+		}
+		if hasId {
+			defineDep(id)
+			t.id, _ = n.expandVocab(id)
+		} else {
+			t.id, _ = n.expandVocab(k)
+		}
+		if len(t.id) > 0 {
+			n.terms[k] = t
+		}
+	}
+	for k := range m {
+		if !strings.HasPrefix(k, "@") {
+			defineFn(k)
+		}
+	}
+	return n
+}
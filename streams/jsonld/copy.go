@@ -0,0 +1,38 @@
+package jsonld
+
+// CopyValue returns a deep copy of a value decoded from JSON, so that the
+// maps and arrays it holds can be modified without changing the original.
+// Other values, such as strings, numbers and booleans, are returned as is.
+//
+// It is used by the generated Clone methods to copy the unknown properties and
+// values of types and properties.
+func CopyValue(v interface{}) interface{} {
+	switch conc := v.(type) {
+	case map[string]interface{}:
+		return CopyObject(conc)
+	case []interface{}:
+		if conc == nil {
+			return conc
+		}
+		arr := make([]interface{}, len(conc))
+		for i, elem := range conc {
+			arr[i] = CopyValue(elem)
+		}
+		return arr
+	default:
+		return v
+	}
+}
+
+// CopyObject returns a deep copy of a JSON object, which is nil if the object
+// is nil.
+func CopyObject(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = CopyValue(v)
+	}
+	return out
+}
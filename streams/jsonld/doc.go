@@ -0,0 +1,14 @@
+// Package jsonld processes the JSON-LD contexts of ActivityStreams documents.
+//
+// ActivityStreams documents may name properties and types with full IRIs,
+// compact IRIs using any prefix, @vocab, or their own term definitions. The
+// generated deserializers only recognize the terms of the ActivityStreams and
+// security contexts, so Normalize rewrites documents into that form before they
+// are resolved.
+//
+// Contexts are loaded with a DocumentLoader, which by default works offline and
+// only knows the ActivityStreams, the W3ID Security Vocabulary, the Data
+// Integrity, and the Multikey contexts.
+// Applications may preload other contexts, or fetch them, by setting their own
+// with SetDocumentLoader.
+package jsonld
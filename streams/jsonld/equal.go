@@ -0,0 +1,85 @@
+package jsonld
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// EqualValues determines whether two values decoded from JSON are the same.
+// Objects and arrays are compared deeply, and numbers are compared regardless
+// of their Go type, so that an int set by an application equals the float64
+// decoded from JSON.
+//
+// It is used by the generated Equals methods to compare the unknown properties
+// and values of types and properties.
+func EqualValues(a, b interface{}) bool {
+	switch conc := a.(type) {
+	case map[string]interface{}:
+		o, ok := b.(map[string]interface{})
+		return ok && EqualObjects(conc, o)
+	case []interface{}:
+		o, ok := b.([]interface{})
+		if !ok || len(conc) != len(o) {
+			return false
+		}
+		for i := range conc {
+			if !EqualValues(conc[i], o[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// EqualObjects determines whether two JSON objects are the same, except for
+// the values of the ignored keys.
+func EqualObjects(a, b map[string]interface{}, ignored ...string) bool {
+	skip := func(k string) bool {
+		for _, i := range ignored {
+			if k == i {
+				return true
+			}
+		}
+		return false
+	}
+	n := 0
+	for k, v := range a {
+		if skip(k) {
+			continue
+		}
+		o, ok := b[k]
+		if !ok || !EqualValues(v, o) {
+			return false
+		}
+		n++
+	}
+	for k := range b {
+		if !skip(k) {
+			n--
+		}
+	}
+	return n == 0
+}
+
+// toFloat returns the value of a number.
+func toFloat(v interface{}) (float64, bool) {
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	r := reflect.ValueOf(v)
+	switch r.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(r.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(r.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return r.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,126 @@
+package jsonld
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ErrContextNotFound indicates a DocumentLoader does not know the document of
+// a context. Contexts that are not found are ignored when normalizing.
+var ErrContextNotFound = errors.New("json-ld context document not found")
+
+// DocumentLoader fetches the document of a remote context, such as the one
+// referenced by a string in a @context value.
+type DocumentLoader interface {
+	// LoadDocument returns the JSON-LD document at the IRI. It returns
+	// ErrContextNotFound if the document is unknown to it.
+	LoadDocument(c context.Context, iri string) (map[string]interface{}, error)
+}
+
+// PreloadedDocumentLoader is a DocumentLoader serving documents from memory,
+// so that contexts are processed without network access.
+//
+// Documents are looked up regardless of the scheme of the IRI being "http" or
+// "https", and of a trailing "#".
+type PreloadedDocumentLoader struct {
+	fallback DocumentLoader
+	mu       sync.RWMutex
+	docs     map[string]map[string]interface{}
+}
+
+var _ DocumentLoader = &PreloadedDocumentLoader{}
+
+// NewPreloadedDocumentLoader creates a PreloadedDocumentLoader knowing the
+// ActivityStreams, the W3ID Security Vocabulary, the Data Integrity, and the
+// Multikey contexts.
+//
+// Documents it does not know are loaded with the fallback, which may be nil to
+// stay offline.
+func NewPreloadedDocumentLoader(fallback DocumentLoader) *PreloadedDocumentLoader {
+	l := &PreloadedDocumentLoader{
+		fallback: fallback,
+		docs:     make(map[string]map[string]interface{}),
+	}
+	if err := l.AddJSON(ActivityStreamsContext, activityStreamsContextDocument); err != nil {
+		panic(err)
+	}
+	if err := l.AddJSON(SecurityV1Context, securityV1ContextDocument); err != nil {
+		panic(err)
+	}
+	if err := l.AddJSON(DataIntegrityV1Context, dataIntegrityV1ContextDocument); err != nil {
+		panic(err)
+	}
+	if err := l.AddJSON(MultikeyV1Context, multikeyV1ContextDocument); err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// Add preloads the document at the IRI.
+func (l *PreloadedDocumentLoader) Add(iri string, doc map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.docs[documentKey(iri)] = doc
+}
+
+// AddJSON preloads the JSON-encoded document at the IRI.
+func (l *PreloadedDocumentLoader) AddJSON(iri, doc string) error {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(doc), &m); err != nil {
+		return err
+	}
+	l.Add(iri, m)
+	return nil
+}
+
+// LoadDocument returns the preloaded document at the IRI, or loads it with the
+// fallback.
+func (l *PreloadedDocumentLoader) LoadDocument(c context.Context, iri string) (map[string]interface{}, error) {
+	l.mu.RLock()
+	doc, ok := l.docs[documentKey(iri)]
+	l.mu.RUnlock()
+	if ok {
+		return doc, nil
+	} else if l.fallback == nil {
+		return nil, ErrContextNotFound
+	}
+	return l.fallback.LoadDocument(c, iri)
+}
+
+// documentKey identifies the document at the IRI regardless of its scheme and
+// fragment delimiter.
+func documentKey(iri string) string {
+	iri = strings.TrimPrefix(iri, "https://")
+	iri = strings.TrimPrefix(iri, "http://")
+	return strings.TrimSuffix(iri, "#")
+}
+
+var (
+	loaderMu sync.RWMutex
+	loader   DocumentLoader = NewPreloadedDocumentLoader(nil)
+	// processed caches the contexts processed with the loader.
+	processed = newContextCache()
+)
+
+// SetDocumentLoader replaces the DocumentLoader used by Normalize, which is by
+// default a PreloadedDocumentLoader without fallback.
+//
+// Documents are loaded at most once per DocumentLoader, as the contexts they
+// define are cached until it is replaced.
+func SetDocumentLoader(l DocumentLoader) {
+	loaderMu.Lock()
+	defer loaderMu.Unlock()
+	loader = l
+	processed = newContextCache()
+}
+
+// documentLoader returns the DocumentLoader and the cache of the contexts it
+// loaded.
+func documentLoader() (DocumentLoader, *contextCache) {
+	loaderMu.RLock()
+	defer loaderMu.RUnlock()
+	return loader, processed
+}
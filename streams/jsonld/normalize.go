@@ -0,0 +1,260 @@
+package jsonld
+
+import (
+	"context"
+	"strings"
+)
+
+// knownTerm is the term of a vocabulary an IRI is compacted to.
+type knownTerm struct {
+	term       string
+	vocabulary string
+	// typ is the @type of the values of the term.
+	typ string
+}
+
+// knownTerms indexes the terms of the contexts of the vocabularies understood
+// by the caller of Normalize.
+type knownTerms struct {
+	// byIRI maps an IRI and container, separated by a space, to its term.
+	byIRI map[string]knownTerm
+	// names are the terms, which keep their meaning only if the document
+	// does not redefine them.
+	names map[string]bool
+}
+
+// known returns the terms of the contexts of the vocabularies. The context of
+// a vocabulary is the document at its IRI.
+func (p processor) known(c context.Context, vocabularies []string) (*knownTerms, error) {
+	key := strings.Join(vocabularies, " ")
+	p.cache.mu.Lock()
+	k, ok := p.cache.known[key]
+	p.cache.mu.Unlock()
+	if ok {
+		return k, nil
+	}
+	k = &knownTerms{
+		byIRI: make(map[string]knownTerm),
+		names: make(map[string]bool),
+	}
+	for _, v := range vocabularies {
+		a, err := p.remote(c, v, 0)
+		if err == ErrContextNotFound {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for name, t := range a.terms {
+			// Skip prefixes, which are not terms of the vocabulary.
+			if strings.HasSuffix(t.id, "#") || strings.HasSuffix(t.id, "/") {
+				continue
+			}
+			k.names[name] = true
+			for _, id := range schemeVariants(t.id) {
+				index := id + " " + t.container
+				// Prefer the same term regardless of the order of
+				// the map when several terms share an IRI, and the
+				// first vocabulary defining it.
+				if prev, ok := k.byIRI[index]; ok && prev.term <= name {
+					continue
+				}
+				k.byIRI[index] = knownTerm{term: name, vocabulary: v, typ: t.typ}
+			}
+		}
+	}
+	p.cache.mu.Lock()
+	p.cache.known[key] = k
+	p.cache.mu.Unlock()
+	return k, nil
+}
+
+// schemeVariants returns the IRI in both its "http" and "https" forms, since
+// vocabularies are referenced with either.
+func schemeVariants(iri string) []string {
+	if strings.HasPrefix(iri, "https://") {
+		return []string{iri, "http://" + strings.TrimPrefix(iri, "https://")}
+	} else if strings.HasPrefix(iri, "http://") {
+		return []string{iri, "https://" + strings.TrimPrefix(iri, "http://")}
+	}
+	return []string{iri}
+}
+
+// Normalize rewrites a JSON-LD document so that the properties and types of
+// the vocabularies are named by the terms of their contexts, which is the
+// only form the deserializers recognize.
+//
+// The @context values of the document are processed with the DocumentLoader
+// set by SetDocumentLoader. Then, keys and "type" values using full IRIs,
+// compact IRIs with any prefix, @vocab, or terms renamed by the document are
+// replaced by the term of the vocabulary with the same IRI. Compact IRIs used
+// as "id" values or as values of properties of IRIs are expanded. Keys and
+// types that are not in the vocabularies are kept as is, except terms of the
+// vocabularies that the document redefines, which are replaced by their IRI.
+// Nested @context values are applied then removed, and the vocabularies of the
+// terms used are added to the top level @context if missing.
+//
+// The vocabularies are the IRIs of the contexts known to the caller, such as
+// ActivityStreamsContext. The document is not modified; a normalized copy is
+// returned. A document without a @context is returned as is.
+func Normalize(c context.Context, m map[string]interface{}, vocabularies ...string) (map[string]interface{}, error) {
+	rawContext, ok := m["@context"]
+	if !ok {
+		return m, nil
+	}
+	l, cache := documentLoader()
+	p := processor{loader: l, cache: cache}
+	k, err := p.known(c, vocabularies)
+	if err != nil {
+		return nil, err
+	}
+	a, err := p.process(c, newActiveContext(), rawContext, 0)
+	if err != nil {
+		return nil, err
+	}
+	n := &normalizer{
+		p:     p,
+		known: k,
+		used:  make(map[string]bool),
+	}
+	out, err := n.object(c, a, m)
+	if err != nil {
+		return nil, err
+	}
+	out["@context"] = n.context(rawContext, vocabularies)
+	return out, nil
+}
+
+// normalizer rewrites the nodes of a document.
+type normalizer struct {
+	p     processor
+	known *knownTerms
+	// used are the vocabularies of the terms written.
+	used map[string]bool
+}
+
+// object returns the normalized copy of a node, without its @context.
+func (n *normalizer) object(c context.Context, a *activeContext, m map[string]interface{}) (map[string]interface{}, error) {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k == "@context" {
+			continue
+		}
+		key, t := n.key(a, k)
+		v, err := n.value(c, a, key, t, v)
+		if err != nil {
+			return nil, err
+		}
+		if prev, ok := out[key]; ok {
+			v = append(toSlice(prev), toSlice(v)...)
+		}
+		out[key] = v
+	}
+	return out, nil
+}
+
+// key returns the term a key is replaced with, and its definition in the
+// document. Keys replaced by a term of a vocabulary take the @type of its
+// values from the vocabulary if the document does not set it.
+func (n *normalizer) key(a *activeContext, k string) (string, term) {
+	t, defined := a.terms[k]
+	iri, ok := a.expandVocab(k)
+	if !ok {
+		return k, t
+	}
+	if kt, ok := n.known.byIRI[iri+" "+t.container]; ok {
+		n.used[kt.vocabulary] = true
+		if len(t.typ) == 0 {
+			t.typ = kt.typ
+		}
+		return kt.term, t
+	} else if defined && n.known.names[k] {
+		return iri, t
+	}
+	return k, t
+}
+
+// typeValue returns the term a "type" value is replaced with.
+func (n *normalizer) typeValue(a *activeContext, s string) string {
+	_, defined := a.terms[s]
+	iri, ok := a.expandVocab(s)
+	if !ok {
+		return s
+	}
+	if kt, ok := n.known.byIRI[iri+" "]; ok {
+		n.used[kt.vocabulary] = true
+		return kt.term
+	} else if defined && n.known.names[s] {
+		return iri
+	}
+	return s
+}
+
+// value returns the normalized copy of the value of a key.
+func (n *normalizer) value(c context.Context, a *activeContext, key string, t term, v interface{}) (interface{}, error) {
+	switch conc := v.(type) {
+	case string:
+		if key == "type" || key == "@type" {
+			return n.typeValue(a, conc), nil
+		} else if key == "id" || key == "@id" || t.typ == "@id" {
+			return a.expandCompact(conc), nil
+		}
+		return conc, nil
+	case []interface{}:
+		arr := make([]interface{}, len(conc))
+		for i, elem := range conc {
+			var err error
+			if arr[i], err = n.value(c, a, key, t, elem); err != nil {
+				return nil, err
+			}
+		}
+		return arr, nil
+	case map[string]interface{}:
+		// The keys of language and index maps are not terms, and value
+		// objects hold literals.
+		if t.container == "@language" || t.container == "@index" {
+			return conc, nil
+		} else if _, ok := conc["@value"]; ok {
+			return conc, nil
+		}
+		if raw, ok := conc["@context"]; ok {
+			var err error
+			if a, err = n.p.process(c, a, raw, 0); err != nil {
+				return nil, err
+			}
+		}
+		return n.object(c, a, conc)
+	default:
+		return v, nil
+	}
+}
+
+// context returns the top level @context, with the vocabularies of the terms
+// written added if it does not reference them.
+func (n *normalizer) context(raw interface{}, vocabularies []string) interface{} {
+	referenced := make(map[string]bool)
+	for _, v := range toSlice(raw) {
+		if s, ok := v.(string); ok {
+			referenced[documentKey(s)] = true
+		}
+	}
+	var missing []interface{}
+	for _, v := range vocabularies {
+		if n.used[v] && !referenced[documentKey(v)] {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) == 0 {
+		return raw
+	} else if raw == nil {
+		return missing
+	}
+	return append(toSlice(raw), missing...)
+}
+
+// toSlice returns the value as the elements of an array.
+func toSlice(v interface{}) []interface{} {
+	if arr, ok := v.([]interface{}); ok {
+		return append([]interface{}(nil), arr...)
+	}
+	return []interface{}{v}
+}
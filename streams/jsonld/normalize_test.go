@@ -0,0 +1,346 @@
+package jsonld
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-test/deep"
+	"testing"
+)
+
+// testLoader is a DocumentLoader returning an error.
+type testLoader struct {
+	err error
+}
+
+func (l testLoader) LoadDocument(c context.Context, iri string) (map[string]interface{}, error) {
+	return nil, l.err
+}
+
+func TestNormalize(t *testing.T) {
+	tables := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "Terms of the vocabularies are kept",
+			input: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://w3id.org/security/v1"],
+  "type": "Person",
+  "id": "https://example.com/sally",
+  "inbox": "https://example.com/sally/inbox",
+  "publicKey": {"id": "https://example.com/sally#key", "publicKeyPem": "pem"}
+}`,
+			expected: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://w3id.org/security/v1"],
+  "type": "Person",
+  "id": "https://example.com/sally",
+  "inbox": "https://example.com/sally/inbox",
+  "publicKey": {"id": "https://example.com/sally#key", "publicKeyPem": "pem"}
+}`,
+		},
+		{
+			name: "Full IRIs",
+			input: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "@id": "https://example.com/note/1",
+  "@type": "https://www.w3.org/ns/activitystreams#Note",
+  "https://www.w3.org/ns/activitystreams#content": "hello",
+  "http://www.w3.org/ns/ldp#inbox": "https://example.com/note/1/inbox"
+}`,
+			expected: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.com/note/1",
+  "type": "Note",
+  "content": "hello",
+  "inbox": "https://example.com/note/1/inbox"
+}`,
+		},
+		{
+			name: "Full IRIs without a context of the vocabulary",
+			input: `{
+  "@context": {},
+  "type": "http://www.w3.org/ns/activitystreams#Note",
+  "http://www.w3.org/ns/activitystreams#name": "hello"
+}`,
+			expected: `{
+  "@context": [{}, "https://www.w3.org/ns/activitystreams"],
+  "type": "Note",
+  "name": "hello"
+}`,
+		},
+		{
+			name: "Compact IRIs with a custom prefix",
+			input: `{
+  "@context": {"activity": "https://www.w3.org/ns/activitystreams#"},
+  "type": "activity:Note",
+  "activity:name": "hello",
+  "activity:to": "activity:Public"
+}`,
+			expected: `{
+  "@context": [{"activity": "https://www.w3.org/ns/activitystreams#"}, "https://www.w3.org/ns/activitystreams"],
+  "type": "Note",
+  "name": "hello",
+  "to": "https://www.w3.org/ns/activitystreams#Public"
+}`,
+		},
+		{
+			name: "Compact IRIs with a prefix aliasing the vocabulary",
+			input: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"as": "https://www.w3.org/ns/activitystreams"}],
+  "type": "as:Note",
+  "as:name": "hello"
+}`,
+			expected: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"as": "https://www.w3.org/ns/activitystreams"}],
+  "type": "Note",
+  "name": "hello"
+}`,
+		},
+		{
+			name: "Compact IRI values of IRI properties",
+			input: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "to": ["as:Public", "https://example.com/sally"]
+}`,
+			expected: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "to": ["https://www.w3.org/ns/activitystreams#Public", "https://example.com/sally"]
+}`,
+		},
+		{
+			name: "Vocab",
+			input: `{
+  "@context": {"@vocab": "https://www.w3.org/ns/activitystreams#"},
+  "type": "Note",
+  "summary": "hello"
+}`,
+			expected: `{
+  "@context": [{"@vocab": "https://www.w3.org/ns/activitystreams#"}, "https://www.w3.org/ns/activitystreams"],
+  "type": "Note",
+  "summary": "hello"
+}`,
+		},
+		{
+			name: "Renamed terms",
+			input: `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "Remarque": "as:Note",
+      "texte": "as:content",
+      "textes": {"@id": "as:content", "@container": "@language"},
+      "auteur": {"@id": "as:attributedTo", "@type": "@id"}
+    }
+  ],
+  "type": "Remarque",
+  "texte": "bonjour",
+  "textes": {"fr": "bonjour", "en": "hello"},
+  "auteur": "as:Public"
+}`,
+			expected: `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {
+      "Remarque": "as:Note",
+      "texte": "as:content",
+      "textes": {"@id": "as:content", "@container": "@language"},
+      "auteur": {"@id": "as:attributedTo", "@type": "@id"}
+    }
+  ],
+  "type": "Note",
+  "content": "bonjour",
+  "contentMap": {"fr": "bonjour", "en": "hello"},
+  "attributedTo": "https://www.w3.org/ns/activitystreams#Public"
+}`,
+		},
+		{
+			name: "Redefined terms are replaced by their IRI",
+			input: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"name": "http://schema.org/name"}],
+  "type": "Note",
+  "name": "hello"
+}`,
+			expected: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", {"name": "http://schema.org/name"}],
+  "type": "Note",
+  "http://schema.org/name": "hello"
+}`,
+		},
+		{
+			name: "Unknown terms are kept",
+			input: `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://example.com/unknown/context",
+    {"toot": "http://joinmastodon.org/ns#", "PropertyValue": "http://schema.org/PropertyValue"}
+  ],
+  "type": ["Person", "PropertyValue"],
+  "toot:discoverable": true,
+  "preferredUsernameMap": {"en": "sally"},
+  "somethingElse": 1
+}`,
+			expected: `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    "https://example.com/unknown/context",
+    {"toot": "http://joinmastodon.org/ns#", "PropertyValue": "http://schema.org/PropertyValue"}
+  ],
+  "type": ["Person", "PropertyValue"],
+  "toot:discoverable": true,
+  "preferredUsernameMap": {"en": "sally"},
+  "somethingElse": 1
+}`,
+		},
+		{
+			name: "Nested contexts are applied and removed",
+			input: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Create",
+  "object": {
+    "@context": {"s": "https://w3id.org/security#"},
+    "type": "Note",
+    "s:publicKeyPem": "pem",
+    "attachment": {"type": "Image", "s:owner": "https://example.com/sally"}
+  }
+}`,
+			expected: `{
+  "@context": ["https://www.w3.org/ns/activitystreams", "https://w3id.org/security/v1"],
+  "type": "Create",
+  "object": {
+    "type": "Note",
+    "publicKeyPem": "pem",
+    "attachment": {"type": "Image", "owner": "https://example.com/sally"}
+  }
+}`,
+		},
+		{
+			name: "Value objects are kept",
+			input: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "content": {"@value": "hello", "@language": "en"}
+}`,
+			expected: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "content": {"@value": "hello", "@language": "en"}
+}`,
+		},
+		{
+			name: "Documents without a context are kept",
+			input: `{
+  "type": "https://www.w3.org/ns/activitystreams#Note"
+}`,
+			expected: `{
+  "type": "https://www.w3.org/ns/activitystreams#Note"
+}`,
+		},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			var m, expected map[string]interface{}
+			if err := json.Unmarshal([]byte(r.input), &m); err != nil {
+				t.Fatalf("cannot json.Unmarshal input: %v", err)
+			}
+			if err := json.Unmarshal([]byte(r.expected), &expected); err != nil {
+				t.Fatalf("cannot json.Unmarshal expected: %v", err)
+			}
+			actual, err := Normalize(context.Background(), m, ActivityStreamsContext, SecurityV1Context)
+			if err != nil {
+				t.Fatalf("Normalize returned error: %v", err)
+			}
+			if diff := deep.Equal(actual, expected); diff != nil {
+				t.Errorf("%v", diff)
+			}
+		})
+	}
+}
+
+func TestNormalizeMergesKeys(t *testing.T) {
+	m := map[string]interface{}{
+		"@context": ActivityStreamsContext,
+		"type":     "Note",
+		"tag":      "https://example.com/tag/1",
+		"https://www.w3.org/ns/activitystreams#tag": "https://example.com/tag/2",
+	}
+	actual, err := Normalize(context.Background(), m, ActivityStreamsContext)
+	if err != nil {
+		t.Fatalf("Normalize returned error: %v", err)
+	}
+	tags, ok := actual["tag"].([]interface{})
+	if !ok || len(tags) != 2 {
+		t.Fatalf("expected two tags, got %v", actual["tag"])
+	}
+	if _, ok := actual["https://www.w3.org/ns/activitystreams#tag"]; ok {
+		t.Errorf("expected the IRI key to be replaced")
+	}
+}
+
+func TestSetDocumentLoader(t *testing.T) {
+	defer SetDocumentLoader(NewPreloadedDocumentLoader(nil))
+	t.Run("PreloadsContexts", func(t *testing.T) {
+		l := NewPreloadedDocumentLoader(nil)
+		l.Add("https://example.com/context", map[string]interface{}{
+			"@context": map[string]interface{}{
+				"Remarque": "https://www.w3.org/ns/activitystreams#Note",
+			},
+		})
+		SetDocumentLoader(l)
+		m := map[string]interface{}{
+			"@context": []interface{}{ActivityStreamsContext, "http://example.com/context"},
+			"type":     "Remarque",
+		}
+		actual, err := Normalize(context.Background(), m, ActivityStreamsContext)
+		if err != nil {
+			t.Fatalf("Normalize returned error: %v", err)
+		}
+		if actual["type"] != "Note" {
+			t.Errorf("expected Note, got %v", actual["type"])
+		}
+	})
+	t.Run("FallsBack", func(t *testing.T) {
+		testErr := errors.New("test error")
+		SetDocumentLoader(NewPreloadedDocumentLoader(testLoader{err: testErr}))
+		m := map[string]interface{}{
+			"@context": []interface{}{ActivityStreamsContext, "https://example.com/context"},
+			"type":     "Note",
+		}
+		_, err := Normalize(context.Background(), m, ActivityStreamsContext)
+		if err != testErr {
+			t.Errorf("expected %v, got %v", testErr, err)
+		}
+	})
+	t.Run("IgnoresContextsNotFound", func(t *testing.T) {
+		SetDocumentLoader(testLoader{err: ErrContextNotFound})
+		m := map[string]interface{}{
+			"@context": ActivityStreamsContext,
+			"type":     "https://www.w3.org/ns/activitystreams#Note",
+		}
+		actual, err := Normalize(context.Background(), m, ActivityStreamsContext)
+		if err != nil {
+			t.Fatalf("Normalize returned error: %v", err)
+		}
+		if actual["type"] != "https://www.w3.org/ns/activitystreams#Note" {
+			t.Errorf("expected the type to be kept, got %v", actual["type"])
+		}
+	})
+	t.Run("StopsOnCycles", func(t *testing.T) {
+		l := NewPreloadedDocumentLoader(nil)
+		l.Add("https://example.com/context", map[string]interface{}{
+			"@context": "https://example.com/context",
+		})
+		SetDocumentLoader(l)
+		m := map[string]interface{}{
+			"@context": "https://example.com/context",
+			"type":     "Note",
+		}
+		if _, err := Normalize(context.Background(), m, ActivityStreamsContext); err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
@@ -0,0 +1,380 @@
+package jsonld
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	rdfType       = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+	rdfFirst      = "http://www.w3.org/1999/02/22-rdf-syntax-ns#first"
+	rdfRest       = "http://www.w3.org/1999/02/22-rdf-syntax-ns#rest"
+	rdfNil        = "http://www.w3.org/1999/02/22-rdf-syntax-ns#nil"
+	rdfLangString = "http://www.w3.org/1999/02/22-rdf-syntax-ns#langString"
+	xsdString     = "http://www.w3.org/2001/XMLSchema#string"
+	xsdBoolean    = "http://www.w3.org/2001/XMLSchema#boolean"
+	xsdInteger    = "http://www.w3.org/2001/XMLSchema#integer"
+	xsdDouble     = "http://www.w3.org/2001/XMLSchema#double"
+)
+
+// absoluteIRI matches the scheme of an absolute IRI.
+var absoluteIRI = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:`)
+
+// rdfTermKind is the kind of an RDF term.
+type rdfTermKind int
+
+const (
+	// invalidTerm is a relative IRI, which has no meaning without a base
+	// IRI. Statements using one are dropped.
+	invalidTerm rdfTermKind = iota
+	iriTerm
+	blankTerm
+	literalTerm
+)
+
+// rdfTerm is the subject, predicate or object of an RDF statement.
+type rdfTerm struct {
+	kind  rdfTermKind
+	value string
+	// datatype and language are only set for literals.
+	datatype string
+	language string
+}
+
+// nquad returns the term in N-Quads form.
+func (t rdfTerm) nquad() string {
+	switch t.kind {
+	case iriTerm:
+		return "<" + t.value + ">"
+	case blankTerm:
+		return t.value
+	}
+	s := `"` + escapeLiteral(t.value) + `"`
+	if t.datatype == rdfLangString {
+		return s + "@" + t.language
+	} else if t.datatype != xsdString {
+		return s + "^^<" + t.datatype + ">"
+	}
+	return s
+}
+
+// escapeLiteral escapes the characters of a literal as canonical N-Quads do.
+func escapeLiteral(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+	).Replace(s)
+}
+
+// quad is an RDF statement of the default graph.
+type quad struct {
+	subject, predicate, object rdfTerm
+}
+
+// nquad returns the statement as a line of N-Quads.
+func (q quad) nquad() string {
+	return q.subject.nquad() + " " + q.predicate.nquad() + " " + q.object.nquad() + " .\n"
+}
+
+// rdfConverter converts a JSON-LD document into RDF statements.
+type rdfConverter struct {
+	p     processor
+	quads []quad
+	// labels are the blank nodes of the document, relabeled.
+	labels map[string]string
+	next   int
+}
+
+// toRDF returns the statements of a JSON-LD document, without duplicates.
+//
+// It supports the features of JSON-LD 1.0 found in ActivityStreams documents:
+// node objects and references, value objects, @type coercion, @list, and
+// language and index maps. Named graphs and reverse properties are ignored.
+func toRDF(c context.Context, m map[string]interface{}) ([]quad, error) {
+	l, cache := documentLoader()
+	r := &rdfConverter{
+		p:      processor{loader: l, cache: cache},
+		labels: make(map[string]string),
+	}
+	if _, err := r.node(c, newActiveContext(), m); err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(r.quads))
+	quads := r.quads[:0]
+	for _, q := range r.quads {
+		if s := q.nquad(); !seen[s] {
+			seen[s] = true
+			quads = append(quads, q)
+		}
+	}
+	return quads, nil
+}
+
+// blank returns a blank node, which is new if the label is empty.
+func (r *rdfConverter) blank(label string) rdfTerm {
+	if len(label) > 0 {
+		if l, ok := r.labels[label]; ok {
+			return rdfTerm{kind: blankTerm, value: l}
+		}
+	}
+	l := fmt.Sprintf("_:b%d", r.next)
+	r.next++
+	if len(label) > 0 {
+		r.labels[label] = l
+	}
+	return rdfTerm{kind: blankTerm, value: l}
+}
+
+// iri returns the term of an expanded IRI or blank node identifier.
+func (r *rdfConverter) iri(s string) rdfTerm {
+	if strings.HasPrefix(s, "_:") {
+		return r.blank(s)
+	} else if absoluteIRI.MatchString(s) {
+		return rdfTerm{kind: iriTerm, value: s}
+	}
+	return rdfTerm{kind: invalidTerm, value: s}
+}
+
+// emit adds a statement, unless it uses a relative IRI.
+func (r *rdfConverter) emit(s, p, o rdfTerm) {
+	if s.kind == invalidTerm || o.kind == invalidTerm {
+		return
+	}
+	r.quads = append(r.quads, quad{subject: s, predicate: p, object: o})
+}
+
+// node adds the statements of a node object and returns its subject.
+func (r *rdfConverter) node(c context.Context, a *activeContext, m map[string]interface{}) (rdfTerm, error) {
+	if raw, ok := m["@context"]; ok {
+		var err error
+		if a, err = r.p.process(c, a, raw, 0); err != nil {
+			return rdfTerm{}, err
+		}
+	}
+	keys := make([]string, 0, len(m))
+	var subject rdfTerm
+	hasId := false
+	for k, v := range m {
+		if iri, ok := a.expandVocab(k); ok && iri == "@id" {
+			if s, ok := v.(string); ok {
+				subject, hasId = r.iri(a.expandCompact(s)), true
+			}
+			continue
+		}
+		keys = append(keys, k)
+	}
+	if !hasId {
+		subject = r.blank("")
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		iri, ok := a.expandVocab(k)
+		if !ok {
+			continue
+		} else if iri == "@type" {
+			for _, v := range toSlice(m[k]) {
+				s, ok := v.(string)
+				if !ok {
+					continue
+				}
+				if typ, ok := a.expandVocab(s); ok {
+					r.emit(subject, rdfTerm{kind: iriTerm, value: rdfType}, r.iri(typ))
+				}
+			}
+			continue
+		} else if strings.HasPrefix(iri, "@") || !absoluteIRI.MatchString(iri) || strings.HasPrefix(iri, "_:") {
+			// Keywords such as @graph are not supported, and
+			// properties that are not IRIs are dropped.
+			continue
+		}
+		predicate := rdfTerm{kind: iriTerm, value: iri}
+		if err := r.values(c, a, subject, predicate, a.terms[k], m[k]); err != nil {
+			return rdfTerm{}, err
+		}
+	}
+	return subject, nil
+}
+
+// values adds the statements of the values of a property.
+func (r *rdfConverter) values(c context.Context, a *activeContext, subject, predicate rdfTerm, t term, v interface{}) error {
+	if _, ok := v.([]interface{}); !ok && v != nil && t.container == "@list" {
+		if m, ok := v.(map[string]interface{}); !ok || m["@list"] == nil {
+			v = []interface{}{v}
+		}
+	}
+	switch conc := v.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		if t.container == "@list" {
+			o, err := r.list(c, a, t, conc)
+			if err != nil {
+				return err
+			}
+			r.emit(subject, predicate, o)
+			return nil
+		}
+		for _, elem := range conc {
+			if err := r.values(c, a, subject, predicate, t, elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		if t.container == "@language" {
+			langs := make([]string, 0, len(conc))
+			for lang := range conc {
+				langs = append(langs, lang)
+			}
+			sort.Strings(langs)
+			for _, lang := range langs {
+				for _, s := range toSlice(conc[lang]) {
+					if s, ok := s.(string); ok {
+						r.emit(subject, predicate, rdfTerm{
+							kind:     literalTerm,
+							value:    s,
+							datatype: rdfLangString,
+							language: strings.ToLower(lang),
+						})
+					}
+				}
+			}
+			return nil
+		} else if t.container == "@index" {
+			for _, elem := range conc {
+				if err := r.values(c, a, subject, predicate, term{id: t.id, typ: t.typ}, elem); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	o, ok, err := r.object(c, a, t, v)
+	if err != nil {
+		return err
+	} else if ok {
+		r.emit(subject, predicate, o)
+	}
+	return nil
+}
+
+// list adds the statements of a list and returns its head.
+func (r *rdfConverter) list(c context.Context, a *activeContext, t term, elems []interface{}) (rdfTerm, error) {
+	var objects []rdfTerm
+	for _, elem := range elems {
+		o, ok, err := r.object(c, a, t, elem)
+		if err != nil {
+			return rdfTerm{}, err
+		} else if ok {
+			objects = append(objects, o)
+		}
+	}
+	head := rdfTerm{kind: iriTerm, value: rdfNil}
+	for i := len(objects) - 1; i >= 0; i-- {
+		b := r.blank("")
+		r.emit(b, rdfTerm{kind: iriTerm, value: rdfFirst}, objects[i])
+		r.emit(b, rdfTerm{kind: iriTerm, value: rdfRest}, head)
+		head = b
+	}
+	return head, nil
+}
+
+// object returns the term of a single value of a property, adding the
+// statements of the nodes it holds. It returns false if the value has no
+// term, such as null.
+func (r *rdfConverter) object(c context.Context, a *activeContext, t term, v interface{}) (rdfTerm, bool, error) {
+	switch conc := v.(type) {
+	case nil:
+		return rdfTerm{}, false, nil
+	case string:
+		switch t.typ {
+		case "@id":
+			return r.iri(a.expandCompact(conc)), true, nil
+		case "@vocab":
+			iri, ok := a.expandVocab(conc)
+			return r.iri(iri), ok, nil
+		case "":
+			return rdfTerm{kind: literalTerm, value: conc, datatype: xsdString}, true, nil
+		}
+		return rdfTerm{kind: literalTerm, value: conc, datatype: t.typ}, true, nil
+	case bool:
+		return rdfTerm{kind: literalTerm, value: strconv.FormatBool(conc), datatype: datatypeOr(t.typ, xsdBoolean)}, true, nil
+	case map[string]interface{}:
+		var value, typ, lang, list interface{}
+		isValue, isList := false, false
+		for k, v := range conc {
+			switch iri, _ := a.expandVocab(k); iri {
+			case "@value":
+				value, isValue = v, true
+			case "@type":
+				typ = v
+			case "@language":
+				lang = v
+			case "@list":
+				list, isList = v, true
+			}
+		}
+		if isList {
+			o, err := r.list(c, a, t, toSlice(list))
+			return o, err == nil, err
+		} else if !isValue {
+			o, err := r.node(c, a, conc)
+			return o, err == nil, err
+		} else if value == nil {
+			return rdfTerm{}, false, nil
+		}
+		vt := term{}
+		if s, ok := typ.(string); ok {
+			vt.typ, _ = a.expandVocab(s)
+		}
+		if s, ok := lang.(string); ok {
+			if str, ok := value.(string); ok {
+				return rdfTerm{kind: literalTerm, value: str, datatype: rdfLangString, language: strings.ToLower(s)}, true, nil
+			}
+		}
+		if s, ok := value.(string); ok && len(vt.typ) == 0 {
+			return rdfTerm{kind: literalTerm, value: s, datatype: xsdString}, true, nil
+		}
+		return r.object(c, a, vt, value)
+	}
+	if f, ok := toFloat(v); ok {
+		return numberLiteral(f, t.typ), true, nil
+	}
+	return rdfTerm{}, false, fmt.Errorf("json-ld value of unsupported type %T", v)
+}
+
+// numberLiteral returns the literal of a number, which is an xsd:integer
+// unless it has a fraction, is too large, or has an xsd:double datatype.
+func numberLiteral(f float64, datatype string) rdfTerm {
+	if f != math.Trunc(f) || math.Abs(f) >= 1e21 || datatype == xsdDouble {
+		// Such as "1.5E0", as ECMAScript's toExponential formats it.
+		s := strconv.FormatFloat(f, 'E', 15, 64)
+		i := strings.IndexByte(s, 'E')
+		mantissa := strings.TrimRight(s[:i], "0")
+		if strings.HasSuffix(mantissa, ".") {
+			mantissa += "0"
+		}
+		exp, _ := strconv.Atoi(s[i+1:])
+		return rdfTerm{kind: literalTerm, value: fmt.Sprintf("%sE%d", mantissa, exp), datatype: datatypeOr(datatype, xsdDouble)}
+	}
+	if f == 0 {
+		// Negative zero is also "0".
+		f = 0
+	}
+	return rdfTerm{kind: literalTerm, value: strconv.FormatFloat(f, 'f', 0, 64), datatype: datatypeOr(datatype, xsdInteger)}
+}
+
+// datatypeOr returns the datatype, or the default if it is not set.
+func datatypeOr(datatype, def string) string {
+	if len(datatype) == 0 || strings.HasPrefix(datatype, "@") {
+		return def
+	}
+	return datatype
+}
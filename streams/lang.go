@@ -0,0 +1,252 @@
+package streams
+
+import (
+	"github.com/go-fed/activity/streams/vocab"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// UndeterminedLanguage is the BCP 47 tag of values without a language, such
+// as a plain "content" string, in the maps of ContentMap, NameMap and
+// SummaryMap.
+const UndeterminedLanguage = "und"
+
+// langStringValue is a value of the content, name, and summary properties.
+type langStringValue interface {
+	IsXMLSchemaString() bool
+	GetXMLSchemaString() string
+	IsRDFLangString() bool
+	GetRDFLangString() map[string]string
+}
+
+// ContentMap returns the values of the content property keyed by their BCP 47
+// language tag. A plain string is keyed by UndeterminedLanguage. It returns an
+// empty map if the property is nil.
+func ContentMap(p vocab.ActivityStreamsContentProperty) map[string]string {
+	m := make(map[string]string)
+	if p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			addLangStringValue(m, iter)
+		}
+	}
+	return m
+}
+
+// NameMap returns the values of the name property keyed by their BCP 47
+// language tag, as ContentMap does.
+func NameMap(p vocab.ActivityStreamsNameProperty) map[string]string {
+	m := make(map[string]string)
+	if p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			addLangStringValue(m, iter)
+		}
+	}
+	return m
+}
+
+// SummaryMap returns the values of the summary property keyed by their BCP 47
+// language tag, as ContentMap does.
+func SummaryMap(p vocab.ActivityStreamsSummaryProperty) map[string]string {
+	m := make(map[string]string)
+	if p != nil {
+		for iter := p.Begin(); iter != p.End(); iter = iter.Next() {
+			addLangStringValue(m, iter)
+		}
+	}
+	return m
+}
+
+// SetContentMap replaces the values of the content property with the map of
+// BCP 47 language tags to values. The value of UndeterminedLanguage is set as
+// a plain "content" string, and the other values as a single "contentMap"
+// alongside it.
+func SetContentMap(p vocab.ActivityStreamsContentProperty, m map[string]string) {
+	for p.Len() > 0 {
+		p.Remove(0)
+	}
+	s, langs := splitUndetermined(m)
+	if s != nil {
+		p.AppendXMLSchemaString(*s)
+	}
+	if len(langs) > 0 {
+		p.AppendRDFLangString(langs)
+	}
+}
+
+// SetNameMap replaces the values of the name property with the map of BCP 47
+// language tags to values, as SetContentMap does.
+func SetNameMap(p vocab.ActivityStreamsNameProperty, m map[string]string) {
+	for p.Len() > 0 {
+		p.Remove(0)
+	}
+	s, langs := splitUndetermined(m)
+	if s != nil {
+		p.AppendXMLSchemaString(*s)
+	}
+	if len(langs) > 0 {
+		p.AppendRDFLangString(langs)
+	}
+}
+
+// SetSummaryMap replaces the values of the summary property with the map of
+// BCP 47 language tags to values, as SetContentMap does.
+func SetSummaryMap(p vocab.ActivityStreamsSummaryProperty, m map[string]string) {
+	for p.Len() > 0 {
+		p.Remove(0)
+	}
+	s, langs := splitUndetermined(m)
+	if s != nil {
+		p.AppendXMLSchemaString(*s)
+	}
+	if len(langs) > 0 {
+		p.AppendRDFLangString(langs)
+	}
+}
+
+// BestContent returns the value of the content property best matching the
+// language ranges, as BestLanguage does.
+func BestContent(p vocab.ActivityStreamsContentProperty, ranges ...string) (string, bool) {
+	v, _, ok := BestLanguage(ContentMap(p), ranges...)
+	return v, ok
+}
+
+// BestName returns the value of the name property best matching the language
+// ranges, as BestLanguage does.
+func BestName(p vocab.ActivityStreamsNameProperty, ranges ...string) (string, bool) {
+	v, _, ok := BestLanguage(NameMap(p), ranges...)
+	return v, ok
+}
+
+// BestSummary returns the value of the summary property best matching the
+// language ranges, as BestLanguage does.
+func BestSummary(p vocab.ActivityStreamsSummaryProperty, ranges ...string) (string, bool) {
+	v, _, ok := BestLanguage(SummaryMap(p), ranges...)
+	return v, ok
+}
+
+// BestLanguage returns the value of the map of BCP 47 language tags best
+// matching the language ranges, which are in order of preference such as
+// returned by ParseAcceptLanguage, and its tag. It returns false only if the
+// map is empty.
+//
+// Tags are compared case-insensitively. For each range, a tag equal to it is
+// preferred, then a more specific tag, such as "en-GB" for "en". If there is
+// none, the range is truncated as in the Lookup scheme of RFC 4647, so that
+// "zh-Hant-TW" falls back to "zh-Hant" then to "zh". If no range matches, or a
+// range is "*", the value of UndeterminedLanguage is returned, or else the
+// value of the first tag in lexical order.
+func BestLanguage(m map[string]string, ranges ...string) (value, tag string, ok bool) {
+	if len(m) == 0 {
+		return
+	}
+	tags := make([]string, 0, len(m))
+	for t := range m {
+		tags = append(tags, t)
+	}
+	sort.Strings(tags)
+	for _, r := range ranges {
+		r = strings.ToLower(strings.TrimSpace(r))
+		if r == "*" {
+			break
+		}
+		for ; len(r) > 0; r = truncateLanguageRange(r) {
+			for _, t := range tags {
+				if strings.ToLower(t) == r {
+					return m[t], t, true
+				}
+			}
+			for _, t := range tags {
+				if strings.HasPrefix(strings.ToLower(t), r+"-") {
+					return m[t], t, true
+				}
+			}
+		}
+	}
+	if v, ok := m[UndeterminedLanguage]; ok {
+		return v, UndeterminedLanguage, true
+	}
+	return m[tags[0]], tags[0], true
+}
+
+// ParseAcceptLanguage returns the language ranges of the value of an
+// Accept-Language header in order of preference. Ranges of equal weight keep
+// their order, and ranges with a weight of zero or an invalid weight are
+// omitted.
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		r string
+		q float64
+	}
+	var ws []weighted
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		r := strings.TrimSpace(params[0])
+		if len(r) == 0 {
+			continue
+		}
+		q := 1.0
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if !strings.HasPrefix(p, "q=") {
+				continue
+			}
+			var err error
+			if q, err = strconv.ParseFloat(p[len("q="):], 64); err != nil {
+				q = 0
+			}
+		}
+		if q > 0 {
+			ws = append(ws, weighted{r, q})
+		}
+	}
+	sort.SliceStable(ws, func(i, j int) bool {
+		return ws[i].q > ws[j].q
+	})
+	ranges := make([]string, len(ws))
+	for i, w := range ws {
+		ranges[i] = w.r
+	}
+	return ranges
+}
+
+// truncateLanguageRange removes the last subtag of the language range, and
+// the single-character subtag preceding it, if any.
+func truncateLanguageRange(r string) string {
+	i := strings.LastIndex(r, "-")
+	if i < 0 {
+		return ""
+	}
+	r = r[:i]
+	if i = strings.LastIndex(r, "-"); i >= 0 && len(r)-i == 2 {
+		r = r[:i]
+	}
+	return r
+}
+
+// addLangStringValue adds a plain string or language map value to the map.
+func addLangStringValue(m map[string]string, v langStringValue) {
+	if v.IsXMLSchemaString() {
+		m[UndeterminedLanguage] = v.GetXMLSchemaString()
+	} else if v.IsRDFLangString() {
+		for k, s := range v.GetRDFLangString() {
+			m[k] = s
+		}
+	}
+}
+
+// splitUndetermined returns the value of UndeterminedLanguage, if any, and a
+// copy of the language map without it.
+func splitUndetermined(m map[string]string) (*string, map[string]string) {
+	var s *string
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		if k == UndeterminedLanguage {
+			v := v
+			s = &v
+			continue
+		}
+		c[k] = v
+	}
+	return s, c
+}
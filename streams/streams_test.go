@@ -3,11 +3,14 @@ package streams
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"github.com/go-fed/activity/streams/vocab"
 	"github.com/go-test/deep"
+	"math"
 	"net/url"
 	"sort"
 	"testing"
+	"time"
 )
 
 // IsKnownResolverError returns true if it is known that an example from
@@ -522,3 +525,738 @@ func GetJSONDiff(str1, str2 []byte) ([]string, error) {
 	}
 	return deep.Equal(i1, i2), nil
 }
+
+func TestToTypeNormalizesContext(t *testing.T) {
+	const input = `{
+  "@context": [
+    "https://www.w3.org/ns/activitystreams",
+    {"act": "https://www.w3.org/ns/activitystreams#", "texte": "act:content"}
+  ],
+  "@id": "https://example.com/note/1",
+  "@type": "https://www.w3.org/ns/activitystreams#Note",
+  "texte": "bonjour",
+  "act:to": "act:Public"
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal: %v", err)
+	}
+	actual, err := ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %v", err)
+	}
+	note, ok := actual.(vocab.ActivityStreamsNote)
+	if !ok {
+		t.Fatalf("expected a Note, got %T", actual)
+	}
+	if id := note.GetJSONLDId(); id == nil || id.Get().String() != "https://example.com/note/1" {
+		t.Errorf("expected the id to be deserialized")
+	}
+	if content := note.GetActivityStreamsContent(); content == nil || content.Len() != 1 || content.At(0).GetXMLSchemaString() != "bonjour" {
+		t.Errorf("expected the content to be deserialized")
+	}
+	if to := note.GetActivityStreamsTo(); to == nil || to.Len() != 1 || to.At(0).GetIRI().String() != "https://www.w3.org/ns/activitystreams#Public" {
+		t.Errorf("expected the to to be deserialized")
+	}
+}
+
+func TestUnknownProperties(t *testing.T) {
+	const input = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "content": "hello",
+  "sensitive": true,
+  "_misskey_content": "hello"
+}`
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatalf("Cannot json.Unmarshal: %v", err)
+	}
+	actual, err := ToType(context.Background(), m)
+	if err != nil {
+		t.Fatalf("ToType returned error: %v", err)
+	}
+	if v, ok := actual.GetUnknownProperty("sensitive"); !ok || v != true {
+		t.Errorf("expected sensitive to be true, got %v", v)
+	}
+	if _, ok := actual.GetUnknownProperty("content"); ok {
+		t.Errorf("expected content to be a known property")
+	}
+	actual.SetUnknownProperty("sensitive", false)
+	actual.SetUnknownProperty("quoteUrl", "https://example.com/note/2")
+	actual.DeleteUnknownProperty("_misskey_content")
+	var names []string
+	actual.RangeUnknownProperties(func(name string, v interface{}) bool {
+		names = append(names, name)
+		return true
+	})
+	if diff := deep.Equal(names, []string{"quoteUrl", "sensitive"}); diff != nil {
+		t.Errorf("RangeUnknownProperties: %v", diff)
+	}
+	names = nil
+	actual.RangeUnknownProperties(func(name string, v interface{}) bool {
+		names = append(names, name)
+		return false
+	})
+	if len(names) != 1 {
+		t.Errorf("expected RangeUnknownProperties to stop, got %v", names)
+	}
+	// A property known to the type is not overwritten.
+	actual.SetUnknownProperty("content", "overwritten")
+	s, err := Serialize(actual)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %v", err)
+	}
+	expected := map[string]interface{}{
+		"@context":  "https://www.w3.org/ns/activitystreams",
+		"type":      "Note",
+		"content":   "hello",
+		"sensitive": false,
+		"quoteUrl":  "https://example.com/note/2",
+	}
+	if diff := deep.Equal(s, expected); diff != nil {
+		t.Errorf("Serialize: %v", diff)
+	}
+	// Types created by the constructors have unknown properties too.
+	note := NewActivityStreamsNote()
+	note.SetUnknownProperty("sensitive", true)
+	if v, ok := note.GetUnknownProperty("sensitive"); !ok || v != true {
+		t.Errorf("expected sensitive to be true, got %v", v)
+	}
+}
+
+const cloneTestInput = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Create",
+  "id": "https://example.com/sally/create/1",
+  "actor": "https://example.com/sally",
+  "to": ["https://www.w3.org/ns/activitystreams#Public", "https://example.com/john"],
+  "published": "2020-01-02T03:04:05Z",
+  "object": {
+    "type": "Note",
+    "id": "https://example.com/sally/note/1",
+    "contentMap": {"en": "hello", "fr": "bonjour"},
+    "tag": [{"type": "Mention", "href": "https://example.com/john", "name": "@john"}],
+    "sensitive": true,
+    "_misskey_reaction": {"name": ":heart:", "urls": ["https://example.com/heart.png"]}
+  }
+}`
+
+// mustCloneTestCreate returns the Create of cloneTestInput.
+func mustCloneTestCreate(tb testing.TB) vocab.ActivityStreamsCreate {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(cloneTestInput), &m); err != nil {
+		tb.Fatalf("Cannot json.Unmarshal: %v", err)
+	}
+	actual, err := ToType(context.Background(), m)
+	if err != nil {
+		tb.Fatalf("ToType returned error: %v", err)
+	}
+	create, ok := actual.(vocab.ActivityStreamsCreate)
+	if !ok {
+		tb.Fatalf("expected a Create, got %T", actual)
+	}
+	return create
+}
+
+func TestClone(t *testing.T) {
+	create := mustCloneTestCreate(t)
+	expected, err := Serialize(create)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %v", err)
+	}
+	clone := create.Clone()
+	actual, err := Serialize(clone)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %v", err)
+	}
+	if diff := deep.Equal(actual, expected); diff != nil {
+		t.Fatalf("Serialize of the clone: %v", diff)
+	}
+	// Modifying the clone does not modify the original.
+	clone.GetActivityStreamsActor().At(0).GetIRI().Path = "/john"
+	clone.GetActivityStreamsTo().AppendIRI(MustParseURL("https://example.com/jane"))
+	clone.GetActivityStreamsPublished().Set(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	note := clone.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+	note.GetActivityStreamsContent().At(0).SetLanguage("de", "hallo")
+	note.GetActivityStreamsTag().At(0).GetActivityStreamsMention().GetActivityStreamsName().At(0).SetXMLSchemaString("@jane")
+	note.SetUnknownProperty("sensitive", false)
+	reaction, _ := note.GetUnknownProperty("_misskey_reaction")
+	reaction.(map[string]interface{})["urls"].([]interface{})[0] = "https://example.com/star.png"
+	after, err := Serialize(create)
+	if err != nil {
+		t.Fatalf("Serialize returned error: %v", err)
+	}
+	if diff := deep.Equal(after, expected); diff != nil {
+		t.Errorf("Serialize of the original after modifying the clone: %v", diff)
+	}
+	// Iterators of the clone belong to the cloned property.
+	to := clone.GetActivityStreamsTo()
+	if next := to.At(0).Next(); next == nil || next.GetIRI().String() != "https://example.com/john" {
+		t.Errorf("expected the next iterator of the clone to be john, got %v", next)
+	}
+	if to.At(1).Next() != to.At(2) {
+		t.Errorf("expected the iterators of the clone to iterate over the clone")
+	}
+}
+
+// BenchmarkClone compares Clone to copying by serializing the type and
+// deserializing it again.
+func BenchmarkClone(b *testing.B) {
+	create := mustCloneTestCreate(b)
+	b.Run("Clone", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			create.Clone()
+		}
+	})
+	b.Run("SerializeToType", func(b *testing.B) {
+		ctx := context.Background()
+		for i := 0; i < b.N; i++ {
+			m, err := Serialize(create)
+			if err != nil {
+				b.Fatalf("Serialize returned error: %v", err)
+			}
+			if _, err := ToType(ctx, m); err != nil {
+				b.Fatalf("ToType returned error: %v", err)
+			}
+		}
+	})
+}
+
+func TestEquals(t *testing.T) {
+	create := mustCloneTestCreate(t)
+	if !create.Equals(mustCloneTestCreate(t)) {
+		t.Errorf("expected values deserialized from the same document to be equal")
+	}
+	if !create.Equals(create.Clone()) {
+		t.Errorf("expected the clone to be equal")
+	}
+	if create.Equals(nil) {
+		t.Errorf("expected nil to not be equal")
+	}
+	tables := []struct {
+		name   string
+		modify func(c vocab.ActivityStreamsCreate)
+	}{
+		{
+			name: "IRI",
+			modify: func(c vocab.ActivityStreamsCreate) {
+				c.GetActivityStreamsActor().At(0).SetIRI(MustParseURL("https://example.com/john"))
+			},
+		},
+		{
+			name: "Appended value",
+			modify: func(c vocab.ActivityStreamsCreate) {
+				c.GetActivityStreamsTo().AppendIRI(MustParseURL("https://example.com/jane"))
+			},
+		},
+		{
+			name: "Value",
+			modify: func(c vocab.ActivityStreamsCreate) {
+				c.GetActivityStreamsPublished().Set(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		{
+			name: "Removed property",
+			modify: func(c vocab.ActivityStreamsCreate) {
+				c.SetActivityStreamsPublished(nil)
+			},
+		},
+		{
+			name: "Language map of a nested type",
+			modify: func(c vocab.ActivityStreamsCreate) {
+				note := c.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+				note.GetActivityStreamsContent().At(0).SetLanguage("de", "hallo")
+			},
+		},
+		{
+			name: "Unknown property",
+			modify: func(c vocab.ActivityStreamsCreate) {
+				note := c.GetActivityStreamsObject().At(0).GetActivityStreamsNote()
+				note.SetUnknownProperty("sensitive", false)
+			},
+		},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			c := create.Clone()
+			r.modify(c)
+			if create.Equals(c) || c.Equals(create) {
+				t.Errorf("expected the modified value to not be equal")
+			}
+		})
+	}
+	t.Run("Numbers of unknown properties", func(t *testing.T) {
+		c := create.Clone()
+		c.SetUnknownProperty("votes", 3)
+		o := create.Clone()
+		o.SetUnknownProperty("votes", float64(3))
+		if !c.Equals(o) {
+			t.Errorf("expected numbers of different Go types to be equal")
+		}
+	})
+	t.Run("Context", func(t *testing.T) {
+		c := create.Clone()
+		c.DeleteUnknownProperty("@context")
+		if !c.Equals(create) {
+			t.Errorf("expected the @context to be ignored")
+		}
+	})
+}
+
+func TestDiff(t *testing.T) {
+	create := mustCloneTestCreate(t)
+	d, err := Diff(create, create.Clone())
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+	if !d.Empty() {
+		t.Errorf("expected no difference, got %v", d)
+	}
+	c := create.Clone()
+	c.SetActivityStreamsPublished(nil)
+	c.GetActivityStreamsActor().At(0).SetIRI(MustParseURL("https://example.com/john"))
+	c.SetUnknownProperty("sensitive", true)
+	summary := NewActivityStreamsSummaryProperty()
+	summary.AppendXMLSchemaString("hello")
+	c.SetActivityStreamsSummary(summary)
+	d, err = Diff(create, c)
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+	expected := TypeDiff{
+		Added: []PropertyDiff{
+			{Name: "sensitive", New: true},
+			{Name: "summary", New: "hello"},
+		},
+		Removed: []PropertyDiff{
+			{Name: "published", Old: "2020-01-02T03:04:05Z"},
+		},
+		Changed: []PropertyDiff{
+			{Name: "actor", Old: "https://example.com/sally", New: "https://example.com/john"},
+		},
+	}
+	if diff := deep.Equal(d, expected); diff != nil {
+		t.Errorf("%v", diff)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := mustCloneTestCreate(t).Validate(); err != nil {
+		t.Errorf("expected a valid Create, got %v", err)
+	}
+	tables := []struct {
+		name string
+		doc  string
+	}{
+		{
+			name: "Missing required object",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Follow",
+  "actor": "https://example.com/sally"
+}`,
+		},
+		{
+			name: "Missing required object of an Add",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Add",
+  "target": "https://example.com/collection"
+}`,
+		},
+		{
+			name: "Missing formerType of a Tombstone",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Tombstone"
+}`,
+		},
+		{
+			name: "Question with anyOf and oneOf",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Question",
+  "anyOf": [{"type": "Note", "name": "Yes"}],
+  "oneOf": [{"type": "Note", "name": "No"}]
+}`,
+		},
+		{
+			name: "Relative IRI",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "attributedTo": "/sally"
+}`,
+		},
+		{
+			name: "Out of range value",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Collection",
+  "totalItems": -1
+}`,
+		},
+		{
+			name: "Invalid nested type",
+			doc: `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Create",
+  "object": {"type": "Follow"}
+}`,
+		},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			var m map[string]interface{}
+			if err := json.Unmarshal([]byte(r.doc), &m); err != nil {
+				t.Fatal(err)
+			}
+			v, err := ToType(context.Background(), m)
+			if err != nil {
+				t.Fatalf("ToType returned error: %v", err)
+			}
+			if err := v.Validate(); err == nil {
+				t.Errorf("expected a validation error")
+			}
+		})
+	}
+	t.Run("Add without a target", func(t *testing.T) {
+		// The target of an Add or Remove may be implied by the context.
+		var m map[string]interface{}
+		if err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Add",
+  "object": "https://example.com/note"
+}`), &m); err != nil {
+			t.Fatal(err)
+		}
+		v, err := ToType(context.Background(), m)
+		if err != nil {
+			t.Fatalf("ToType returned error: %v", err)
+		}
+		if err := v.Validate(); err != nil {
+			t.Errorf("expected no validation error, got %v", err)
+		}
+	})
+	t.Run("Out of range value set by the application", func(t *testing.T) {
+		c := NewActivityStreamsCollection()
+		total := NewActivityStreamsTotalItemsProperty()
+		total.Set(-1)
+		c.SetActivityStreamsTotalItems(total)
+		if err := c.Validate(); err == nil {
+			t.Errorf("expected a validation error")
+		}
+	})
+}
+
+func TestCanonicalize(t *testing.T) {
+	tables := []struct {
+		name     string
+		in       interface{}
+		expected string
+	}{
+		{
+			name:     "Sorts by UTF-16 code units",
+			in:       map[string]interface{}{"\u20ac": 1, "\r": 2, "\ufb33": 3, "1": 4, "\U0001f600": 5, "\u0080": 6, "\u00f6": 7},
+			expected: "{\"\\r\":2,\"1\":4,\"\u0080\":6,\"\u00f6\":7,\"\u20ac\":1,\"\U0001f600\":5,\"\ufb33\":3}",
+		},
+		{
+			name:     "Escapes strings minimally",
+			in:       []interface{}{"\"\\\b\f\n\r\t\u001f</script>\u2028"},
+			expected: "[\"\\\"\\\\\\b\\f\\n\\r\\t\\u001f</script>\u2028\"]",
+		},
+		{
+			name:     "Formats numbers as ECMAScript",
+			in:       []interface{}{0, math.Copysign(0, -1), 1e21, 1e20, 0.000001, 1e-7, 333333333.3333332, 5e-324, -1.5, json.Number("10")},
+			expected: "[0,0,1e+21,100000000000000000000,0.000001,1e-7,333333333.3333332,5e-324,-1.5,10]",
+		},
+		{
+			name:     "Encodes other types as JSON",
+			in:       map[string]interface{}{"b": map[string]string{"z": "1", "a": "2"}, "a": []string{"x"}, "c": nil, "d": true},
+			expected: `{"a":["x"],"b":{"a":"2","z":"1"},"c":null,"d":true}`,
+		},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			b, err := Canonicalize(r.in)
+			if err != nil {
+				t.Fatalf("Canonicalize returned error: %v", err)
+			}
+			if string(b) != r.expected {
+				t.Errorf("expected %s, got %s", r.expected, b)
+			}
+		})
+	}
+	for _, in := range []interface{}{math.NaN(), math.Inf(1), "\xff"} {
+		if _, err := Canonicalize(in); err == nil {
+			t.Errorf("expected an error for %q", in)
+		}
+	}
+}
+
+func TestSerializeCanonical(t *testing.T) {
+	person := NewActivityStreamsPerson()
+	name := NewActivityStreamsNameProperty()
+	name.AppendXMLSchemaString("Sally")
+	person.SetActivityStreamsName(name)
+	key := NewW3IDSecurityV1PublicKey()
+	pem := NewW3IDSecurityV1PublicKeyPemProperty()
+	pem.Set("-----BEGIN PUBLIC KEY-----")
+	key.SetW3IDSecurityV1PublicKeyPem(pem)
+	pk := NewW3IDSecurityV1PublicKeyProperty()
+	pk.AppendW3IDSecurityV1PublicKey(key)
+	person.SetW3IDSecurityV1PublicKey(pk)
+	expected := `{"@context":["https://www.w3.org/ns/activitystreams","https://w3id.org/security/v1"],"name":"Sally","publicKey":{"publicKeyPem":"-----BEGIN PUBLIC KEY-----"},"type":"Person"}`
+	// Maps are iterated in a random order, so serialize several times.
+	for i := 0; i < 20; i++ {
+		b, err := SerializeCanonical(person)
+		if err != nil {
+			t.Fatalf("SerializeCanonical returned error: %v", err)
+		}
+		if string(b) != expected {
+			t.Fatalf("expected %s, got %s", expected, b)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tables := []struct {
+		header   string
+		expected []string
+	}{
+		{"", []string{}},
+		{"fr", []string{"fr"}},
+		{"fr-CH, fr;q=0.9, en;q=0.8, de;q=0.7, *;q=0.5", []string{"fr-CH", "fr", "en", "de", "*"}},
+		{"en;q=0.5, de, ja;q=0", []string{"de", "en"}},
+		{"en;q=bad, de;q=0.1", []string{"de"}},
+	}
+	for _, r := range tables {
+		if diff := deep.Equal(ParseAcceptLanguage(r.header), r.expected); diff != nil {
+			t.Errorf("%q: %v", r.header, diff)
+		}
+	}
+}
+
+func TestBestLanguage(t *testing.T) {
+	m := map[string]string{
+		"en":         "color",
+		"en-GB":      "colour",
+		"zh-Hant":    "顏色",
+		"fr-CA":      "couleur",
+		"und":        "?",
+		"sr-Latn-RS": "boja",
+	}
+	tables := []struct {
+		name     string
+		ranges   []string
+		expected string
+	}{
+		{"Exact", []string{"en-GB"}, "colour"},
+		{"Case insensitive", []string{"EN-gb"}, "colour"},
+		{"Truncated", []string{"en-US"}, "color"},
+		{"Truncated script and region", []string{"zh-Hant-TW"}, "顏色"},
+		{"More specific", []string{"fr"}, "couleur"},
+		{"More specific after truncating", []string{"sr-Latn-BA"}, "boja"},
+		{"Order of preference", []string{"de", "fr-FR", "en"}, "couleur"},
+		{"Private use", []string{"en-GB-x-oed"}, "colour"},
+		{"Undetermined", []string{"de"}, "?"},
+		{"Wildcard", []string{"*", "en"}, "?"},
+		{"No ranges", nil, "?"},
+	}
+	for _, r := range tables {
+		r := r // shadow loop variable
+		t.Run(r.name, func(t *testing.T) {
+			v, _, ok := BestLanguage(m, r.ranges...)
+			if !ok || v != r.expected {
+				t.Errorf("expected %q, got %q (%v)", r.expected, v, ok)
+			}
+		})
+	}
+	t.Run("First tag without undetermined", func(t *testing.T) {
+		v, tag, ok := BestLanguage(map[string]string{"fr": "couleur", "de": "Farbe"}, "ja")
+		if !ok || v != "Farbe" || tag != "de" {
+			t.Errorf("expected de Farbe, got %s %q (%v)", tag, v, ok)
+		}
+	})
+	t.Run("Empty", func(t *testing.T) {
+		if _, _, ok := BestLanguage(nil, "en"); ok {
+			t.Errorf("expected no value")
+		}
+	})
+}
+
+func TestLanguageMaps(t *testing.T) {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "Note",
+  "contentMap": {"en": "hello", "fr": "bonjour"},
+  "summary": "greeting",
+  "nameMap": {"de": "Hallo"}
+}`), &m); err != nil {
+		t.Fatal(err)
+	}
+	v, err := ToType(context.Background(), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	note := v.(vocab.ActivityStreamsNote)
+	expected := map[string]string{"en": "hello", "fr": "bonjour"}
+	if diff := deep.Equal(ContentMap(note.GetActivityStreamsContent()), expected); diff != nil {
+		t.Errorf("ContentMap: %v", diff)
+	}
+	if s, ok := BestContent(note.GetActivityStreamsContent(), ParseAcceptLanguage("fr-CA, en;q=0.5")...); !ok || s != "bonjour" {
+		t.Errorf("expected bonjour, got %q", s)
+	}
+	if s, ok := BestName(note.GetActivityStreamsName(), "en"); !ok || s != "Hallo" {
+		t.Errorf("expected Hallo, got %q", s)
+	}
+	if diff := deep.Equal(SummaryMap(note.GetActivityStreamsSummary()), map[string]string{"und": "greeting"}); diff != nil {
+		t.Errorf("SummaryMap: %v", diff)
+	}
+	if _, ok := BestContent(nil, "en"); ok {
+		t.Errorf("expected no content")
+	}
+	SetContentMap(note.GetActivityStreamsContent(), map[string]string{"en": "hi", "und": "hi?"})
+	SetSummaryMap(note.GetActivityStreamsSummary(), map[string]string{"und": "greeting"})
+	out, err := Serialize(note)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(out["contentMap"], map[string]string{"en": "hi"}); diff != nil {
+		t.Errorf("contentMap: %v", diff)
+	}
+	if out["content"] != "hi?" {
+		t.Errorf("expected content hi?, got %v", out["content"])
+	}
+	if out["summary"] != "greeting" {
+		t.Errorf("expected summary greeting, got %v", out["summary"])
+	}
+	if _, ok := out["summaryMap"]; ok {
+		t.Errorf("expected no summaryMap, got %v", out["summaryMap"])
+	}
+	// Both the plain value and the map survive a round trip.
+	b, err := json.Marshal(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rm map[string]interface{}
+	if err = json.Unmarshal(b, &rm); err != nil {
+		t.Fatal(err)
+	}
+	if v, err = ToType(context.Background(), rm); err != nil {
+		t.Fatal(err)
+	}
+	note = v.(vocab.ActivityStreamsNote)
+	if diff := deep.Equal(ContentMap(note.GetActivityStreamsContent()), map[string]string{"en": "hi", "und": "hi?"}); diff != nil {
+		t.Errorf("ContentMap after round trip: %v", diff)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	var events []string
+	record := Walker{
+		Pre: func(p Path, v vocab.Type) error {
+			events = append(events, "pre "+p.String()+" "+v.GetTypeName())
+			return nil
+		},
+		Post: func(p Path, v vocab.Type) error {
+			events = append(events, "post "+p.String())
+			return nil
+		},
+		IRI: func(p Path, u *url.URL) error {
+			events = append(events, "iri "+p.String()+" "+u.String())
+			return nil
+		},
+		Literal: func(p Path, v interface{}) error {
+			if s, ok := v.(string); ok {
+				events = append(events, "literal "+p.String()+" "+s)
+			} else {
+				events = append(events, "literal "+p.String())
+			}
+			return nil
+		},
+	}
+	t.Run("Visits every value", func(t *testing.T) {
+		events = nil
+		if err := Walk(mustCloneTestCreate(t), record); err != nil {
+			t.Fatalf("Walk returned error: %v", err)
+		}
+		expected := []string{
+			"pre  Create",
+			"iri actor[0] https://example.com/sally",
+			"iri id[0] https://example.com/sally/create/1",
+			"pre object[0] Note",
+			"literal object[0].content[0]",
+			"iri object[0].id[0] https://example.com/sally/note/1",
+			"pre object[0].tag[0] Mention",
+			"iri object[0].tag[0].href[0] https://example.com/john",
+			"literal object[0].tag[0].name[0] @john",
+			"literal object[0].tag[0].type[0] Mention",
+			"post object[0].tag[0]",
+			"literal object[0].type[0] Note",
+			"literal object[0]._misskey_reaction[0]",
+			"literal object[0].sensitive[0]",
+			"post object[0]",
+			"literal published[0]",
+			"iri to[0] https://www.w3.org/ns/activitystreams#Public",
+			"iri to[1] https://example.com/john",
+			"literal type[0] Create",
+			"post ",
+		}
+		if diff := deep.Equal(events, expected); diff != nil {
+			t.Errorf("%v", diff)
+		}
+	})
+	t.Run("Skips children", func(t *testing.T) {
+		events = nil
+		w := record
+		w.Pre = func(p Path, v vocab.Type) error {
+			events = append(events, "pre "+p.String()+" "+v.GetTypeName())
+			if _, ok := p.Last(); ok {
+				return SkipChildren
+			}
+			return nil
+		}
+		w.IRI = nil
+		w.Literal = nil
+		if err := Walk(mustCloneTestCreate(t), w); err != nil {
+			t.Fatalf("Walk returned error: %v", err)
+		}
+		expected := []string{
+			"pre  Create",
+			"pre object[0] Note",
+			"post ",
+		}
+		if diff := deep.Equal(events, expected); diff != nil {
+			t.Errorf("%v", diff)
+		}
+	})
+	t.Run("Stops at error", func(t *testing.T) {
+		events = nil
+		stop := errors.New("stop")
+		w := record
+		w.IRI = func(p Path, u *url.URL) error {
+			if e, _ := p.Last(); e.Property == "href" {
+				return stop
+			}
+			return nil
+		}
+		if err := Walk(mustCloneTestCreate(t), w); err != stop {
+			t.Fatalf("expected the error of the Walker, got %v", err)
+		}
+		expected := []string{
+			"pre  Create",
+			"pre object[0] Note",
+			"literal object[0].content[0]",
+			"pre object[0].tag[0] Mention",
+		}
+		if diff := deep.Equal(events, expected); diff != nil {
+			t.Errorf("%v", diff)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package streams
 
 import (
 	"github.com/go-fed/activity/streams/vocab"
+	"sort"
 )
 
 const (
@@ -10,6 +11,9 @@ const (
 	// rest of the payload. Important for linked-data representations, but
 	// only applicable to go-fed at code-generation time.
 	jsonLDContext = "@context"
+	// activityStreamsVocabulary is the URI of the ActivityStreams
+	// vocabulary, which comes first in a serialized @context.
+	activityStreamsVocabulary = "https://www.w3.org/ns/activitystreams"
 )
 
 // Serialize adds the context vocabularies contained within the type
@@ -35,14 +39,26 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 		}
 	} else {
 		var arr []interface{}
+		var vocabs []string
 		aliases := make(map[string]string)
 		for vocab, alias := range v {
 			if len(alias) == 0 {
-				arr = append(arr, vocab)
+				vocabs = append(vocabs, vocab)
 			} else {
 				aliases[alias] = vocab
 			}
 		}
+		// Order the vocabularies so the same value always has the same
+		// context, with ActivityStreams first as is customary.
+		sort.Slice(vocabs, func(i, j int) bool {
+			if (vocabs[i] == activityStreamsVocabulary) != (vocabs[j] == activityStreamsVocabulary) {
+				return vocabs[i] == activityStreamsVocabulary
+			}
+			return vocabs[i] < vocabs[j]
+		})
+		for _, vocab := range vocabs {
+			arr = append(arr, vocab)
+		}
 		if len(aliases) > 0 {
 			arr = append(arr, aliases)
 		}
@@ -50,7 +66,6 @@ func Serialize(a vocab.Type) (m map[string]interface{}, e error) {
 	}
 	// TODO: Update the context instead if it already exists
 	m[jsonLDContext] = contextValue
-	// TODO: Sort the context based on arbitrary order.
 	// Delete any existing `@context` in child maps.
 	var cleanFnRecur func(map[string]interface{})
 	cleanFnRecur = func(r map[string]interface{}) {
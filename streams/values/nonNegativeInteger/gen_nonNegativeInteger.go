@@ -31,3 +31,12 @@ func DeserializeNonNegativeInteger(this interface{}) (int, error) {
 func LessNonNegativeInteger(lhs, rhs int) bool {
 	return lhs < rhs
 }
+
+// ValidateNonNegativeInteger returns an error if the nonNegativeInteger value is
+// out of its range.
+func ValidateNonNegativeInteger(this int) error {
+	if this < 0 {
+		return fmt.Errorf("%d is a negative integer for xsd:nonNegativeInteger", this)
+	}
+	return nil
+}
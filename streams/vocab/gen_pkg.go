@@ -4,20 +4,36 @@ package vocab
 
 // Type represents an ActivityStreams type.
 type Type interface {
+	// DeleteUnknownProperty removes the unknown property with the name.
+	DeleteUnknownProperty(name string)
 	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 	GetJSONLDId() JSONLDIdProperty
 	// GetTypeName returns the ActivityStreams type name.
 	GetTypeName() string
+	// GetUnknownProperty returns the value of the unknown property with the
+	// name, and whether it is set.
+	GetUnknownProperty(name string) (interface{}, bool)
 	// JSONLDContext returns the JSONLD URIs required in the context string
 	// for this property and the specific values that are set. The value
 	// in the map is the alias used to import the property's value or
 	// values.
 	JSONLDContext() map[string]string
+	// RangeProperties calls the function with the name and values of each
+	// property that has a value, in the order of their names, until it
+	// returns false.
+	RangeProperties(fn func(name string, values []interface{}) bool)
+	// RangeUnknownProperties calls the function with each unknown property in
+	// the order of their names, until it returns false.
+	RangeUnknownProperties(fn func(name string, v interface{}) bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
 	// SetJSONLDId sets the "id" property.
 	SetJSONLDId(JSONLDIdProperty)
+	// SetUnknownProperty sets the unknown property with the name.
+	SetUnknownProperty(name string, v interface{})
+	// Validate returns an error if the value violates its vocabulary.
+	Validate() error
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
@@ -7,6 +7,10 @@ import "net/url"
 // ActivityStreamsActorPropertyIterator represents a single value for the "actor"
 // property.
 type ActivityStreamsActorPropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsActorPropertyIterator) bool
 	// GetActivityStreamsAccept returns the value of this property. When
 	// IsActivityStreamsAccept returns false, GetActivityStreamsAccept
 	// will return an arbitrary value.
@@ -660,6 +664,15 @@ type ActivityStreamsActorPropertyIterator interface {
 	// SetType attempts to set the property for the arbitrary type. Returns an
 	// error if it is not a valid type to set on this property.
 	SetType(t Type) error
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // Describes one or more entities that either performed or are expected to perform
@@ -932,12 +945,18 @@ type ActivityStreamsActorProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsActorPropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsActorProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsActorPropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsActorProperty) bool
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "actor". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
@@ -1602,4 +1621,10 @@ type ActivityStreamsActorProperty interface {
 	// Swap swaps the location of values at two indices for the "actor"
 	// property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
@@ -10,6 +10,10 @@ import (
 // ActivityStreamsClosedPropertyIterator represents a single value for the
 // "closed" property.
 type ActivityStreamsClosedPropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsClosedPropertyIterator) bool
 	// GetActivityStreamsAccept returns the value of this property. When
 	// IsActivityStreamsAccept returns false, GetActivityStreamsAccept
 	// will return an arbitrary value.
@@ -685,6 +689,15 @@ type ActivityStreamsClosedPropertyIterator interface {
 	// SetXMLSchemaDateTime sets the value of this property. Calling
 	// IsXMLSchemaDateTime afterwards returns true.
 	SetXMLSchemaDateTime(v time.Time)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // Indicates that a question has been closed, and answers are no longer accepted.
@@ -935,12 +948,18 @@ type ActivityStreamsClosedProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsClosedPropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsClosedProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsClosedPropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsClosedProperty) bool
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "closed". Existing elements at that index and
 	// higher are shifted back once. Invalidates all iterators.
@@ -1627,4 +1646,10 @@ type ActivityStreamsClosedProperty interface {
 	// Swap swaps the location of values at two indices for the "closed"
 	// property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
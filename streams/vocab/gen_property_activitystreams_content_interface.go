@@ -7,6 +7,10 @@ import "net/url"
 // ActivityStreamsContentPropertyIterator represents a single value for the
 // "content" property.
 type ActivityStreamsContentPropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsContentPropertyIterator) bool
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -81,6 +85,15 @@ type ActivityStreamsContentPropertyIterator interface {
 	// natural language map. Calling IsXMLSchemaString afterwards will
 	// return true. Calling IsRDFLangString afterwards returns false.
 	SetXMLSchemaString(v string)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // The content or textual representation of the Object encoded as a JSON string.
@@ -132,12 +145,18 @@ type ActivityStreamsContentProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsContentPropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsContentProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsContentPropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsContentProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "content". Existing elements at that index and higher are shifted
 	// back once. Invalidates all iterators.
@@ -191,6 +210,14 @@ type ActivityStreamsContentProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SerializeMap converts the langString value into an interface
+	// representation to serialize as the "contentMap" property, alongside
+	// the other values serialized as the "content" property. It returns
+	// nil if there is no such value, in which case Serialize serializes
+	// all of the values. Applications should not need this function as
+	// most typical use cases serialize types instead of individual
+	// properties.
+	SerializeMap() (interface{}, error)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "content". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
@@ -205,4 +232,10 @@ type ActivityStreamsContentProperty interface {
 	// Swap swaps the location of values at two indices for the "content"
 	// property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
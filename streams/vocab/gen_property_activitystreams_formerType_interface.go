@@ -7,6 +7,10 @@ import "net/url"
 // ActivityStreamsFormerTypePropertyIterator represents a single value for the
 // "formerType" property.
 type ActivityStreamsFormerTypePropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsFormerTypePropertyIterator) bool
 	// GetActivityStreamsAccept returns the value of this property. When
 	// IsActivityStreamsAccept returns false, GetActivityStreamsAccept
 	// will return an arbitrary value.
@@ -649,6 +653,15 @@ type ActivityStreamsFormerTypePropertyIterator interface {
 	// SetXMLSchemaString sets the value of this property. Calling
 	// IsXMLSchemaString afterwards returns true.
 	SetXMLSchemaString(v string)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // On a Tombstone object, the formerType property identifies the type of the
@@ -889,12 +902,18 @@ type ActivityStreamsFormerTypeProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsFormerTypePropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsFormerTypeProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsFormerTypePropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsFormerTypeProperty) bool
 	// InsertActivityStreamsAccept inserts a Accept value at the specified
 	// index for a property "formerType". Existing elements at that index
 	// and higher are shifted back once. Invalidates all iterators.
@@ -1558,4 +1577,10 @@ type ActivityStreamsFormerTypeProperty interface {
 	// Swap swaps the location of values at two indices for the "formerType"
 	// property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
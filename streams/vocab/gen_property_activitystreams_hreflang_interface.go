@@ -19,6 +19,13 @@ type ActivityStreamsHreflangProperty interface {
 	// Clear ensures no value of this property is set. Calling IsRFCBcp47
 	// afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Its values, IRI, and types are copied.
+	Clone() ActivityStreamsHreflangProperty
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsHreflangProperty) bool
 	// Get returns the value of this property. When IsRFCBcp47 returns false,
 	// Get will return any arbitrary value.
 	Get() string
@@ -59,4 +66,13 @@ type ActivityStreamsHreflangProperty interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards will
 	// return true.
 	SetIRI(v *url.URL)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
@@ -7,6 +7,10 @@ import "net/url"
 // ActivityStreamsNamePropertyIterator represents a single value for the "name"
 // property.
 type ActivityStreamsNamePropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsNamePropertyIterator) bool
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -81,6 +85,15 @@ type ActivityStreamsNamePropertyIterator interface {
 	// natural language map. Calling IsXMLSchemaString afterwards will
 	// return true. Calling IsRDFLangString afterwards returns false.
 	SetXMLSchemaString(v string)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // A simple, human-readable, plain-text name for the object. HTML markup MUST NOT
@@ -121,12 +134,18 @@ type ActivityStreamsNameProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsNamePropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsNameProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsNamePropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsNameProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "name". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
@@ -180,6 +199,13 @@ type ActivityStreamsNameProperty interface {
 	// instead of individual properties. It is exposed for alternatives to
 	// go-fed implementations to use.
 	Serialize() (interface{}, error)
+	// SerializeMap converts the langString value into an interface
+	// representation to serialize as the "nameMap" property, alongside
+	// the other values serialized as the "name" property. It returns nil
+	// if there is no such value, in which case Serialize serializes all
+	// of the values. Applications should not need this function as most
+	// typical use cases serialize types instead of individual properties.
+	SerializeMap() (interface{}, error)
 	// SetIRI sets an IRI value to be at the specified index for the property
 	// "name". Panics if the index is out of bounds.
 	SetIRI(idx int, v *url.URL)
@@ -194,4 +220,10 @@ type ActivityStreamsNameProperty interface {
 	// Swap swaps the location of values at two indices for the "name"
 	// property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
@@ -7,6 +7,10 @@ import "net/url"
 // ActivityStreamsRelPropertyIterator represents a single value for the "rel"
 // property.
 type ActivityStreamsRelPropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsRelPropertyIterator) bool
 	// Get returns the value of this property. When IsRFCRfc5988 returns
 	// false, Get will return any arbitrary value.
 	Get() string
@@ -46,6 +50,15 @@ type ActivityStreamsRelPropertyIterator interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards will
 	// return true.
 	SetIRI(v *url.URL)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // A link relation associated with a Link. The value MUST conform to both the
@@ -81,12 +94,18 @@ type ActivityStreamsRelProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsRelPropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsRelProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsRelPropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsRelProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "rel". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
@@ -142,4 +161,10 @@ type ActivityStreamsRelProperty interface {
 	SetIRI(idx int, v *url.URL)
 	// Swap swaps the location of values at two indices for the "rel" property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
@@ -7,6 +7,10 @@ import "net/url"
 // ActivityStreamsUrlPropertyIterator represents a single value for the "url"
 // property.
 type ActivityStreamsUrlPropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o ActivityStreamsUrlPropertyIterator) bool
 	// GetActivityStreamsLink returns the value of this property. When
 	// IsActivityStreamsLink returns false, GetActivityStreamsLink will
 	// return an arbitrary value.
@@ -79,6 +83,15 @@ type ActivityStreamsUrlPropertyIterator interface {
 	// SetXMLSchemaAnyURI sets the value of this property. Calling
 	// IsXMLSchemaAnyURI afterwards returns true.
 	SetXMLSchemaAnyURI(v *url.URL)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // Identifies one or more links to representations of the object
@@ -145,12 +158,18 @@ type ActivityStreamsUrlProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() ActivityStreamsUrlPropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() ActivityStreamsUrlProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() ActivityStreamsUrlPropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o ActivityStreamsUrlProperty) bool
 	// InsertActivityStreamsLink inserts a Link value at the specified index
 	// for a property "url". Existing elements at that index and higher
 	// are shifted back once. Invalidates all iterators.
@@ -241,4 +260,10 @@ type ActivityStreamsUrlProperty interface {
 	SetXMLSchemaAnyURI(idx int, v *url.URL)
 	// Swap swaps the location of values at two indices for the "url" property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
@@ -6,6 +6,10 @@ import "net/url"
 
 // JSONLDTypePropertyIterator represents a single value for the "type" property.
 type JSONLDTypePropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o JSONLDTypePropertyIterator) bool
 	// GetIRI returns the IRI of this property. When IsIRI returns false,
 	// GetIRI will return an arbitrary value.
 	GetIRI() *url.URL
@@ -60,6 +64,15 @@ type JSONLDTypePropertyIterator interface {
 	// SetXMLSchemaString sets the value of this property. Calling
 	// IsXMLSchemaString afterwards returns true.
 	SetXMLSchemaString(v string)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
 
 // Identifies the schema type(s) of the JSON-LD entity.
@@ -82,12 +95,18 @@ type JSONLDTypeProperty interface {
 	// iterator's Next method and this property's End method to iterate
 	// from front to back through all values.
 	Begin() JSONLDTypePropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() JSONLDTypeProperty
 	// Empty returns returns true if there are no elements.
 	Empty() bool
 	// End returns beyond-the-last iterator, which is nil. Can be used with
 	// the iterator's Next method and this property's Begin method to
 	// iterate from front to back through all values.
 	End() JSONLDTypePropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o JSONLDTypeProperty) bool
 	// Insert inserts an IRI value at the specified index for a property
 	// "type". Existing elements at that index and higher are shifted back
 	// once. Invalidates all iterators.
@@ -155,4 +174,10 @@ type JSONLDTypeProperty interface {
 	// Swap swaps the location of values at two indices for the "type"
 	// property.
 	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
 }
@@ -0,0 +1,182 @@
+// Code generated by astool. DO NOT EDIT.
+
+package vocab
+
+import "net/url"
+
+// W3IDMultikeyV1AssertionMethodPropertyIterator represents a single value for the
+// "assertionMethod" property.
+type W3IDMultikeyV1AssertionMethodPropertyIterator interface {
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o W3IDMultikeyV1AssertionMethodPropertyIterator) bool
+	// Get returns the value of this property. When IsW3IDMultikeyV1Multikey
+	// returns false, Get will return any arbitrary value.
+	Get() W3IDMultikeyV1Multikey
+	// GetIRI returns the IRI of this property. When IsIRI returns false,
+	// GetIRI will return any arbitrary value.
+	GetIRI() *url.URL
+	// GetType returns the value in this property as a Type. Returns nil if
+	// the value is not an ActivityStreams type, such as an IRI or another
+	// value.
+	GetType() Type
+	// HasAny returns true if the value or IRI is set.
+	HasAny() bool
+	// IsIRI returns true if this property is an IRI.
+	IsIRI() bool
+	// IsW3IDMultikeyV1Multikey returns true if this property is set and not
+	// an IRI.
+	IsW3IDMultikeyV1Multikey() bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API detail only for folks looking to replace the
+	// go-fed implementation. Applications should not use this method.
+	KindIndex() int
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o W3IDMultikeyV1AssertionMethodPropertyIterator) bool
+	// Name returns the name of this property: "W3IDMultikeyV1AssertionMethod".
+	Name() string
+	// Next returns the next iterator, or nil if there is no next iterator.
+	Next() W3IDMultikeyV1AssertionMethodPropertyIterator
+	// Prev returns the previous iterator, or nil if there is no previous
+	// iterator.
+	Prev() W3IDMultikeyV1AssertionMethodPropertyIterator
+	// Set sets the value of this property. Calling IsW3IDMultikeyV1Multikey
+	// afterwards will return true.
+	Set(v W3IDMultikeyV1Multikey)
+	// SetIRI sets the value of this property. Calling IsIRI afterwards will
+	// return true.
+	SetIRI(v *url.URL)
+	// SetType attempts to set the property for the arbitrary type. Returns an
+	// error if it is not a valid type to set on this property.
+	SetType(t Type) error
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
+}
+
+// The keys an ActivityStreams actor creates proofs with
+type W3IDMultikeyV1AssertionMethodProperty interface {
+	// AppendIRI appends an IRI value to the back of a list of the property
+	// "assertionMethod"
+	AppendIRI(v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "assertionMethod". Invalidates iterators that are
+	// traversing using Prev. Returns an error if the type is not a valid
+	// one to set for this property.
+	AppendType(t Type) error
+	// AppendW3IDMultikeyV1Multikey appends a Multikey value to the back of a
+	// list of the property "assertionMethod". Invalidates iterators that
+	// are traversing using Prev.
+	AppendW3IDMultikeyV1Multikey(v W3IDMultikeyV1Multikey)
+	// At returns the property value for the specified index. Panics if the
+	// index is out of bounds.
+	At(index int) W3IDMultikeyV1AssertionMethodPropertyIterator
+	// Begin returns the first iterator, or nil if empty. Can be used with the
+	// iterator's Next method and this property's End method to iterate
+	// from front to back through all values.
+	Begin() W3IDMultikeyV1AssertionMethodPropertyIterator
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Each of its values is copied.
+	Clone() W3IDMultikeyV1AssertionMethodProperty
+	// Empty returns returns true if there are no elements.
+	Empty() bool
+	// End returns beyond-the-last iterator, which is nil. Can be used with
+	// the iterator's Next method and this property's Begin method to
+	// iterate from front to back through all values.
+	End() W3IDMultikeyV1AssertionMethodPropertyIterator
+	// Equals determines whether this property has the same values as the
+	// other, in the same order.
+	Equals(o W3IDMultikeyV1AssertionMethodProperty) bool
+	// Insert inserts an IRI value at the specified index for a property
+	// "assertionMethod". Existing elements at that index and higher are
+	// shifted back once. Invalidates all iterators.
+	InsertIRI(idx int, v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "assertionMethod". Invalidates all iterators. Returns
+	// an error if the type is not a valid one to set for this property.
+	InsertType(idx int, t Type) error
+	// InsertW3IDMultikeyV1Multikey inserts a Multikey value at the specified
+	// index for a property "assertionMethod". Existing elements at that
+	// index and higher are shifted back once. Invalidates all iterators.
+	InsertW3IDMultikeyV1Multikey(idx int, v W3IDMultikeyV1Multikey)
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of value.
+	// This is a leaky API method specifically needed only for alternate
+	// implementations for go-fed. Applications should not use this
+	// method. Panics if the index is out of bounds.
+	KindIndex(idx int) int
+	// Len returns the number of values that exist for the "assertionMethod"
+	// property.
+	Len() (length int)
+	// Less computes whether another property is less than this one. Mixing
+	// types results in a consistent but arbitrary ordering
+	Less(i, j int) bool
+	// LessThan compares two instances of this property with an arbitrary but
+	// stable comparison. Applications should not use this because it is
+	// only meant to help alternative implementations to go-fed to be able
+	// to normalize nonfunctional properties.
+	LessThan(o W3IDMultikeyV1AssertionMethodProperty) bool
+	// Name returns the name of this property ("assertionMethod") with any
+	// alias.
+	Name() string
+	// PrependIRI prepends an IRI value to the front of a list of the property
+	// "assertionMethod".
+	PrependIRI(v *url.URL)
+	// PrependType prepends an arbitrary type value to the front of a list of
+	// the property "assertionMethod". Invalidates all iterators. Returns
+	// an error if the type is not a valid one to set for this property.
+	PrependType(t Type) error
+	// PrependW3IDMultikeyV1Multikey prepends a Multikey value to the front of
+	// a list of the property "assertionMethod". Invalidates all iterators.
+	PrependW3IDMultikeyV1Multikey(v W3IDMultikeyV1Multikey)
+	// Remove deletes an element at the specified index from a list of the
+	// property "assertionMethod", regardless of its type. Panics if the
+	// index is out of bounds. Invalidates all iterators.
+	Remove(idx int)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format. Applications should not
+	// need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// Set sets a Multikey value to be at the specified index for the property
+	// "assertionMethod". Panics if the index is out of bounds.
+	// Invalidates all iterators.
+	Set(idx int, v W3IDMultikeyV1Multikey)
+	// SetIRI sets an IRI value to be at the specified index for the property
+	// "assertionMethod". Panics if the index is out of bounds.
+	SetIRI(idx int, v *url.URL)
+	// SetType sets an arbitrary type value to the specified index of the
+	// property "assertionMethod". Invalidates all iterators. Returns an
+	// error if the type is not a valid one to set for this property.
+	// Panics if the index is out of bounds.
+	SetType(idx int, t Type) error
+	// Swap swaps the location of values at two indices for the
+	// "assertionMethod" property.
+	Swap(i, j int)
+	// Validate returns an error naming the first value of this property that
+	// is out of its range, or is an IRI that is not absolute.
+	Validate() error
+	// Values returns the values of this property in order, as returned by the
+	// Value method of each of them.
+	Values() []interface{}
+}
@@ -9,6 +9,13 @@ type W3IDSecurityV1PublicKeyPemProperty interface {
 	// Clear ensures no value of this property is set. Calling
 	// IsXMLSchemaString afterwards will return false.
 	Clear()
+	// Clone returns a deep copy of this property, which can be modified
+	// without changing this one. Its values, IRI, and types are copied.
+	Clone() W3IDSecurityV1PublicKeyPemProperty
+	// Equals determines whether this property has the same value as the
+	// other. Types are compared with their Equals method, and IRIs by
+	// their string form.
+	Equals(o W3IDSecurityV1PublicKeyPemProperty) bool
 	// Get returns the value of this property. When IsXMLSchemaString returns
 	// false, Get will return any arbitrary value.
 	Get() string
@@ -49,4 +56,13 @@ type W3IDSecurityV1PublicKeyPemProperty interface {
 	// SetIRI sets the value of this property. Calling IsIRI afterwards will
 	// return true.
 	SetIRI(v *url.URL)
+	// Validate returns an error if the value of this property is out of its
+	// range, or is an IRI that is not absolute. Types are validated with
+	// their Validate method.
+	Validate() error
+	// Value returns the value of this property: a type, a *url.URL for an
+	// IRI, or the value of a literal as returned by its getter. A value
+	// out of the range of the property is returned as it was deserialized
+	// from JSON. It returns nil if there is no value.
+	Value() interface{}
 }
@@ -22,6 +22,17 @@ package vocab
 //     "type": "Activity"
 //   }
 type ActivityStreamsActivity interface {
+	// Clone returns a deep copy of this Activity, which can be modified
+	// without changing this one. Its properties, values, IRIs, and
+	// unknown properties are copied.
+	Clone() ActivityStreamsActivity
+	// DeleteUnknownProperty removes the unknown property with the name.
+	DeleteUnknownProperty(name string)
+	// Equals determines whether this Activity has the same properties,
+	// values, and unknown properties as the other. Values of
+	// non-functional properties are compared in order, and the aliases
+	// used for vocabularies are ignored.
+	Equals(o ActivityStreamsActivity) bool
 	// GetActivityStreamsActor returns the "actor" property if it exists, and
 	// nil otherwise.
 	GetActivityStreamsActor() ActivityStreamsActorProperty
@@ -142,6 +153,17 @@ type ActivityStreamsActivity interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GetUnknownProperty returns the value of the unknown property with the
+	// name, and whether it is set. The value is as it was deserialized
+	// from JSON, such as a string, float64, bool, map[string]interface{}
+	// or []interface{}.
+	GetUnknownProperty(name string) (interface{}, bool)
+	// GetW3IDDataIntegrityV1Proof returns the "proof" property if it exists,
+	// and nil otherwise.
+	GetW3IDDataIntegrityV1Proof() W3IDDataIntegrityV1ProofProperty
+	// GetW3IDSecurityV1Signature returns the "signature" property if it
+	// exists, and nil otherwise.
+	GetW3IDSecurityV1Signature() W3IDSecurityV1SignatureProperty
 	// IsExtending returns true if the Activity type extends from the other
 	// type.
 	IsExtending(other Type) bool
@@ -152,6 +174,16 @@ type ActivityStreamsActivity interface {
 	// LessThan computes if this Activity is lesser, with an arbitrary but
 	// stable determination.
 	LessThan(o ActivityStreamsActivity) bool
+	// RangeProperties calls the function with the name and values of each
+	// property of this Activity that has a value, in the order of their
+	// names, until it returns false. Each value is a type, an IRI, or the
+	// value of a literal, as returned by the Value method of the
+	// property. Unknown properties are not included.
+	RangeProperties(fn func(name string, values []interface{}) bool)
+	// RangeUnknownProperties calls the function with each unknown property in
+	// the order of their names, until it returns false. The @context of
+	// the document the Activity was deserialized from is skipped.
+	RangeUnknownProperties(fn func(name string, v interface{}) bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
@@ -229,6 +261,20 @@ type ActivityStreamsActivity interface {
 	SetJSONLDId(i JSONLDIdProperty)
 	// SetJSONLDType sets the "type" property.
 	SetJSONLDType(i JSONLDTypeProperty)
+	// SetUnknownProperty sets the unknown property with the name, which is
+	// serialized with the value as is. The value must be marshallable
+	// into JSON. It is not serialized if the name is the name of a
+	// property known to the Activity type.
+	SetUnknownProperty(name string, v interface{})
+	// SetW3IDDataIntegrityV1Proof sets the "proof" property.
+	SetW3IDDataIntegrityV1Proof(i W3IDDataIntegrityV1ProofProperty)
+	// SetW3IDSecurityV1Signature sets the "signature" property.
+	SetW3IDSecurityV1Signature(i W3IDSecurityV1SignatureProperty)
+	// Validate returns an error if this Activity violates its vocabulary: if
+	// a value is out of the range of its property, if an IRI is not
+	// absolute, or if a property required by the specification is
+	// missing. The error names the property of the first violation found.
+	Validate() error
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
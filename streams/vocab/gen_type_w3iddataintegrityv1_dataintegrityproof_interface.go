@@ -0,0 +1,106 @@
+// Code generated by astool. DO NOT EDIT.
+
+package vocab
+
+// A Data Integrity proof of a document, whose cryptographic suite is named by the
+// cryptosuite
+type W3IDDataIntegrityV1DataIntegrityProof interface {
+	// Clone returns a deep copy of this DataIntegrityProof, which can be
+	// modified without changing this one. Its properties, values, IRIs,
+	// and unknown properties are copied.
+	Clone() W3IDDataIntegrityV1DataIntegrityProof
+	// DeleteUnknownProperty removes the unknown property with the name.
+	DeleteUnknownProperty(name string)
+	// Equals determines whether this DataIntegrityProof has the same
+	// properties, values, and unknown properties as the other. Values of
+	// non-functional properties are compared in order, and the aliases
+	// used for vocabularies are ignored.
+	Equals(o W3IDDataIntegrityV1DataIntegrityProof) bool
+	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+	GetJSONLDId() JSONLDIdProperty
+	// GetJSONLDType returns the "type" property if it exists, and nil
+	// otherwise.
+	GetJSONLDType() JSONLDTypeProperty
+	// GetTypeName returns the name of this type.
+	GetTypeName() string
+	// GetUnknownProperties returns the unknown properties for the
+	// DataIntegrityProof type. Note that this should not be used by app
+	// developers. It is only used to help determine which implementation
+	// is LessThan the other. Developers who are creating a different
+	// implementation of this type's interface can use this method in
+	// their LessThan implementation, but routine ActivityPub applications
+	// should not use this to bypass the code generation tool.
+	GetUnknownProperties() map[string]interface{}
+	// GetUnknownProperty returns the value of the unknown property with the
+	// name, and whether it is set. The value is as it was deserialized
+	// from JSON, such as a string, float64, bool, map[string]interface{}
+	// or []interface{}.
+	GetUnknownProperty(name string) (interface{}, bool)
+	// GetW3IDDataIntegrityV1Created returns the "created" property if it
+	// exists, and nil otherwise.
+	GetW3IDDataIntegrityV1Created() W3IDDataIntegrityV1CreatedProperty
+	// GetW3IDDataIntegrityV1Cryptosuite returns the "cryptosuite" property if
+	// it exists, and nil otherwise.
+	GetW3IDDataIntegrityV1Cryptosuite() W3IDDataIntegrityV1CryptosuiteProperty
+	// GetW3IDDataIntegrityV1ProofPurpose returns the "proofPurpose" property
+	// if it exists, and nil otherwise.
+	GetW3IDDataIntegrityV1ProofPurpose() W3IDDataIntegrityV1ProofPurposeProperty
+	// GetW3IDDataIntegrityV1ProofValue returns the "proofValue" property if
+	// it exists, and nil otherwise.
+	GetW3IDDataIntegrityV1ProofValue() W3IDDataIntegrityV1ProofValueProperty
+	// GetW3IDDataIntegrityV1VerificationMethod returns the
+	// "verificationMethod" property if it exists, and nil otherwise.
+	GetW3IDDataIntegrityV1VerificationMethod() W3IDDataIntegrityV1VerificationMethodProperty
+	// IsExtending returns true if the DataIntegrityProof type extends from
+	// the other type.
+	IsExtending(other Type) bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this type and the specific properties that are set. The value
+	// in the map is the alias used to import the type and its properties.
+	JSONLDContext() map[string]string
+	// LessThan computes if this DataIntegrityProof is lesser, with an
+	// arbitrary but stable determination.
+	LessThan(o W3IDDataIntegrityV1DataIntegrityProof) bool
+	// RangeProperties calls the function with the name and values of each
+	// property of this DataIntegrityProof that has a value, in the order
+	// of their names, until it returns false. Each value is a type, an
+	// IRI, or the value of a literal, as returned by the Value method of
+	// the property. Unknown properties are not included.
+	RangeProperties(fn func(name string, values []interface{}) bool)
+	// RangeUnknownProperties calls the function with each unknown property in
+	// the order of their names, until it returns false. The @context of
+	// the document the DataIntegrityProof was deserialized from is
+	// skipped.
+	RangeUnknownProperties(fn func(name string, v interface{}) bool)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format.
+	Serialize() (map[string]interface{}, error)
+	// SetJSONLDId sets the "id" property.
+	SetJSONLDId(i JSONLDIdProperty)
+	// SetJSONLDType sets the "type" property.
+	SetJSONLDType(i JSONLDTypeProperty)
+	// SetUnknownProperty sets the unknown property with the name, which is
+	// serialized with the value as is. The value must be marshallable
+	// into JSON. It is not serialized if the name is the name of a
+	// property known to the DataIntegrityProof type.
+	SetUnknownProperty(name string, v interface{})
+	// SetW3IDDataIntegrityV1Created sets the "created" property.
+	SetW3IDDataIntegrityV1Created(i W3IDDataIntegrityV1CreatedProperty)
+	// SetW3IDDataIntegrityV1Cryptosuite sets the "cryptosuite" property.
+	SetW3IDDataIntegrityV1Cryptosuite(i W3IDDataIntegrityV1CryptosuiteProperty)
+	// SetW3IDDataIntegrityV1ProofPurpose sets the "proofPurpose" property.
+	SetW3IDDataIntegrityV1ProofPurpose(i W3IDDataIntegrityV1ProofPurposeProperty)
+	// SetW3IDDataIntegrityV1ProofValue sets the "proofValue" property.
+	SetW3IDDataIntegrityV1ProofValue(i W3IDDataIntegrityV1ProofValueProperty)
+	// SetW3IDDataIntegrityV1VerificationMethod sets the "verificationMethod"
+	// property.
+	SetW3IDDataIntegrityV1VerificationMethod(i W3IDDataIntegrityV1VerificationMethodProperty)
+	// Validate returns an error if this DataIntegrityProof violates its
+	// vocabulary: if a value is out of the range of its property, if an
+	// IRI is not absolute, or if a property required by the specification
+	// is missing. The error names the property of the first violation
+	// found.
+	Validate() error
+	// VocabularyURI returns the vocabulary's URI as a string.
+	VocabularyURI() string
+}
@@ -0,0 +1,88 @@
+// Code generated by astool. DO NOT EDIT.
+
+package vocab
+
+// A public key encoded with its multicodec type in a multibase string
+type W3IDMultikeyV1Multikey interface {
+	// Clone returns a deep copy of this Multikey, which can be modified
+	// without changing this one. Its properties, values, IRIs, and
+	// unknown properties are copied.
+	Clone() W3IDMultikeyV1Multikey
+	// DeleteUnknownProperty removes the unknown property with the name.
+	DeleteUnknownProperty(name string)
+	// Equals determines whether this Multikey has the same properties,
+	// values, and unknown properties as the other. Values of
+	// non-functional properties are compared in order, and the aliases
+	// used for vocabularies are ignored.
+	Equals(o W3IDMultikeyV1Multikey) bool
+	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
+	GetJSONLDId() JSONLDIdProperty
+	// GetJSONLDType returns the "type" property if it exists, and nil
+	// otherwise.
+	GetJSONLDType() JSONLDTypeProperty
+	// GetTypeName returns the name of this type.
+	GetTypeName() string
+	// GetUnknownProperties returns the unknown properties for the Multikey
+	// type. Note that this should not be used by app developers. It is
+	// only used to help determine which implementation is LessThan the
+	// other. Developers who are creating a different implementation of
+	// this type's interface can use this method in their LessThan
+	// implementation, but routine ActivityPub applications should not use
+	// this to bypass the code generation tool.
+	GetUnknownProperties() map[string]interface{}
+	// GetUnknownProperty returns the value of the unknown property with the
+	// name, and whether it is set. The value is as it was deserialized
+	// from JSON, such as a string, float64, bool, map[string]interface{}
+	// or []interface{}.
+	GetUnknownProperty(name string) (interface{}, bool)
+	// GetW3IDMultikeyV1Controller returns the "controller" property if it
+	// exists, and nil otherwise.
+	GetW3IDMultikeyV1Controller() W3IDMultikeyV1ControllerProperty
+	// GetW3IDMultikeyV1PublicKeyMultibase returns the "publicKeyMultibase"
+	// property if it exists, and nil otherwise.
+	GetW3IDMultikeyV1PublicKeyMultibase() W3IDMultikeyV1PublicKeyMultibaseProperty
+	// IsExtending returns true if the Multikey type extends from the other
+	// type.
+	IsExtending(other Type) bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this type and the specific properties that are set. The value
+	// in the map is the alias used to import the type and its properties.
+	JSONLDContext() map[string]string
+	// LessThan computes if this Multikey is lesser, with an arbitrary but
+	// stable determination.
+	LessThan(o W3IDMultikeyV1Multikey) bool
+	// RangeProperties calls the function with the name and values of each
+	// property of this Multikey that has a value, in the order of their
+	// names, until it returns false. Each value is a type, an IRI, or the
+	// value of a literal, as returned by the Value method of the
+	// property. Unknown properties are not included.
+	RangeProperties(fn func(name string, values []interface{}) bool)
+	// RangeUnknownProperties calls the function with each unknown property in
+	// the order of their names, until it returns false. The @context of
+	// the document the Multikey was deserialized from is skipped.
+	RangeUnknownProperties(fn func(name string, v interface{}) bool)
+	// Serialize converts this into an interface representation suitable for
+	// marshalling into a text or binary format.
+	Serialize() (map[string]interface{}, error)
+	// SetJSONLDId sets the "id" property.
+	SetJSONLDId(i JSONLDIdProperty)
+	// SetJSONLDType sets the "type" property.
+	SetJSONLDType(i JSONLDTypeProperty)
+	// SetUnknownProperty sets the unknown property with the name, which is
+	// serialized with the value as is. The value must be marshallable
+	// into JSON. It is not serialized if the name is the name of a
+	// property known to the Multikey type.
+	SetUnknownProperty(name string, v interface{})
+	// SetW3IDMultikeyV1Controller sets the "controller" property.
+	SetW3IDMultikeyV1Controller(i W3IDMultikeyV1ControllerProperty)
+	// SetW3IDMultikeyV1PublicKeyMultibase sets the "publicKeyMultibase"
+	// property.
+	SetW3IDMultikeyV1PublicKeyMultibase(i W3IDMultikeyV1PublicKeyMultibaseProperty)
+	// Validate returns an error if this Multikey violates its vocabulary: if
+	// a value is out of the range of its property, if an IRI is not
+	// absolute, or if a property required by the specification is
+	// missing. The error names the property of the first violation found.
+	Validate() error
+	// VocabularyURI returns the vocabulary's URI as a string.
+	VocabularyURI() string
+}
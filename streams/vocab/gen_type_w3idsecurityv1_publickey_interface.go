@@ -4,6 +4,17 @@ package vocab
 
 // A public key represents a public cryptographical key for a user
 type W3IDSecurityV1PublicKey interface {
+	// Clone returns a deep copy of this PublicKey, which can be modified
+	// without changing this one. Its properties, values, IRIs, and
+	// unknown properties are copied.
+	Clone() W3IDSecurityV1PublicKey
+	// DeleteUnknownProperty removes the unknown property with the name.
+	DeleteUnknownProperty(name string)
+	// Equals determines whether this PublicKey has the same properties,
+	// values, and unknown properties as the other. Values of
+	// non-functional properties are compared in order, and the aliases
+	// used for vocabularies are ignored.
+	Equals(o W3IDSecurityV1PublicKey) bool
 	// GetJSONLDId returns the "id" property if it exists, and nil otherwise.
 	GetJSONLDId() JSONLDIdProperty
 	// GetTypeName returns the name of this type.
@@ -16,6 +27,11 @@ type W3IDSecurityV1PublicKey interface {
 	// implementation, but routine ActivityPub applications should not use
 	// this to bypass the code generation tool.
 	GetUnknownProperties() map[string]interface{}
+	// GetUnknownProperty returns the value of the unknown property with the
+	// name, and whether it is set. The value is as it was deserialized
+	// from JSON, such as a string, float64, bool, map[string]interface{}
+	// or []interface{}.
+	GetUnknownProperty(name string) (interface{}, bool)
 	// GetW3IDSecurityV1Owner returns the "owner" property if it exists, and
 	// nil otherwise.
 	GetW3IDSecurityV1Owner() W3IDSecurityV1OwnerProperty
@@ -32,15 +48,35 @@ type W3IDSecurityV1PublicKey interface {
 	// LessThan computes if this PublicKey is lesser, with an arbitrary but
 	// stable determination.
 	LessThan(o W3IDSecurityV1PublicKey) bool
+	// RangeProperties calls the function with the name and values of each
+	// property of this PublicKey that has a value, in the order of their
+	// names, until it returns false. Each value is a type, an IRI, or the
+	// value of a literal, as returned by the Value method of the
+	// property. Unknown properties are not included.
+	RangeProperties(fn func(name string, values []interface{}) bool)
+	// RangeUnknownProperties calls the function with each unknown property in
+	// the order of their names, until it returns false. The @context of
+	// the document the PublicKey was deserialized from is skipped.
+	RangeUnknownProperties(fn func(name string, v interface{}) bool)
 	// Serialize converts this into an interface representation suitable for
 	// marshalling into a text or binary format.
 	Serialize() (map[string]interface{}, error)
 	// SetJSONLDId sets the "id" property.
 	SetJSONLDId(i JSONLDIdProperty)
+	// SetUnknownProperty sets the unknown property with the name, which is
+	// serialized with the value as is. The value must be marshallable
+	// into JSON. It is not serialized if the name is the name of a
+	// property known to the PublicKey type.
+	SetUnknownProperty(name string, v interface{})
 	// SetW3IDSecurityV1Owner sets the "owner" property.
 	SetW3IDSecurityV1Owner(i W3IDSecurityV1OwnerProperty)
 	// SetW3IDSecurityV1PublicKeyPem sets the "publicKeyPem" property.
 	SetW3IDSecurityV1PublicKeyPem(i W3IDSecurityV1PublicKeyPemProperty)
+	// Validate returns an error if this PublicKey violates its vocabulary: if
+	// a value is out of the range of its property, if an IRI is not
+	// absolute, or if a property required by the specification is
+	// missing. The error names the property of the first violation found.
+	Validate() error
 	// VocabularyURI returns the vocabulary's URI as a string.
 	VocabularyURI() string
 }
@@ -0,0 +1,129 @@
+package streams
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-fed/activity/streams/vocab"
+	"net/url"
+	"strings"
+)
+
+// SkipChildren is returned by the Pre function of a Walker to not visit the
+// properties of a type. It is not returned by Walk.
+var SkipChildren = errors.New("skip the properties of this type")
+
+// PathElement is a step from a type to one of the values of its properties.
+type PathElement struct {
+	// Property is the name of the property, such as "object" or "content".
+	Property string
+	// Index is the position of the value among the values of the
+	// property, which is zero for a functional property.
+	Index int
+}
+
+// Path is the location of a value visited by Walk, from the type being walked.
+// The path of the walked type itself is empty.
+type Path []PathElement
+
+// String returns the path in the form "object[0].tag[1]".
+func (p Path) String() string {
+	parts := make([]string, len(p))
+	for i, e := range p {
+		parts[i] = fmt.Sprintf("%s[%d]", e.Property, e.Index)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Last returns the last element of the path, and false if the path is empty.
+func (p Path) Last() (PathElement, bool) {
+	if len(p) == 0 {
+		return PathElement{}, false
+	}
+	return p[len(p)-1], true
+}
+
+// Walker receives the values visited by Walk. Every function is optional.
+//
+// The Path passed to a function is only valid during the call, and must be
+// copied to be kept.
+type Walker struct {
+	// Pre is called with each type before its properties are visited.
+	// Returning SkipChildren skips its properties, and returning any
+	// other error stops the walk.
+	Pre func(p Path, t vocab.Type) error
+	// Post is called with each type after its properties are visited,
+	// unless they were skipped. Returning an error stops the walk.
+	Post func(p Path, t vocab.Type) error
+	// IRI is called with each IRI, including the ids of types and the
+	// values of properties whose values are IRIs. Returning an error stops
+	// the walk.
+	IRI func(p Path, iri *url.URL) error
+	// Literal is called with each other value, such as a string, a
+	// time.Time, a map[string]string of a language map, or the value of
+	// an unknown property as it was deserialized from JSON. Returning an
+	// error stops the walk.
+	Literal func(p Path, v interface{}) error
+}
+
+// Walk visits the type and every type, IRI, and literal nested in its
+// properties, in depth-first order. The properties of a type are visited in
+// the order of their names, followed by its unknown properties in the order
+// of their names, whose values are not walked into.
+//
+// Walk returns the first error returned by a function of the Walker, other
+// than SkipChildren.
+func Walk(t vocab.Type, w Walker) error {
+	return walkType(nil, t, w)
+}
+
+// walkType visits the type at the path and its properties.
+func walkType(p Path, t vocab.Type, w Walker) (err error) {
+	if w.Pre != nil {
+		if err = w.Pre(p, t); err == SkipChildren {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+	t.RangeProperties(func(name string, values []interface{}) bool {
+		for i, v := range values {
+			if err = walkValue(append(p, PathElement{Property: name, Index: i}), v, w); err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return
+	}
+	if w.Literal != nil {
+		t.RangeUnknownProperties(func(name string, v interface{}) bool {
+			err = w.Literal(append(p, PathElement{Property: name}), v)
+			return err == nil
+		})
+		if err != nil {
+			return
+		}
+	}
+	if w.Post != nil {
+		err = w.Post(p, t)
+	}
+	return
+}
+
+// walkValue visits a value of a property at the path.
+func walkValue(p Path, v interface{}, w Walker) error {
+	switch x := v.(type) {
+	case vocab.Type:
+		return walkType(p, x, w)
+	case *url.URL:
+		if w.IRI != nil {
+			return w.IRI(p, x)
+		}
+	default:
+		if w.Literal != nil {
+			return w.Literal(p, x)
+		}
+	}
+	return nil
+}